@@ -44,7 +44,6 @@ methods to convert to/from the hub version.
 
 /*
 ConvertTo is expected to modify its argument to contain the converted object.
-Most of the conversion is straightforward copying, except for converting our changed field.
 */
 
 // ConvertTo converts this CronJob (v2) to the Hub version (v1).
@@ -53,7 +52,49 @@ func (src *CronJob) ConvertTo(dstRaw conversion.Hub) error {
 	log.Printf("ConvertTo: Converting CronJob from Spoke version v2 to Hub version v1;"+
 		"source: %s/%s, target: %s/%s", src.Namespace, src.Name, dst.Namespace, dst.Name)
 
-	sched := src.Spec.Schedule
+	/*
+		The fields that match between the versions, such as the ObjectMeta and most of the spec, are converted by
+		the functions that Kubebuilder generates in `zz_generated.conversion.go`. Run
+		`kubebuilder generate conversion` to regenerate them after changing the types.
+	*/
+	if err := Convert_v2_CronJob_To_v1_CronJob(src, dst); err != nil {
+		return err
+	}
+
+	// Restore the fields that only exist in the Hub version, when this object was converted from it
+	return restoreCronJob(src, dst)
+}
+
+/*
+ConvertFrom is expected to modify its receiver to contain the converted object.
+*/
+
+// ConvertFrom converts the Hub version (v1) to this CronJob (v2).
+func (dst *CronJob) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*batchv1.CronJob)
+	log.Printf("ConvertFrom: Converting CronJob from Hub version v1 to Spoke version v2;"+
+		"source: %s/%s, target: %s/%s", src.Namespace, src.Name, dst.Namespace, dst.Name)
+
+	if err := Convert_v1_CronJob_To_v2_CronJob(src, dst); err != nil {
+		return err
+	}
+
+	// Stash the fields that only exist in the Hub version, so that they are restored when converted back
+	return stashCronJob(src, dst)
+}
+
+/*
+Our schedule changed from a string to a structured type, which the generated functions cannot convert.
+They flag it in `zz_generated.conversion.go`, and leave the conversion functions of
+`CronJobSpec` for us to implement in this package.
+
+Converting to the hub version joins the fields of the schedule, using `*` for the ones that are
+not set.
+*/
+
+// Convert_v2_CronJobSpec_To_v1_CronJobSpec converts the CronJobSpec (v2) to the Hub version (v1).
+func Convert_v2_CronJobSpec_To_v1_CronJobSpec(in *CronJobSpec, out *batchv1.CronJobSpec) error {
+	sched := in.Schedule
 	scheduleParts := []string{"*", "*", "*", "*", "*"}
 	if sched.Minute != nil {
 		scheduleParts[0] = string(*sched.Minute)
@@ -70,43 +111,21 @@ func (src *CronJob) ConvertTo(dstRaw conversion.Hub) error {
 	if sched.DayOfWeek != nil {
 		scheduleParts[4] = string(*sched.DayOfWeek)
 	}
-	dst.Spec.Schedule = strings.Join(scheduleParts, " ")
+	out.Schedule = strings.Join(scheduleParts, " ")
 
 	/*
-		The rest of the conversion is pretty rote.
+		The rest of the conversion is generated.
 	*/
-	// ObjectMeta
-	dst.ObjectMeta = src.ObjectMeta
-
-	// Spec
-	dst.Spec.StartingDeadlineSeconds = src.Spec.StartingDeadlineSeconds
-	dst.Spec.ConcurrencyPolicy = batchv1.ConcurrencyPolicy(src.Spec.ConcurrencyPolicy)
-	dst.Spec.Suspend = src.Spec.Suspend
-	dst.Spec.JobTemplate = src.Spec.JobTemplate
-	dst.Spec.SuccessfulJobsHistoryLimit = src.Spec.SuccessfulJobsHistoryLimit
-	dst.Spec.FailedJobsHistoryLimit = src.Spec.FailedJobsHistoryLimit
-
-	// Status
-	dst.Status.Active = src.Status.Active
-	dst.Status.LastScheduleTime = src.Status.LastScheduleTime
-
-	return nil
+	return autoConvert_v2_CronJobSpec_To_v1_CronJobSpec(in, out)
 }
 
-// +kubebuilder:docs-gen:collapse=rote conversion
-
 /*
-ConvertFrom is expected to modify its receiver to contain the converted object.
-Most of the conversion is straightforward copying, except for converting our changed field.
+Converting from the hub version splits the schedule into its fields, leaving out the ones set to `*`.
 */
 
-// ConvertFrom converts the Hub version (v1) to this CronJob (v2).
-func (dst *CronJob) ConvertFrom(srcRaw conversion.Hub) error {
-	src := srcRaw.(*batchv1.CronJob)
-	log.Printf("ConvertFrom: Converting CronJob from Hub version v1 to Spoke version v2;"+
-		"source: %s/%s, target: %s/%s", src.Namespace, src.Name, dst.Namespace, dst.Name)
-
-	schedParts := strings.Split(src.Spec.Schedule, " ")
+// Convert_v1_CronJobSpec_To_v2_CronJobSpec converts the CronJobSpec of the Hub version (v1) to v2.
+func Convert_v1_CronJobSpec_To_v2_CronJobSpec(in *batchv1.CronJobSpec, out *CronJobSpec) error {
+	schedParts := strings.Split(in.Schedule, " ")
 	if len(schedParts) != 5 {
 		return fmt.Errorf("invalid schedule: not a standard 5-field schedule")
 	}
@@ -117,31 +136,11 @@ func (dst *CronJob) ConvertFrom(srcRaw conversion.Hub) error {
 		part := CronField(raw)
 		return &part
 	}
-	dst.Spec.Schedule.Minute = partIfNeeded(schedParts[0])
-	dst.Spec.Schedule.Hour = partIfNeeded(schedParts[1])
-	dst.Spec.Schedule.DayOfMonth = partIfNeeded(schedParts[2])
-	dst.Spec.Schedule.Month = partIfNeeded(schedParts[3])
-	dst.Spec.Schedule.DayOfWeek = partIfNeeded(schedParts[4])
+	out.Schedule.Minute = partIfNeeded(schedParts[0])
+	out.Schedule.Hour = partIfNeeded(schedParts[1])
+	out.Schedule.DayOfMonth = partIfNeeded(schedParts[2])
+	out.Schedule.Month = partIfNeeded(schedParts[3])
+	out.Schedule.DayOfWeek = partIfNeeded(schedParts[4])
 
-	/*
-		The rest of the conversion is pretty rote.
-	*/
-	// ObjectMeta
-	dst.ObjectMeta = src.ObjectMeta
-
-	// Spec
-	dst.Spec.StartingDeadlineSeconds = src.Spec.StartingDeadlineSeconds
-	dst.Spec.ConcurrencyPolicy = ConcurrencyPolicy(src.Spec.ConcurrencyPolicy)
-	dst.Spec.Suspend = src.Spec.Suspend
-	dst.Spec.JobTemplate = src.Spec.JobTemplate
-	dst.Spec.SuccessfulJobsHistoryLimit = src.Spec.SuccessfulJobsHistoryLimit
-	dst.Spec.FailedJobsHistoryLimit = src.Spec.FailedJobsHistoryLimit
-
-	// Status
-	dst.Status.Active = src.Status.Active
-	dst.Status.LastScheduleTime = src.Status.LastScheduleTime
-
-	return nil
+	return autoConvert_v1_CronJobSpec_To_v2_CronJobSpec(in, out)
 }
-
-// +kubebuilder:docs-gen:collapse=rote conversion
@@ -0,0 +1,144 @@
+/*
+Copyright 2026 The Kubernetes authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/apitesting/fuzzer"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metafuzzer "k8s.io/apimachinery/pkg/apis/meta/fuzzer"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	runtimeserializer "k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/diff"
+	"sigs.k8s.io/randfill"
+
+	batchv1 "tutorial.kubebuilder.io/project/api/v1"
+)
+
+// The round-trip tests fill a CronJob with random values, convert it to the other version and back,
+// and check that nothing was lost. "go test" runs them with the seeds added below; run them with
+// "go test -fuzz=FuzzCronJobSpokeHubSpoke ./<this package>" to explore more values.
+
+// FuzzCronJobSpokeHubSpoke checks that converting a CronJob (v2) to the Hub version (v1)
+// and back returns the same object.
+func FuzzCronJobSpokeHubSpoke(f *testing.F) {
+	for seed := range int64(10) {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, seed int64) {
+		spoke := &CronJob{}
+		newCronJobFiller(t, seed).Fill(spoke)
+
+		hub := &batchv1.CronJob{}
+		if err := spoke.ConvertTo(hub); err != nil {
+			t.Fatalf("failed to convert to the Hub version: %v", err)
+		}
+		roundTrip := &CronJob{}
+		if err := roundTrip.ConvertFrom(hub); err != nil {
+			t.Fatalf("failed to convert from the Hub version: %v", err)
+		}
+
+		removeCronJobConversionData(&spoke.ObjectMeta)
+		removeCronJobConversionData(&roundTrip.ObjectMeta)
+		if !apiequality.Semantic.DeepEqual(spoke, roundTrip) {
+			t.Errorf("the CronJob changed after a round trip through the Hub version:\n%s",
+				diff.Diff(spoke, roundTrip))
+		}
+	})
+}
+
+// FuzzCronJobHubSpokeHub checks that converting a CronJob Hub version (v1) to v2
+// and back returns the same object, including the fields stashed in the annotations of v2.
+func FuzzCronJobHubSpokeHub(f *testing.F) {
+	for seed := range int64(10) {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, seed int64) {
+		hub := &batchv1.CronJob{}
+		newCronJobFiller(t, seed).Fill(hub)
+
+		spoke := &CronJob{}
+		if err := spoke.ConvertFrom(hub); err != nil {
+			t.Fatalf("failed to convert from the Hub version: %v", err)
+		}
+		roundTrip := &batchv1.CronJob{}
+		if err := spoke.ConvertTo(roundTrip); err != nil {
+			t.Fatalf("failed to convert to the Hub version: %v", err)
+		}
+
+		removeCronJobConversionData(&hub.ObjectMeta)
+		removeCronJobConversionData(&roundTrip.ObjectMeta)
+		if !apiequality.Semantic.DeepEqual(hub, roundTrip) {
+			t.Errorf("the CronJob changed after a round trip through v2:\n%s",
+				diff.Diff(hub, roundTrip))
+		}
+	})
+}
+
+// newCronJobFiller returns a filler of random values for the Kubernetes types of both versions of
+// CronJob, determined by seed.
+func newCronJobFiller(t *testing.T, seed int64) *randfill.Filler {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	if err := AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add v2 to the scheme: %v", err)
+	}
+	if err := batchv1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add v1 to the scheme: %v", err)
+	}
+
+	funcs := fuzzer.MergeFuzzerFuncs(metafuzzer.Funcs, cronjobFuzzerFuncs)
+	return fuzzer.FuzzerFor(funcs, rand.NewSource(seed), runtimeserializer.NewCodecFactory(scheme))
+}
+
+// removeCronJobConversionData removes the fields of the Hub version stashed in the annotations of
+// v2, which are not part of the objects compared.
+func removeCronJobConversionData(meta *metav1.ObjectMeta) {
+	delete(meta.Annotations, conversionDataAnnotation)
+	if len(meta.Annotations) == 0 {
+		meta.Annotations = nil
+	}
+}
+
+// cronjobFuzzerFuncs returns the functions filling the types that must not be random.
+// The schedules are made of five fields without spaces, "*" matching any value.
+func cronjobFuzzerFuncs(_ runtimeserializer.CodecFactory) []any {
+	cronField := func(c randfill.Continue) string {
+		fields := []string{"*", "0", "*/5", "1-5", "MON"}
+		return fields[c.Intn(len(fields))]
+	}
+	return []any{
+		func(j *batchv1.CronJobSpec, c randfill.Continue) {
+			c.FillNoCustom(j)
+			j.Schedule = strings.Join([]string{cronField(c), cronField(c), cronField(c), cronField(c), cronField(c)}, " ")
+		},
+		func(j *CronSchedule, c randfill.Continue) {
+			for _, field := range []**CronField{&j.Minute, &j.Hour, &j.DayOfMonth, &j.Month, &j.DayOfWeek} {
+				*field = nil
+				if value := cronField(c); value != "*" {
+					part := CronField(value)
+					*field = &part
+				}
+			}
+		},
+	}
+}
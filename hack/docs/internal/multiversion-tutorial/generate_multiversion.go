@@ -107,6 +107,7 @@ func (sp *Sample) UpdateTutorial() {
 	"k8s.io/utils/ptr"`)
 	hackutils.CheckError("add import for webhook tests", err)
 
+	sp.generateConversion()
 	sp.updateConversionFiles()
 	sp.updateSampleV2()
 	sp.updateMain()
@@ -152,6 +153,13 @@ func (sp *Sample) updateSampleV2() {
 	hackutils.CheckError("replacing TODO with sampleV2Code in batch_v2_cronjob.yaml", err)
 }
 
+// generateConversion regenerates the conversion functions of v2 from the updated API types.
+func (sp *Sample) generateConversion() {
+	cmd := exec.Command(sp.ctx.BinaryName, "generate", "conversion")
+	_, err := sp.ctx.Run(cmd)
+	hackutils.CheckError("generating the conversion functions for multiversion tutorial", err)
+}
+
 func (sp *Sample) updateConversionFiles() {
 	path := filepath.Join(sp.ctx.Dir, "api/v1/cronjob_conversion.go")
 
@@ -200,37 +208,20 @@ methods to convert to/from the hub version.
 	hackutils.CheckError("adding comment to hub v2", err)
 
 	err = pluginutil.ReplaceInFile(path,
-		`// TODO(user): Implement conversion logic from v2 to v1
-	// Example: Copying Spec fields
-	// dst.Spec.Size = src.Spec.Replicas
-
-	// Copy ObjectMeta to preserve name, namespace, labels, etc.
-	dst.ObjectMeta = src.ObjectMeta
-
-	return nil
-}`,
-		hubV2CovertTo)
-	hackutils.CheckError("replace covertTo at hub v2", err)
-
-	err = pluginutil.ReplaceInFile(path,
-		`// TODO(user): Implement conversion logic from v1 to v2
-	// Example: Copying Spec fields
-	// dst.Spec.Replicas = src.Spec.Size
+		`// The fields with the same name and type in both versions, including ObjectMeta, are converted by the
+	// functions of zz_generated.conversion.go. Run "kubebuilder generate conversion" to regenerate them
+	// after changing the types.
+	// TODO(user): Implement the Convert_ functions flagged there, for the fields that do not map.`,
+		hubV2ConvertToComment)
+	hackutils.CheckError("replace the generated conversion comment at hub v2", err)
 
-	// Copy ObjectMeta to preserve name, namespace, labels, etc.
-	dst.ObjectMeta = src.ObjectMeta
-
-	return nil
-}
-`,
-		hubV2ConvertFromCode)
-	hackutils.CheckError("replace covert from at hub v2", err)
+	err = pluginutil.AppendCodeAtTheEnd(path, hubV2ConvertSpecCode)
+	hackutils.CheckError("adding the conversion of the spec at hub v2", err)
 
 	err = pluginutil.ReplaceInFile(path,
 		"// ConvertFrom converts the Hub version (v1) to this CronJob (v2).",
 		`/*
 ConvertFrom is expected to modify its receiver to contain the converted object.
-Most of the conversion is straightforward copying, except for converting our changed field.
 */
 
 // ConvertFrom converts the Hub version (v1) to this CronJob (v2).`)
@@ -240,11 +231,37 @@ Most of the conversion is straightforward copying, except for converting our cha
 		"// ConvertTo converts this CronJob (v2) to the Hub version (v1).",
 		`/*
 ConvertTo is expected to modify its argument to contain the converted object.
-Most of the conversion is straightforward copying, except for converting our changed field.
 */
 
 // ConvertTo converts this CronJob (v2) to the Hub version (v1).`)
 	hackutils.CheckError("replace covert info at hub v2", err)
+
+	sp.updateConversionTest()
+}
+
+// updateConversionTest fills the schedules of the round-trip tests with valid values.
+func (sp *Sample) updateConversionTest() {
+	path := filepath.Join(sp.ctx.Dir, "api/v2/cronjob_conversion_test.go")
+
+	err := pluginutil.InsertCode(path,
+		`"math/rand"`,
+		`
+	"strings"`)
+	hackutils.CheckError("adding imports to the conversion test", err)
+
+	err = pluginutil.ReplaceInFile(path,
+		`// cronjobFuzzerFuncs returns the functions filling the types that must not be random.
+// TODO(user): Add a function for each type with values that cannot survive a round trip, for example:
+//
+//	func(j *MyType, c randfill.Continue) {
+//		c.FillNoCustom(j)
+//		j.Format = "default"
+//	},
+func cronjobFuzzerFuncs(_ runtimeserializer.CodecFactory) []any {
+	return []any{}
+}`,
+		hubV2FuzzerFuncsCode)
+	hackutils.CheckError("adding the fuzzer functions of the schedules", err)
 }
 
 func (sp *Sample) updateAPIV1() {
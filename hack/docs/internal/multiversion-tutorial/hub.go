@@ -36,7 +36,25 @@ standard packages.
 */
 `
 
-const hubV2CovertTo = `sched := src.Spec.Schedule
+const hubV2ConvertToComment = `/*
+	The fields that match between the versions, such as the ObjectMeta and most of the spec, are converted by
+	the functions that Kubebuilder generates in ` + "`" + `zz_generated.conversion.go` + "`" + `. Run
+	` + "`" + `kubebuilder generate conversion` + "`" + ` to regenerate them after changing the types.
+	*/`
+
+const hubV2ConvertSpecCode = `
+/*
+Our schedule changed from a string to a structured type, which the generated functions cannot convert.
+They flag it in ` + "`" + `zz_generated.conversion.go` + "`" + `, and leave the conversion functions of
+` + "`" + `CronJobSpec` + "`" + ` for us to implement in this package.
+
+Converting to the hub version joins the fields of the schedule, using ` + "`" + `*` + "`" + ` for the ones that are
+not set.
+*/
+
+// Convert_v2_CronJobSpec_To_v1_CronJobSpec converts the CronJobSpec (v2) to the Hub version (v1).
+func Convert_v2_CronJobSpec_To_v1_CronJobSpec(in *CronJobSpec, out *batchv1.CronJobSpec) error {
+	sched := in.Schedule
 	scheduleParts := []string{"*", "*", "*", "*", "*"}
 	if sched.Minute != nil {
 		scheduleParts[0] = string(*sched.Minute)
@@ -53,31 +71,21 @@ const hubV2CovertTo = `sched := src.Spec.Schedule
 	if sched.DayOfWeek != nil {
 		scheduleParts[4] = string(*sched.DayOfWeek)
 	}
-	dst.Spec.Schedule = strings.Join(scheduleParts, " ")
+	out.Schedule = strings.Join(scheduleParts, " ")
 
 	/*
-		The rest of the conversion is pretty rote.
+		The rest of the conversion is generated.
 	*/
-	// ObjectMeta
-	dst.ObjectMeta = src.ObjectMeta
-
-	// Spec
-	dst.Spec.StartingDeadlineSeconds = src.Spec.StartingDeadlineSeconds
-	dst.Spec.ConcurrencyPolicy = batchv1.ConcurrencyPolicy(src.Spec.ConcurrencyPolicy)
-	dst.Spec.Suspend = src.Spec.Suspend
-	dst.Spec.JobTemplate = src.Spec.JobTemplate
-	dst.Spec.SuccessfulJobsHistoryLimit = src.Spec.SuccessfulJobsHistoryLimit
-	dst.Spec.FailedJobsHistoryLimit = src.Spec.FailedJobsHistoryLimit
-
-	// Status
-	dst.Status.Active = src.Status.Active
-	dst.Status.LastScheduleTime = src.Status.LastScheduleTime
-
-	return nil
+	return autoConvert_v2_CronJobSpec_To_v1_CronJobSpec(in, out)
 }
-// +kubebuilder:docs-gen:collapse=rote conversion`
 
-const hubV2ConvertFromCode = `schedParts := strings.Split(src.Spec.Schedule, " ")
+/*
+Converting from the hub version splits the schedule into its fields, leaving out the ones set to ` + "`" + `*` + "`" + `.
+*/
+
+// Convert_v1_CronJobSpec_To_v2_CronJobSpec converts the CronJobSpec of the Hub version (v1) to v2.
+func Convert_v1_CronJobSpec_To_v2_CronJobSpec(in *batchv1.CronJobSpec, out *CronJobSpec) error {
+	schedParts := strings.Split(in.Schedule, " ")
 	if len(schedParts) != 5 {
 		return fmt.Errorf("invalid schedule: not a standard 5-field schedule")
 	}
@@ -88,30 +96,36 @@ const hubV2ConvertFromCode = `schedParts := strings.Split(src.Spec.Schedule, " "
 		part := CronField(raw)
 		return &part
 	}
-	dst.Spec.Schedule.Minute = partIfNeeded(schedParts[0])
-	dst.Spec.Schedule.Hour = partIfNeeded(schedParts[1])
-	dst.Spec.Schedule.DayOfMonth = partIfNeeded(schedParts[2])
-	dst.Spec.Schedule.Month = partIfNeeded(schedParts[3])
-	dst.Spec.Schedule.DayOfWeek = partIfNeeded(schedParts[4])
+	out.Schedule.Minute = partIfNeeded(schedParts[0])
+	out.Schedule.Hour = partIfNeeded(schedParts[1])
+	out.Schedule.DayOfMonth = partIfNeeded(schedParts[2])
+	out.Schedule.Month = partIfNeeded(schedParts[3])
+	out.Schedule.DayOfWeek = partIfNeeded(schedParts[4])
 
-	/*
-		The rest of the conversion is pretty rote.
-	*/
-	// ObjectMeta
-	dst.ObjectMeta = src.ObjectMeta
-
-	// Spec
-	dst.Spec.StartingDeadlineSeconds = src.Spec.StartingDeadlineSeconds
-	dst.Spec.ConcurrencyPolicy = ConcurrencyPolicy(src.Spec.ConcurrencyPolicy)
-	dst.Spec.Suspend = src.Spec.Suspend
-	dst.Spec.JobTemplate = src.Spec.JobTemplate
-	dst.Spec.SuccessfulJobsHistoryLimit = src.Spec.SuccessfulJobsHistoryLimit
-	dst.Spec.FailedJobsHistoryLimit = src.Spec.FailedJobsHistoryLimit
-
-	// Status
-	dst.Status.Active = src.Status.Active
-	dst.Status.LastScheduleTime = src.Status.LastScheduleTime
-	
-	return nil
+	return autoConvert_v1_CronJobSpec_To_v2_CronJobSpec(in, out)
 }
-// +kubebuilder:docs-gen:collapse=rote conversion`
+`
+
+const hubV2FuzzerFuncsCode = `// cronjobFuzzerFuncs returns the functions filling the types that must not be random.
+// The schedules are made of five fields without spaces, "*" matching any value.
+func cronjobFuzzerFuncs(_ runtimeserializer.CodecFactory) []any {
+	cronField := func(c randfill.Continue) string {
+		fields := []string{"*", "0", "*/5", "1-5", "MON"}
+		return fields[c.Intn(len(fields))]
+	}
+	return []any{
+		func(j *batchv1.CronJobSpec, c randfill.Continue) {
+			c.FillNoCustom(j)
+			j.Schedule = strings.Join([]string{cronField(c), cronField(c), cronField(c), cronField(c), cronField(c)}, " ")
+		},
+		func(j *CronSchedule, c randfill.Continue) {
+			for _, field := range []**CronField{&j.Minute, &j.Hour, &j.DayOfMonth, &j.Month, &j.DayOfWeek} {
+				*field = nil
+				if value := cronField(c); value != "*" {
+					part := CronField(value)
+					*field = &part
+				}
+			}
+		},
+	}
+}`
@@ -582,11 +582,11 @@ func createControllers(res resource.Resource) error {
 		if !res.Controller {
 			return nil
 		}
-		return createControllerWithName(res, "")
+		return createControllerWithName(res, resource.Controller{})
 	}
 
 	for _, controller := range *res.Controllers {
-		if err := createControllerWithName(res, controller.Name); err != nil {
+		if err := createControllerWithName(res, controller); err != nil {
 			return fmt.Errorf("failed to create controller %q: %w", controller.Name, err)
 		}
 	}
@@ -594,8 +594,8 @@ func createControllers(res resource.Resource) error {
 	return nil
 }
 
-// Creates a single controller for a resource with a specific name.
-func createControllerWithName(res resource.Resource, controllerName string) error {
+// Creates a single controller for a resource, named after the controller unless it is the legacy one.
+func createControllerWithName(res resource.Resource, controller resource.Controller) error {
 	args := append([]string{"create", "api"}, getGVKFlags(res)...)
 
 	// Always set --resource=false since we're only creating the controller
@@ -603,8 +603,11 @@ func createControllerWithName(res resource.Resource, controllerName string) erro
 	args = append(args, "--controller=true")
 
 	// Add controller name if specified
-	if controllerName != "" {
-		args = append(args, "--controller-name", controllerName)
+	if controller.Name != "" {
+		args = append(args, "--controller-name", controller.Name)
+	}
+	if controller.Finalizer {
+		args = append(args, "--finalizer")
 	}
 
 	// Add the external API flags if the resource is external
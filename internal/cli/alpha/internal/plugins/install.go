@@ -0,0 +1,355 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugins
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/ed25519"
+	"errors"
+	"fmt"
+	"io"
+	iofs "io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+	pluginexternal "sigs.k8s.io/kubebuilder/v4/pkg/plugin/external"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/external"
+)
+
+// InstallOptions configures how an external plugin is installed.
+type InstallOptions struct {
+	// Name is the name of the plugin. Defaults to the name declared in the plugin manifest,
+	// or to the name of the executable.
+	Name string
+	// Version is the version of the plugin, e.g. v1. Defaults to the version declared in the plugin manifest.
+	Version string
+	// Force replaces the plugin version if it is already installed.
+	Force bool
+}
+
+// Install installs the external plugin found in source, which can be a directory holding the plugin
+// executable and its optional manifest, the plugin executable itself, or a tarball (.tar, .tar.gz or .tgz)
+// of such a directory.
+//
+// The plugin is validated against its manifest, if any, and must answer a `metadata` request before it is
+// moved to `<root>/<name>/<version>`.
+func (m Manager) Install(source string, opts InstallOptions) (Installed, error) {
+	info, err := m.FS.Stat(source)
+	if err != nil {
+		return Installed{}, fmt.Errorf("error reading plugin source %q: %w", source, err)
+	}
+
+	srcDir, executable := source, ""
+	switch {
+	case info.IsDir():
+	case isTarball(source):
+		if srcDir, err = afero.TempDir(m.FS, "", "kubebuilder-plugin-"); err != nil {
+			return Installed{}, fmt.Errorf("error creating temporary directory: %w", err)
+		}
+		defer func() {
+			_ = m.FS.RemoveAll(srcDir)
+		}()
+
+		if err = extractTarball(m.FS, source, srcDir); err != nil {
+			return Installed{}, err
+		}
+		if srcDir, err = tarballRoot(m.FS, srcDir); err != nil {
+			return Installed{}, err
+		}
+	default:
+		srcDir, executable = filepath.Dir(source), source
+	}
+
+	var manifest *pluginexternal.Manifest
+	if executable == "" {
+		if manifest, err = external.LoadManifest(m.FS, srcDir); err != nil {
+			return Installed{}, err
+		}
+	}
+
+	name, version := opts.Name, opts.Version
+	if manifest != nil {
+		name, version = defaultString(name, manifest.Name), defaultString(version, manifest.Version)
+	}
+
+	// Without name, the plugin is named after its executable, which can be the only file of the directory.
+	if name == "" {
+		candidate := executable
+		if candidate == "" {
+			if candidate, err = soleFile(m.FS, srcDir); err != nil {
+				return Installed{}, err
+			}
+		}
+		name = strings.Split(filepath.Base(candidate), ".")[0]
+	}
+	if name == "" {
+		return Installed{}, errors.New("unable to determine the plugin name, set it or declare it in the plugin manifest")
+	}
+	if version == "" {
+		return Installed{}, errors.New("unable to determine the plugin version, set it or declare it in the plugin manifest")
+	}
+
+	installed := Installed{Name: name, Version: version}
+	if err = plugin.ValidateKey(installed.Key()); err != nil {
+		return Installed{}, fmt.Errorf("invalid plugin key %q: %w", installed.Key(), err)
+	}
+	if manifest != nil {
+		if err = external.ValidateManifest(*manifest, name, version); err != nil {
+			return Installed{}, fmt.Errorf("invalid manifest for external plugin %q: %w", installed.Key(), err)
+		}
+	}
+
+	if executable == "" {
+		if executable, err = findExecutable(m.FS, srcDir, name); err != nil {
+			return Installed{}, err
+		}
+		if executable == "" {
+			return Installed{}, fmt.Errorf("no executable named %q found in %q", name, srcDir)
+		}
+	}
+
+	// The executable must be named after the plugin to be discovered, e.g. sample or sample.wasm.
+	fileName := filepath.Base(executable)
+	if strings.Split(fileName, ".")[0] != name {
+		fileName = name + filepath.Ext(fileName)
+	}
+
+	target := filepath.Join(m.Root, name, version)
+	if _, err = m.FS.Stat(target); err == nil {
+		if !opts.Force {
+			return Installed{}, fmt.Errorf("external plugin %q is already installed, use force to replace it",
+				installed.Key())
+		}
+	} else if !errors.Is(err, iofs.ErrNotExist) {
+		return Installed{}, fmt.Errorf("error checking directory %q: %w", target, err)
+	}
+
+	// The plugin is staged in a hidden directory of the plugins root, which is skipped by the plugins
+	// discovery, and only moved to its final location once verified.
+	if err = m.FS.MkdirAll(m.Root, 0o755); err != nil {
+		return Installed{}, fmt.Errorf("error creating plugins directory %q: %w", m.Root, err)
+	}
+	staging, err := afero.TempDir(m.FS, m.Root, ".install-")
+	if err != nil {
+		return Installed{}, fmt.Errorf("error creating staging directory: %w", err)
+	}
+	defer func() {
+		_ = m.FS.RemoveAll(staging)
+	}()
+
+	mode := iofs.FileMode(0o755)
+	if external.IsWasmPlugin(executable) {
+		mode = 0o644
+	}
+	staged := filepath.Join(staging, fileName)
+	if err = copyFile(m.FS, executable, staged, mode); err != nil {
+		return Installed{}, err
+	}
+
+	if manifest != nil {
+		if err = copyFile(m.FS, filepath.Join(srcDir, pluginexternal.ManifestFileName),
+			filepath.Join(staging, pluginexternal.ManifestFileName), 0o644); err != nil {
+			return Installed{}, err
+		}
+		if err = m.verify(*manifest, staged); err != nil {
+			return Installed{}, fmt.Errorf("refusing to install external plugin %q: %w", installed.Key(), err)
+		}
+	}
+
+	var subcommands []string
+	if manifest != nil {
+		subcommands = manifest.Subcommands
+	}
+	if err = external.Handshake(staged, subcommands); err != nil {
+		return Installed{}, fmt.Errorf("refusing to install external plugin %q: %w", installed.Key(), err)
+	}
+
+	if err = m.FS.RemoveAll(target); err != nil {
+		return Installed{}, fmt.Errorf("error removing previous installation %q: %w", target, err)
+	}
+	if err = m.FS.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return Installed{}, fmt.Errorf("error creating directory %q: %w", filepath.Dir(target), err)
+	}
+	if err = m.FS.Chmod(staging, 0o755); err != nil {
+		return Installed{}, fmt.Errorf("error setting permissions of %q: %w", staging, err)
+	}
+	if err = m.FS.Rename(staging, target); err != nil {
+		return Installed{}, fmt.Errorf("error installing external plugin %q: %w", installed.Key(), err)
+	}
+
+	installed.Path = filepath.Join(target, fileName)
+	return installed, nil
+}
+
+// verify checks the integrity of the plugin executable against its manifest.
+func (m Manager) verify(manifest pluginexternal.Manifest, executable string) error {
+	var keys []ed25519.PublicKey
+	if manifest.Signature != "" {
+		var err error
+		if keys, err = external.LoadPublicKeys(m.FS, external.PublicKeysPath(m.Root)); err != nil {
+			return fmt.Errorf("error loading external plugins public keys: %w", err)
+		}
+	}
+	return external.VerifyExecutable(m.FS, manifest, executable, keys)
+}
+
+// isTarball checks if the provided file is a tarball based on its extension.
+func isTarball(file string) bool {
+	return strings.HasSuffix(file, ".tar") || strings.HasSuffix(file, ".tar.gz") || strings.HasSuffix(file, ".tgz")
+}
+
+// extractTarball extracts the regular files and directories of the tarball found in source into dir.
+func extractTarball(fs afero.Fs, source, dir string) error {
+	f, err := fs.Open(source)
+	if err != nil {
+		return fmt.Errorf("error opening tarball %q: %w", source, err)
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	var r io.Reader = f
+	if !strings.HasSuffix(source, ".tar") {
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			return fmt.Errorf("error reading tarball %q: %w", source, err)
+		}
+		defer func() {
+			_ = gz.Close()
+		}()
+		r = gz
+	}
+
+	tr := tar.NewReader(r)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("error reading tarball %q: %w", source, err)
+		}
+
+		name := filepath.Clean(filepath.FromSlash(header.Name))
+		if filepath.IsAbs(name) || name == ".." || strings.HasPrefix(name, ".."+string(filepath.Separator)) {
+			return fmt.Errorf("tarball %q contains an invalid path %q", source, header.Name)
+		}
+		path := filepath.Join(dir, name)
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err = fs.MkdirAll(path, 0o755); err != nil {
+				return fmt.Errorf("error creating directory %q: %w", path, err)
+			}
+		case tar.TypeReg:
+			if err = fs.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+				return fmt.Errorf("error creating directory %q: %w", filepath.Dir(path), err)
+			}
+			if err = writeFile(fs, path, tr, iofs.FileMode(header.Mode).Perm()); err != nil {
+				return err
+			}
+		default:
+			// Links and other special files are not needed by plugins.
+			continue
+		}
+	}
+}
+
+// tarballRoot returns the directory holding the extracted plugin, descending into the single
+// top-level directory of the tarball if there is one.
+func tarballRoot(fs afero.Fs, dir string) (string, error) {
+	entries, err := afero.ReadDir(fs, dir)
+	if err != nil {
+		return "", fmt.Errorf("error reading directory %q: %w", dir, err)
+	}
+	if len(entries) == 1 && entries[0].IsDir() {
+		return filepath.Join(dir, entries[0].Name()), nil
+	}
+	return dir, nil
+}
+
+// soleFile returns the path of the only file found in dir, besides the plugin manifest and the hidden files,
+// or an empty string if there are none or several.
+func soleFile(fs afero.Fs, dir string) (string, error) {
+	entries, err := afero.ReadDir(fs, dir)
+	if err != nil {
+		return "", fmt.Errorf("error reading directory %q: %w", dir, err)
+	}
+
+	file := ""
+	for _, entry := range entries {
+		if entry.IsDir() || entry.Name() == pluginexternal.ManifestFileName || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		if file != "" {
+			return "", nil
+		}
+		file = filepath.Join(dir, entry.Name())
+	}
+
+	return file, nil
+}
+
+// copyFile copies the file found in src to dst with the provided permissions.
+func copyFile(fs afero.Fs, src, dst string, mode iofs.FileMode) error {
+	f, err := fs.Open(src)
+	if err != nil {
+		return fmt.Errorf("error opening %q: %w", src, err)
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	return writeFile(fs, dst, f, mode)
+}
+
+// writeFile writes the contents of r to path with the provided permissions.
+func writeFile(fs afero.Fs, path string, r io.Reader, mode iofs.FileMode) error {
+	f, err := fs.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, mode)
+	if err != nil {
+		return fmt.Errorf("error creating %q: %w", path, err)
+	}
+
+	if _, err = io.Copy(f, r); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("error writing %q: %w", path, err)
+	}
+
+	if err = f.Close(); err != nil {
+		return fmt.Errorf("error closing %q: %w", path, err)
+	}
+
+	// The permissions are set explicitly as the file creation mode is subject to the umask.
+	if err = fs.Chmod(path, mode); err != nil {
+		return fmt.Errorf("error setting permissions of %q: %w", path, err)
+	}
+
+	return nil
+}
+
+// defaultString returns value, or def if value is empty.
+func defaultString(value, def string) string {
+	if value == "" {
+		return def
+	}
+	return value
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package plugins manages the external plugins installed in the plugins root directory,
+// which follows the `<root>/<name>/<version>/<executable>` layout.
+package plugins
+
+import (
+	"errors"
+	"fmt"
+	iofs "io/fs"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/external"
+)
+
+// Installed is an external plugin version installed in the plugins root directory.
+type Installed struct {
+	// Name is the name of the plugin.
+	Name string
+	// Version is the version of the plugin, e.g. v1.
+	Version string
+	// Path is the path of the plugin executable.
+	Path string
+}
+
+// Key returns the plugin key, e.g. sample/v1.
+func (i Installed) Key() string {
+	return path.Join(i.Name, i.Version)
+}
+
+// Manager installs, lists and removes the external plugins found in Root.
+type Manager struct {
+	// FS is the filesystem holding the plugins root directory.
+	FS afero.Fs
+	// Root is the plugins root directory.
+	Root string
+}
+
+// List returns the installed external plugins sorted by key.
+func (m Manager) List() ([]Installed, error) {
+	names, err := afero.ReadDir(m.FS, m.Root)
+	if err != nil {
+		if errors.Is(err, iofs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error reading plugins directory %q: %w", m.Root, err)
+	}
+
+	var installed []Installed
+	for _, name := range names {
+		// Hidden directories, such as the public keys directory, are not plugins.
+		if !name.IsDir() || strings.HasPrefix(name.Name(), ".") {
+			continue
+		}
+
+		versions, err := afero.ReadDir(m.FS, filepath.Join(m.Root, name.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("error reading plugin directory %q: %w", filepath.Join(m.Root, name.Name()), err)
+		}
+
+		for _, version := range versions {
+			if !version.IsDir() {
+				continue
+			}
+
+			dir := filepath.Join(m.Root, name.Name(), version.Name())
+			executable, err := findExecutable(m.FS, dir, name.Name())
+			if err != nil {
+				return nil, err
+			}
+			if executable == "" {
+				continue
+			}
+
+			installed = append(installed, Installed{Name: name.Name(), Version: version.Name(), Path: executable})
+		}
+	}
+
+	slices.SortFunc(installed, func(a, b Installed) int {
+		return strings.Compare(a.Key(), b.Key())
+	})
+
+	return installed, nil
+}
+
+// Get returns the installed plugin with the provided key, e.g. sample/v1.
+func (m Manager) Get(key string) (Installed, error) {
+	installed, err := m.List()
+	if err != nil {
+		return Installed{}, err
+	}
+
+	for _, i := range installed {
+		if i.Key() == key {
+			return i, nil
+		}
+	}
+
+	return Installed{}, fmt.Errorf("external plugin %q is not installed in %q", key, m.Root)
+}
+
+// Uninstall removes the installed plugin with the provided key. If the key has no version,
+// e.g. sample, every installed version of the plugin is removed. It returns the removed plugins.
+func (m Manager) Uninstall(key string) ([]Installed, error) {
+	name, version, err := splitKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	installed, err := m.List()
+	if err != nil {
+		return nil, err
+	}
+
+	var removed []Installed
+	for _, i := range installed {
+		if i.Name != name || (version != "" && i.Version != version) {
+			continue
+		}
+
+		if err = m.FS.RemoveAll(filepath.Dir(i.Path)); err != nil {
+			return removed, fmt.Errorf("error removing external plugin %q: %w", i.Key(), err)
+		}
+		removed = append(removed, i)
+	}
+
+	if len(removed) == 0 {
+		return nil, fmt.Errorf("external plugin %q is not installed in %q", key, m.Root)
+	}
+
+	// Remove the plugin directory once its last version is removed.
+	pluginDir := filepath.Join(m.Root, name)
+	if versions, err := afero.ReadDir(m.FS, pluginDir); err == nil && len(versions) == 0 {
+		if err = m.FS.Remove(pluginDir); err != nil {
+			return removed, fmt.Errorf("error removing directory %q: %w", pluginDir, err)
+		}
+	}
+
+	return removed, nil
+}
+
+// splitKey validates the plugin key and returns its name and version.
+func splitKey(key string) (string, string, error) {
+	if err := plugin.ValidateKey(key); err != nil {
+		return "", "", fmt.Errorf("invalid plugin key %q: %w", key, err)
+	}
+	name, version := plugin.SplitKey(key)
+	return name, version, nil
+}
+
+// findExecutable returns the path of the executable of the plugin named name found in dir, or an empty
+// string if there is none. The executable is named after the plugin, with an optional extension,
+// e.g. sample, sample.sh or sample.wasm.
+func findExecutable(fs afero.Fs, dir, name string) (string, error) {
+	files, err := afero.ReadDir(fs, dir)
+	if err != nil {
+		return "", fmt.Errorf("error reading directory %q: %w", dir, err)
+	}
+
+	for _, file := range files {
+		if file.IsDir() || file.Name() == external.ManifestFileName {
+			continue
+		}
+		if file.Name() == name || strings.Split(file.Name(), ".")[0] == name {
+			return filepath.Join(dir, file.Name()), nil
+		}
+	}
+
+	return "", nil
+}
@@ -0,0 +1,224 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugins
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+
+	cfgv3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
+)
+
+const (
+	validPlugin = `#!/bin/sh
+cat > /dev/null
+echo '{"apiVersion":"v1alpha1","command":"metadata","metadata":{"description":"sample"}}'
+`
+	invalidPlugin = `#!/bin/sh
+cat > /dev/null
+echo 'not a plugin'
+`
+)
+
+var _ = Describe("Manager", func() {
+	var (
+		manager Manager
+		srcDir  string
+	)
+
+	writeFile := func(path, content string, mode os.FileMode) {
+		Expect(os.MkdirAll(filepath.Dir(path), 0o755)).To(Succeed())
+		Expect(os.WriteFile(path, []byte(content), mode)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		tmp := GinkgoT().TempDir()
+		manager = Manager{FS: afero.NewOsFs(), Root: filepath.Join(tmp, "plugins")}
+		srcDir = filepath.Join(tmp, "src")
+		writeFile(filepath.Join(srcDir, "sample"), validPlugin, 0o755)
+	})
+
+	Context("Install", func() {
+		It("should install a plugin from a directory", func() {
+			installed, err := manager.Install(srcDir, InstallOptions{Version: "v1"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(installed.Key()).To(Equal("sample/v1"))
+			Expect(installed.Path).To(Equal(filepath.Join(manager.Root, "sample", "v1", "sample")))
+
+			info, err := os.Stat(installed.Path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(0o755)))
+		})
+
+		It("should install a plugin executable under the provided name", func() {
+			writeFile(filepath.Join(srcDir, "bin", "plugin.sh"), validPlugin, 0o644)
+
+			installed, err := manager.Install(filepath.Join(srcDir, "bin", "plugin.sh"),
+				InstallOptions{Name: "monitoring", Version: "v2"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(installed.Path).To(Equal(filepath.Join(manager.Root, "monitoring", "v2", "monitoring.sh")))
+		})
+
+		It("should install a plugin from a tarball", func() {
+			buf := &bytes.Buffer{}
+			gz := gzip.NewWriter(buf)
+			tw := tar.NewWriter(gz)
+			Expect(tw.WriteHeader(&tar.Header{Name: "sample/", Typeflag: tar.TypeDir, Mode: 0o755})).To(Succeed())
+			Expect(tw.WriteHeader(&tar.Header{
+				Name: "sample/sample", Typeflag: tar.TypeReg, Mode: 0o755, Size: int64(len(validPlugin)),
+			})).To(Succeed())
+			_, err := tw.Write([]byte(validPlugin))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tw.Close()).To(Succeed())
+			Expect(gz.Close()).To(Succeed())
+
+			tarball := filepath.Join(srcDir, "..", "sample.tar.gz")
+			writeFile(tarball, buf.String(), 0o644)
+
+			installed, err := manager.Install(tarball, InstallOptions{Version: "v1"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(installed.Key()).To(Equal("sample/v1"))
+		})
+
+		It("should use the name and the version declared in the manifest", func() {
+			sum := sha256.Sum256([]byte(validPlugin))
+			writeFile(filepath.Join(srcDir, "plugin.yaml"),
+				"name: sample\nversion: v3\nsha256: "+hex.EncodeToString(sum[:])+"\n", 0o644)
+
+			installed, err := manager.Install(srcDir, InstallOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(installed.Key()).To(Equal("sample/v3"))
+			Expect(filepath.Join(manager.Root, "sample", "v3", "plugin.yaml")).To(BeAnExistingFile())
+		})
+
+		It("should refuse a plugin that does not match its manifest", func() {
+			writeFile(filepath.Join(srcDir, "plugin.yaml"), "name: sample\nversion: v1\nsha256: abc\n", 0o644)
+
+			_, err := manager.Install(srcDir, InstallOptions{})
+			Expect(err).To(MatchError(ContainSubstring("checksum mismatch")))
+			Expect(filepath.Join(manager.Root, "sample")).NotTo(BeADirectory())
+		})
+
+		It("should refuse a plugin that fails the metadata handshake", func() {
+			writeFile(filepath.Join(srcDir, "sample"), invalidPlugin, 0o755)
+
+			_, err := manager.Install(srcDir, InstallOptions{Version: "v1"})
+			Expect(err).To(MatchError(ContainSubstring("did not answer the metadata request")))
+			Expect(filepath.Join(manager.Root, "sample")).NotTo(BeADirectory())
+		})
+
+		It("should refuse invalid plugin keys", func() {
+			_, err := manager.Install(srcDir, InstallOptions{Version: "1.0"})
+			Expect(err).To(MatchError(ContainSubstring("invalid plugin key")))
+		})
+
+		It("should only replace an installed version when forced", func() {
+			_, err := manager.Install(srcDir, InstallOptions{Version: "v1"})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = manager.Install(srcDir, InstallOptions{Version: "v1"})
+			Expect(err).To(MatchError(ContainSubstring("already installed")))
+
+			_, err = manager.Install(srcDir, InstallOptions{Version: "v1", Force: true})
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Context("List and Uninstall", func() {
+		BeforeEach(func() {
+			for _, version := range []string{"v1", "v2"} {
+				_, err := manager.Install(srcDir, InstallOptions{Version: version})
+				Expect(err).NotTo(HaveOccurred())
+			}
+			Expect(os.MkdirAll(filepath.Join(manager.Root, ".keys"), 0o755)).To(Succeed())
+		})
+
+		It("should list the installed versions", func() {
+			installed, err := manager.List()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(installed).To(HaveLen(2))
+			Expect(installed[0].Key()).To(Equal("sample/v1"))
+			Expect(installed[1].Key()).To(Equal("sample/v2"))
+		})
+
+		It("should remove a single version", func() {
+			removed, err := manager.Uninstall("sample/v1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(removed).To(HaveLen(1))
+
+			installed, err := manager.List()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(installed).To(HaveLen(1))
+			Expect(installed[0].Key()).To(Equal("sample/v2"))
+		})
+
+		It("should remove all the versions", func() {
+			removed, err := manager.Uninstall("sample")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(removed).To(HaveLen(2))
+			Expect(filepath.Join(manager.Root, "sample")).NotTo(BeADirectory())
+		})
+
+		It("should fail for plugins that are not installed", func() {
+			_, err := manager.Uninstall("other/v1")
+			Expect(err).To(MatchError(ContainSubstring("is not installed")))
+		})
+	})
+
+	Context("Pin", func() {
+		BeforeEach(func() {
+			_, err := manager.Install(srcDir, InstallOptions{Version: "v2"})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should replace the plugin version in the project layout", func() {
+			cfg := cfgv3.New()
+			Expect(cfg.SetPluginChain([]string{"go.kubebuilder.io/v4", "sample/v1"})).To(Succeed())
+			Expect(cfg.EncodePluginConfig("sample/v1", map[string]any{"monitoring": true})).To(Succeed())
+
+			Expect(manager.Pin(cfg, "sample/v2")).To(Succeed())
+			Expect(cfg.GetPluginChain()).To(Equal([]string{"go.kubebuilder.io/v4", "sample/v2"}))
+
+			pluginConfig := map[string]any{}
+			Expect(cfg.DecodePluginConfig("sample/v2", &pluginConfig)).To(Succeed())
+			Expect(pluginConfig).To(HaveKeyWithValue("monitoring", true))
+		})
+
+		It("should fail if the version is not installed", func() {
+			cfg := cfgv3.New()
+			Expect(cfg.SetPluginChain([]string{"sample/v1"})).To(Succeed())
+
+			Expect(manager.Pin(cfg, "sample/v3")).To(MatchError(ContainSubstring("is not installed")))
+		})
+
+		It("should fail if the plugin is not in the project layout", func() {
+			cfg := cfgv3.New()
+			Expect(cfg.SetPluginChain([]string{"go.kubebuilder.io/v4"})).To(Succeed())
+
+			Expect(manager.Pin(cfg, "sample/v2")).To(MatchError(ContainSubstring("is not in the project layout")))
+		})
+	})
+})
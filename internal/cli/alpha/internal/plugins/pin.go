@@ -0,0 +1,90 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugins
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+)
+
+// Pin makes the project use the installed plugin version with the provided key, e.g. sample/v2,
+// by replacing the version of the plugin in the project layout. The plugin configuration stored
+// under the previous key is copied to the new key, unless it already exists.
+func (m Manager) Pin(cfg config.Config, key string) error {
+	name, version, err := splitKey(key)
+	if err != nil {
+		return err
+	}
+	if version == "" {
+		return fmt.Errorf("plugin key %q must include the version to pin", key)
+	}
+
+	if _, err = m.Get(key); err != nil {
+		return err
+	}
+
+	chain := slices.Clone(cfg.GetPluginChain())
+	previous := ""
+	for i, pluginKey := range chain {
+		if pluginName, _ := plugin.SplitKey(pluginKey); pluginName == name {
+			previous, chain[i] = pluginKey, key
+		}
+	}
+	if previous == "" {
+		return fmt.Errorf("external plugin %q is not in the project layout %q", name, cfg.GetPluginChain())
+	}
+
+	if err = cfg.SetPluginChain(chain); err != nil {
+		return fmt.Errorf("error setting the project layout: %w", err)
+	}
+
+	if previous == key {
+		return nil
+	}
+
+	return movePluginConfig(cfg, previous, key)
+}
+
+// movePluginConfig copies the plugin configuration stored under from to the key to, unless it already exists.
+func movePluginConfig(cfg config.Config, from, to string) error {
+	var keyNotFoundErr config.PluginKeyNotFoundError
+
+	var existing map[string]any
+	if err := cfg.DecodePluginConfig(to, &existing); err == nil {
+		return nil
+	} else if !errors.As(err, &keyNotFoundErr) {
+		return fmt.Errorf("error reading the plugin config for %q: %w", to, err)
+	}
+
+	var pluginConfig map[string]any
+	if err := cfg.DecodePluginConfig(from, &pluginConfig); err != nil {
+		if errors.As(err, &keyNotFoundErr) {
+			return nil
+		}
+		return fmt.Errorf("error reading the plugin config for %q: %w", from, err)
+	}
+
+	if err := cfg.EncodePluginConfig(to, pluginConfig); err != nil {
+		return fmt.Errorf("error writing the plugin config for %q: %w", to, err)
+	}
+
+	return nil
+}
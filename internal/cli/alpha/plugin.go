@@ -0,0 +1,185 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alpha
+
+import (
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+
+	"sigs.k8s.io/kubebuilder/v4/internal/cli/alpha/internal/plugins"
+	"sigs.k8s.io/kubebuilder/v4/pkg/config/store/yaml"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+)
+
+// NewPluginCommand returns the `kubebuilder alpha plugin` command group, which manages the external
+// plugins installed in the plugins root directory returned by getPluginsRoot.
+func NewPluginCommand(getPluginsRoot func() (string, error)) *cobra.Command {
+	manager := &plugins.Manager{FS: afero.NewOsFs()}
+
+	cmd := &cobra.Command{
+		Use:   "plugin",
+		Short: "Manage the installed external plugins",
+		Long: `Install, list and remove the external plugins found in the plugins root directory,
+and pin the project to one of the installed plugin versions.
+
+The plugins root directory is $EXTERNAL_PLUGINS_PATH if set, otherwise:
+  • Linux: $XDG_CONFIG_HOME/kubebuilder/plugins or ~/.config/kubebuilder/plugins
+  • macOS: ~/Library/Application Support/kubebuilder/plugins`,
+		PersistentPreRunE: func(_ *cobra.Command, _ []string) error {
+			root, err := getPluginsRoot()
+			if err != nil {
+				return fmt.Errorf("could not get plugins root: %w", err)
+			}
+			manager.Root = root
+			return nil
+		},
+	}
+
+	cmd.AddCommand(
+		newPluginInstallCommand(manager),
+		newPluginListCommand(manager),
+		newPluginUninstallCommand(manager),
+		newPluginPinCommand(manager),
+	)
+
+	return cmd
+}
+
+func newPluginInstallCommand(manager *plugins.Manager) *cobra.Command {
+	opts := plugins.InstallOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "install <source>",
+		Short: "Install an external plugin from a directory, an executable or a tarball",
+		Long: `Install an external plugin into <plugins root>/<name>/<version>.
+
+The source can be a directory holding the plugin executable and its optional plugin.yaml manifest,
+the plugin executable itself, or a tarball (.tar, .tar.gz or .tgz) of such a directory.
+The name and the version default to the ones declared in the manifest.
+
+The plugin is only installed if it passes the manifest checks and answers a metadata request.`,
+		Example: `
+  # Install the plugin built in ./bin, described by ./bin/plugin.yaml
+  kubebuilder alpha plugin install ./bin
+
+  # Install a plugin executable without manifest
+  kubebuilder alpha plugin install ./sample --version v1
+
+  # Replace an installed version with a released tarball
+  kubebuilder alpha plugin install ./sample-v1.tar.gz --force`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			installed, err := manager.Install(args[0], opts)
+			if err != nil {
+				return fmt.Errorf("failed to install external plugin: %w", err)
+			}
+			_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Installed external plugin %q in %s\n", installed.Key(), installed.Path)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Name, "name", "",
+		"name of the plugin. Defaults to the name declared in the manifest or to the name of the executable")
+	cmd.Flags().StringVar(&opts.Version, "version", "",
+		"version of the plugin, e.g. v1. Defaults to the version declared in the manifest")
+	cmd.Flags().BoolVar(&opts.Force, "force", false, "replace the plugin version if it is already installed")
+
+	return cmd
+}
+
+func newPluginListCommand(manager *plugins.Manager) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List the installed external plugins",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			installed, err := manager.List()
+			if err != nil {
+				return fmt.Errorf("failed to list external plugins: %w", err)
+			}
+
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 4, 2, ' ', 0)
+			_, _ = fmt.Fprintln(w, "PLUGIN\tVERSION\tPATH")
+			for _, i := range installed {
+				_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", i.Name, i.Version, i.Path)
+			}
+			if err = w.Flush(); err != nil {
+				return fmt.Errorf("failed to print external plugins: %w", err)
+			}
+			return nil
+		},
+	}
+}
+
+func newPluginUninstallCommand(manager *plugins.Manager) *cobra.Command {
+	return &cobra.Command{
+		Use:   "uninstall <name>[/<version>]",
+		Short: "Remove an installed external plugin",
+		Long:  `Remove an installed version of an external plugin, or all of its versions if no version is provided.`,
+		Example: `
+  # Remove the version v1 of the sample plugin
+  kubebuilder alpha plugin uninstall sample/v1
+
+  # Remove all the versions of the sample plugin
+  kubebuilder alpha plugin uninstall sample`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			removed, err := manager.Uninstall(args[0])
+			for _, i := range removed {
+				_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Uninstalled external plugin %q\n", i.Key())
+			}
+			if err != nil {
+				return fmt.Errorf("failed to uninstall external plugin: %w", err)
+			}
+			return nil
+		},
+	}
+}
+
+func newPluginPinCommand(manager *plugins.Manager) *cobra.Command {
+	return &cobra.Command{
+		Use:   "pin <name>/<version>",
+		Short: "Pin the project to an installed version of an external plugin",
+		Long: `Replace the version of an external plugin in the layout of the PROJECT file found in the current
+directory, so that the project uses the provided installed version. The plugin configuration stored
+under the previous version is copied to the new one.`,
+		Example: `
+  # Use the version v2 of the sample plugin in the project
+  kubebuilder alpha plugin pin sample/v2`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			store := yaml.New(machinery.Filesystem{FS: afero.NewOsFs()})
+			if err := store.Load(); err != nil {
+				return fmt.Errorf("failed to load PROJECT file: %w", err)
+			}
+
+			if err := manager.Pin(store.Config(), args[0]); err != nil {
+				return fmt.Errorf("failed to pin external plugin: %w", err)
+			}
+
+			if err := store.Save(); err != nil {
+				return fmt.Errorf("failed to save PROJECT file: %w", err)
+			}
+
+			_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Pinned the project to external plugin %q\n", args[0])
+			return nil
+		},
+	}
+}
@@ -18,6 +18,7 @@ package cli
 
 import (
 	"fmt"
+	"runtime"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -26,13 +27,17 @@ import (
 )
 
 const (
-	alphaCommand = "alpha"
+	alphaCommand       = "alpha"
+	alphaPluginCommand = "plugin"
 )
 
 var alphaCommands = []*cobra.Command{
 	newAlphaCommand(),
 	alpha.NewScaffoldCommand(),
 	alpha.NewUpdateCommand(),
+	alpha.NewPluginCommand(func() (string, error) {
+		return retrievePluginsRoot(runtime.GOOS)
+	}),
 }
 
 func newAlphaCommand() *cobra.Command {
@@ -171,7 +171,14 @@ func (c *CLI) buildCmd() error {
 
 	// Resolve plugins for project version and plugin keys.
 	if err := c.resolvePlugins(); err != nil {
-		return err
+		// The external plugins management commands are used to fix a project whose plugins are not
+		// installed, so they are available even if the plugins cannot be resolved.
+		if !isAlphaCommand(os.Args[1:], alphaPluginCommand) {
+			return err
+		}
+		log.Warn("unable to resolve the project plugins", "error", err)
+		c.addAlphaCmd()
+		return nil
 	}
 
 	// Add the subcommands
@@ -220,7 +227,7 @@ func (c *CLI) getInfoFromConfigFile() error {
 	// before the CLI tries to load it. This avoids errors during config loading
 	// and lets users migrate their project layout from go/v3 to go/v4.
 
-	if isAlphaGenerateCommand(os.Args[1:]) {
+	if isAlphaCommand(os.Args[1:], "generate") {
 		// Patch raw file bytes before unmarshalling
 		if err := patchProjectFileInMemoryIfNeeded(c.fs.FS, yamlstore.DefaultPath); err != nil {
 			return err
@@ -234,9 +241,9 @@ func (c *CLI) getInfoFromConfigFile() error {
 	return c.getInfoFromConfig(cfg.Config())
 }
 
-// isAlphaGenerateCommand checks if the command invocation is `kubebuilder alpha generate`
-// by scanning os.Args (excluding global flags). It returns true if "alpha" is followed by "generate".
-func isAlphaGenerateCommand(args []string) bool {
+// isAlphaCommand checks if the command invocation is `kubebuilder alpha <subcommand>`
+// by scanning os.Args (excluding global flags). It returns true if "alpha" is followed by subcommand.
+func isAlphaCommand(args []string, subcommand string) bool {
 	positional := []string{}
 	skip := false
 
@@ -262,9 +269,9 @@ func isAlphaGenerateCommand(args []string) bool {
 		positional = append(positional, arg)
 	}
 
-	// Check for `alpha generate` in positional arguments
+	// Check for `alpha <subcommand>` in positional arguments
 	for i := 0; i < len(positional)-1; i++ {
-		if positional[i] == "alpha" && positional[i+1] == "generate" {
+		if positional[i] == alphaCommand && positional[i+1] == subcommand {
 			return true
 		}
 	}
@@ -555,7 +562,10 @@ func (c *CLI) addSubcommands() {
 	}
 
 	// kubebuilder edit
-	c.cmd.AddCommand(c.newEditCmd())
+	editCmd := c.newEditCmd()
+	// kubebuilder edit api
+	editCmd.AddCommand(c.newEditAPICmd())
+	c.cmd.AddCommand(editCmd)
 
 	// kubebuilder init
 	c.cmd.AddCommand(c.newInitCmd())
@@ -565,6 +575,9 @@ func (c *CLI) addSubcommands() {
 	if c.version != "" {
 		c.cmd.AddCommand(c.newVersionCmd())
 	}
+
+	// Subcommands provided by plugins, e.g. kubebuilder create policy
+	c.addExtraSubcommands()
 }
 
 // addExtraCommands adds the additional commands.
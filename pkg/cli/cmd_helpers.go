@@ -125,6 +125,18 @@ func (c *CLI) applySubcommandHooks(
 	subcommands []keySubcommandTuple,
 	errorMessage string,
 	createConfig bool,
+) {
+	c.applySubcommandHooksWithAccess(cmd, subcommands, errorMessage, createConfig, plugin.ProjectAccessWrite)
+}
+
+// applySubcommandHooksWithAccess is applySubcommandHooks for subcommands that only need the provided
+// access to the project configuration, such as the extra subcommands provided by plugins.
+func (c *CLI) applySubcommandHooksWithAccess(
+	cmd *cobra.Command,
+	subcommands []keySubcommandTuple,
+	errorMessage string,
+	createConfig bool,
+	projectAccess plugin.ProjectAccess,
 ) {
 	commandPluginChain := make([]string, len(subcommands))
 	for i, tuple := range subcommands {
@@ -161,6 +173,7 @@ func (c *CLI) applySubcommandHooks(
 		pluginChain:         pluginChain,
 		cliVersion:          c.cliVersion,
 		duplicateFlagValues: result.duplicateFlagValues,
+		projectAccess:       projectAccess,
 	}
 	cmd.PreRunE = factory.preRunEFunc(result.options, createConfig)
 	cmd.RunE = factory.runEFunc()
@@ -305,6 +318,9 @@ type executionHooksFactory struct {
 	cliVersion string
 	// duplicateFlagValues maps flag names to Values to sync from the parsed flag in PreRunE.
 	duplicateFlagValues map[string][]pflag.Value
+	// projectAccess is the access to the project configuration needed by the subcommands.
+	// The configuration is not loaded for plugin.ProjectAccessNone, and only saved for plugin.ProjectAccessWrite.
+	projectAccess plugin.ProjectAccess
 }
 
 func (factory *executionHooksFactory) forEach(cb func(subcommand plugin.Subcommand) error, errorMessage string) error {
@@ -415,7 +431,7 @@ func (factory *executionHooksFactory) preRunEFunc(
 			if err := factory.store.New(factory.projectVersion); err != nil {
 				return fmt.Errorf("%s: error initializing project configuration: %w", factory.errorMessage, err)
 			}
-		} else {
+		} else if factory.projectAccess != plugin.ProjectAccessNone {
 			// Load the project configuration.
 			if err := factory.store.Load(); os.IsNotExist(err) {
 				return fmt.Errorf("%s: failed to find configuration file, project must be initialized",
@@ -449,7 +465,7 @@ func (factory *executionHooksFactory) preRunEFunc(
 
 		// Inject config hook.
 		if err := factory.forEach(func(subcommand plugin.Subcommand) error {
-			if subcommand, requiresConfig := subcommand.(plugin.RequiresConfig); requiresConfig {
+			if subcommand, requiresConfig := subcommand.(plugin.RequiresConfig); requiresConfig && cfg != nil {
 				return subcommand.InjectConfig(cfg)
 			}
 			return nil
@@ -507,8 +523,10 @@ func (factory *executionHooksFactory) runEFunc() func(*cobra.Command, []string)
 // and executes the post-scaffold hook.
 func (factory *executionHooksFactory) postRunEFunc() func(*cobra.Command, []string) error {
 	return func(*cobra.Command, []string) error {
-		if err := factory.store.Save(); err != nil {
-			return fmt.Errorf("%s: failed to save configuration file: %w", factory.errorMessage, err)
+		if factory.projectAccess == plugin.ProjectAccessWrite {
+			if err := factory.store.Save(); err != nil {
+				return fmt.Errorf("%s: failed to save configuration file: %w", factory.errorMessage, err)
+			}
 		}
 
 		// Post-scaffold hook.
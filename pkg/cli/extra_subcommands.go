@@ -0,0 +1,167 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	"fmt"
+	log "log/slog"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+)
+
+// extraSubcommandTuple pairs an extra subcommand with the key of the plugin that provides it.
+type extraSubcommandTuple struct {
+	key       string
+	configKey string
+	extra     plugin.ExtraSubcommand
+}
+
+// addExtraSubcommands registers the subcommands that the resolved plugins provide in addition to
+// init, create api, create webhook and edit. The subcommands declared with the same command by several
+// plugins are chained like the other subcommands, following the order of the resolved plugins.
+func (c *CLI) addExtraSubcommands() {
+	var commands []string
+	tuplesByCommand := make(map[string][]extraSubcommandTuple)
+	for _, p := range c.resolvedPlugins {
+		for _, tuple := range collectExtraSubcommands(p, plugin.KeyFor(p)) {
+			command := strings.Join(strings.Fields(tuple.extra.Command), " ")
+			if command == "" {
+				log.Warn("ignoring extra subcommand without command", "plugin", tuple.key)
+				continue
+			}
+			if err := tuple.extra.ProjectAccess.Validate(); err != nil {
+				log.Warn("ignoring extra subcommand", "plugin", tuple.key, "subcommand", command, "error", err)
+				continue
+			}
+
+			if _, found := tuplesByCommand[command]; !found {
+				commands = append(commands, command)
+			}
+			tuplesByCommand[command] = append(tuplesByCommand[command], tuple)
+		}
+	}
+
+	for _, command := range commands {
+		c.addExtraSubcommand(command, tuplesByCommand[command])
+	}
+}
+
+func collectExtraSubcommands(p plugin.Plugin, configKey string) []extraSubcommandTuple {
+	if bundle, isBundle := p.(plugin.Bundle); isBundle {
+		var collected []extraSubcommandTuple
+		for _, nested := range bundle.Plugins() {
+			collected = append(collected, collectExtraSubcommands(nested, configKey)...)
+		}
+		return collected
+	}
+
+	hasExtraSubcommands, isValid := p.(plugin.HasExtraSubcommands)
+	if !isValid {
+		return nil
+	}
+
+	extras := hasExtraSubcommands.GetExtraSubcommands()
+	collected := make([]extraSubcommandTuple, 0, len(extras))
+	for _, extra := range extras {
+		collected = append(collected, extraSubcommandTuple{key: plugin.KeyFor(p), configKey: configKey, extra: extra})
+	}
+	return collected
+}
+
+// addExtraSubcommand registers the subcommand provided by the tuples under its parent commands,
+// creating them if needed. Subcommands that already exist cannot be overridden.
+func (c *CLI) addExtraSubcommand(command string, tuples []extraSubcommandTuple) {
+	path := strings.Fields(command)
+
+	parent := c.cmd
+	for _, name := range path[:len(path)-1] {
+		child := findSubcommand(parent, name)
+		if child == nil {
+			child = &cobra.Command{
+				Use:   name,
+				Short: fmt.Sprintf("Subcommands of %q provided by plugins", name),
+			}
+			parent.AddCommand(child)
+		}
+		parent = child
+	}
+
+	name := path[len(path)-1]
+	if findSubcommand(parent, name) != nil {
+		keys := make([]string, 0, len(tuples))
+		for _, tuple := range tuples {
+			keys = append(keys, tuple.key)
+		}
+		log.Warn("ignoring extra subcommand, the command already exists", "subcommand", command, "plugins", keys)
+		return
+	}
+
+	cmd := &cobra.Command{Use: name}
+	projectAccess := plugin.ProjectAccessNone
+	subcommands := make([]keySubcommandTuple, 0, len(tuples))
+	for _, tuple := range tuples {
+		if cmd.Short == "" {
+			cmd.Short = tuple.extra.Short
+		}
+		projectAccess = maxProjectAccess(projectAccess, tuple.extra.ProjectAccess)
+		subcommands = append(subcommands, keySubcommandTuple{
+			key:        tuple.key,
+			configKey:  tuple.configKey,
+			subcommand: tuple.extra.Subcommand,
+		})
+	}
+	cmd.Long = cmd.Short
+	parent.AddCommand(cmd)
+
+	if projectAccess == plugin.ProjectAccessNone {
+		for _, tuple := range subcommands {
+			if _, requiresResource := tuple.subcommand.(plugin.RequiresResource); requiresResource {
+				cmdErr(cmd, fmt.Errorf("plugin %q requires a resource, which needs access to the project configuration",
+					tuple.key))
+				return
+			}
+		}
+	}
+
+	c.applySubcommandHooksWithAccess(cmd, subcommands, fmt.Sprintf("failed to run %q", command), false, projectAccess)
+}
+
+// findSubcommand returns the direct subcommand of cmd with the provided name or alias, or nil if there is none.
+func findSubcommand(cmd *cobra.Command, name string) *cobra.Command {
+	for _, subCmd := range cmd.Commands() {
+		if subCmd.Name() == name || subCmd.HasAlias(name) {
+			return subCmd
+		}
+	}
+	return nil
+}
+
+// maxProjectAccess returns the widest of the provided project accesses.
+func maxProjectAccess(a, b plugin.ProjectAccess) plugin.ProjectAccess {
+	order := map[plugin.ProjectAccess]int{
+		plugin.ProjectAccessNone:  0,
+		plugin.ProjectAccessRead:  1,
+		plugin.ProjectAccessWrite: 2,
+	}
+	if order[b] > order[a] {
+		return b
+	}
+	return a
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	yamlstore "sigs.k8s.io/kubebuilder/v4/pkg/config/store/yaml"
+	cfgv3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+)
+
+type extraSubcommandsPlugin struct {
+	extras []plugin.ExtraSubcommand
+}
+
+func (p extraSubcommandsPlugin) Name() string            { return "extra.example.com" }
+func (p extraSubcommandsPlugin) Version() plugin.Version { return plugin.Version{Number: 1} }
+func (p extraSubcommandsPlugin) SupportedProjectVersions() []config.Version {
+	return []config.Version{{Number: 3}}
+}
+func (p extraSubcommandsPlugin) GetExtraSubcommands() []plugin.ExtraSubcommand {
+	return p.extras
+}
+
+type recordingSubcommand struct {
+	name     string
+	cfg      config.Config
+	scaffold bool
+}
+
+func (s *recordingSubcommand) BindFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&s.name, "name", "", "name of the policy")
+}
+
+func (s *recordingSubcommand) InjectConfig(c config.Config) error {
+	s.cfg = c
+	return nil
+}
+
+func (s *recordingSubcommand) Scaffold(machinery.Filesystem) error {
+	s.scaffold = true
+	return nil
+}
+
+type resourceSubcommand struct{}
+
+func (s *resourceSubcommand) InjectResource(*resource.Resource) error { return nil }
+func (s *resourceSubcommand) Scaffold(machinery.Filesystem) error     { return nil }
+
+var _ = Describe("addExtraSubcommands", func() {
+	var (
+		c              *CLI
+		policy, audit  *recordingSubcommand
+		createCmd      *cobra.Command
+		extraPluginKey = "extra.example.com/v1"
+	)
+
+	BeforeEach(func() {
+		policy = &recordingSubcommand{}
+		audit = &recordingSubcommand{}
+
+		c = &CLI{
+			commandName: "kubebuilder",
+			fs:          machinery.Filesystem{FS: afero.NewMemMapFs()},
+			cmd:         &cobra.Command{Use: "kubebuilder"},
+			resolvedPlugins: []plugin.Plugin{extraSubcommandsPlugin{extras: []plugin.ExtraSubcommand{
+				{
+					Command:       "create  policy",
+					Short:         "Scaffold a policy",
+					ProjectAccess: plugin.ProjectAccessWrite,
+					Subcommand:    policy,
+				},
+				{Command: "audit run", Short: "Audit the project", ProjectAccess: plugin.ProjectAccessNone, Subcommand: audit},
+				{Command: "create api", ProjectAccess: plugin.ProjectAccessWrite, Subcommand: &recordingSubcommand{}},
+				{Command: "", ProjectAccess: plugin.ProjectAccessWrite, Subcommand: &recordingSubcommand{}},
+				{Command: "create invalid", ProjectAccess: "admin", Subcommand: &recordingSubcommand{}},
+			}}},
+		}
+
+		createCmd = &cobra.Command{Use: "create"}
+		createCmd.AddCommand(&cobra.Command{Use: "api"})
+		c.cmd.AddCommand(createCmd)
+	})
+
+	It("should register the extra subcommands under their parent commands", func() {
+		c.addExtraSubcommands()
+
+		Expect(hasSubCommand(createCmd, "policy")).To(BeTrue())
+		Expect(hasSubCommand(createCmd, "invalid")).To(BeFalse())
+		Expect(createCmd.Commands()).To(HaveLen(2))
+
+		auditCmd := findSubcommand(c.cmd, "audit")
+		Expect(auditCmd).NotTo(BeNil())
+		Expect(hasSubCommand(auditCmd, "run")).To(BeTrue())
+		Expect(findSubcommand(auditCmd, "run").Short).To(Equal("Audit the project"))
+	})
+
+	It("should run subcommands without project access outside a project", func() {
+		c.addExtraSubcommands()
+
+		c.cmd.SetArgs([]string{"audit", "run"})
+		Expect(c.cmd.Execute()).To(Succeed())
+		Expect(audit.scaffold).To(BeTrue())
+		Expect(audit.cfg).To(BeNil())
+	})
+
+	It("should require a project for subcommands with project access", func() {
+		c.addExtraSubcommands()
+
+		c.cmd.SetArgs([]string{"create", "policy", "--name", "sample"})
+		Expect(c.cmd.Execute()).To(MatchError(ContainSubstring("failed to load configuration file")))
+		Expect(policy.scaffold).To(BeFalse())
+	})
+
+	It("should inject the project configuration and save it", func() {
+		store := yamlstore.New(c.fs)
+		Expect(store.New(cfgv3.Version)).To(Succeed())
+		Expect(store.Config().SetDomain("my.domain")).To(Succeed())
+		Expect(store.Save()).To(Succeed())
+
+		c.addExtraSubcommands()
+
+		c.cmd.SetArgs([]string{"create", "policy", "--name", "sample"})
+		Expect(c.cmd.Execute()).To(Succeed())
+		Expect(policy.scaffold).To(BeTrue())
+		Expect(policy.name).To(Equal("sample"))
+		Expect(policy.cfg.GetDomain()).To(Equal("my.domain"))
+	})
+
+	It("should refuse subcommands requiring a resource without project access", func() {
+		c.resolvedPlugins = []plugin.Plugin{extraSubcommandsPlugin{extras: []plugin.ExtraSubcommand{
+			{Command: "create dashboard", ProjectAccess: plugin.ProjectAccessNone, Subcommand: &resourceSubcommand{}},
+		}}}
+
+		c.addExtraSubcommands()
+
+		c.cmd.SetArgs([]string{"create", "dashboard"})
+		Expect(c.cmd.Execute()).To(MatchError(ContainSubstring(extraPluginKey)))
+	})
+})
@@ -17,6 +17,7 @@ limitations under the License.
 package cli
 
 import (
+	"crypto/ed25519"
 	"errors"
 	"fmt"
 	"io/fs"
@@ -26,6 +27,7 @@ import (
 	"runtime"
 	"slices"
 	"strings"
+	"sync"
 
 	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
@@ -244,6 +246,7 @@ func getPluginsRoot(host string) (pluginsRoot string, err error) {
 
 // DiscoverExternalPlugins discovers the external plugins in the plugins root directory
 // and adds them to external.Plugin.
+// Plugins can be executables or WebAssembly modules (`<name>.wasm`), which are run in a sandbox.
 func DiscoverExternalPlugins(filesystem afero.Fs) (ps []plugin.Plugin, err error) {
 	pluginsRoot, err := retrievePluginsRoot(runtime.GOOS)
 	if err != nil {
@@ -269,12 +272,23 @@ func DiscoverExternalPlugins(filesystem afero.Fs) (ps []plugin.Plugin, err error
 		return nil, fmt.Errorf("error reading plugins directory %q: %w", pluginsRoot, err)
 	}
 
+	// Public keys are only loaded once, and only if a plugin manifest declares a signature.
+	loadPublicKeys := sync.OnceValues(func() ([]ed25519.PublicKey, error) {
+		return external.LoadPublicKeys(filesystem, external.PublicKeysPath(pluginsRoot))
+	})
+
 	for _, pluginInfo := range pluginInfos {
 		if !pluginInfo.IsDir() {
 			slog.Debug("skipping parsing, not a directory", "name", pluginInfo.Name())
 			continue
 		}
 
+		// Hidden directories, such as the default public keys directory, are not plugins.
+		if strings.HasPrefix(pluginInfo.Name(), ".") {
+			slog.Debug("skipping parsing, hidden directory", "name", pluginInfo.Name())
+			continue
+		}
+
 		versions, err := afero.ReadDir(filesystem, filepath.Join(pluginsRoot, pluginInfo.Name()))
 		if err != nil {
 			return nil, fmt.Errorf("error reading plugin directory %s: %w",
@@ -289,7 +303,7 @@ func DiscoverExternalPlugins(filesystem afero.Fs) (ps []plugin.Plugin, err error
 
 			pluginFiles, err := afero.ReadDir(filesystem, filepath.Join(pluginsRoot, pluginInfo.Name(), version.Name()))
 			if err != nil {
-				return nil, fmt.Errorf("error reading plugion version directory %q: %w",
+				return nil, fmt.Errorf("error reading plugin version directory %q: %w",
 					filepath.Join(pluginsRoot, pluginInfo.Name(), version.Name()), err)
 			}
 
@@ -305,19 +319,23 @@ func DiscoverExternalPlugins(filesystem afero.Fs) (ps []plugin.Plugin, err error
 
 				if pluginFile.Name() == pluginInfo.Name() || trimmedPluginName[0] == pluginInfo.Name() {
 					// check whether the external plugin is an executable.
-					if !isPluginExecutable(pluginFile.Mode()) {
+					// WebAssembly modules are run in-process, so they do not need to be executables.
+					if !external.IsWasmPlugin(pluginFile.Name()) && !isPluginExecutable(pluginFile.Mode()) {
 						return nil, fmt.Errorf("external plugin %q found in path is not an executable", pluginFile.Name())
 					}
 
-					ep := external.Plugin{
-						PName:                     pluginInfo.Name(),
-						Path:                      filepath.Join(pluginsRoot, pluginInfo.Name(), version.Name(), pluginFile.Name()),
-						PSupportedProjectVersions: []config.Version{cfgv3.Version},
-						Args:                      parseExternalPluginArgs(),
+					var pluginVersion plugin.Version
+					if err = pluginVersion.Parse(version.Name()); err != nil {
+						return nil, fmt.Errorf("error parsing external plugin version %q: %w", version.Name(), err)
 					}
 
-					if err = ep.PVersion.Parse(version.Name()); err != nil {
-						return nil, fmt.Errorf("error parsing external plugin version %q: %w", version.Name(), err)
+					// A plugin whose manifest is invalid or does not match its executable is skipped rather than
+					// failing the discovery, so that the other plugins and the command removing it keep working.
+					ep, err := newExternalPlugin(filesystem, pluginsRoot, pluginInfo.Name(), version.Name(),
+						pluginFile.Name(), loadPublicKeys)
+					if err != nil {
+						slog.Warn("skipping external plugin", "path", ep.Path, "error", err)
+						continue
 					}
 
 					slog.Debug("Adding external plugin", "plugin name", ep.Name())
@@ -331,6 +349,65 @@ func DiscoverExternalPlugins(filesystem afero.Fs) (ps []plugin.Plugin, err error
 	return ps, nil
 }
 
+// newExternalPlugin builds the external plugin found in the provided version directory. If the directory
+// contains a plugin manifest, the plugin is validated against it and its executable integrity is verified.
+func newExternalPlugin(
+	filesystem afero.Fs,
+	pluginsRoot, name, version, fileName string,
+	loadPublicKeys func() ([]ed25519.PublicKey, error),
+) (external.Plugin, error) {
+	dir := filepath.Join(pluginsRoot, name, version)
+	ep := external.Plugin{
+		PName:                     name,
+		Path:                      filepath.Join(dir, fileName),
+		PSupportedProjectVersions: []config.Version{cfgv3.Version},
+		Args:                      parseExternalPluginArgs(),
+	}
+
+	if err := ep.PVersion.Parse(version); err != nil {
+		return ep, fmt.Errorf("error parsing external plugin version %q: %w", version, err)
+	}
+
+	manifest, err := external.LoadManifest(filesystem, dir)
+	if err != nil {
+		return ep, fmt.Errorf("invalid external plugin %q: %w", plugin.KeyFor(ep), err)
+	}
+	if manifest == nil {
+		return ep, nil
+	}
+
+	if err = external.ValidateManifest(*manifest, name, version); err != nil {
+		return ep, fmt.Errorf("invalid manifest for external plugin %q: %w", plugin.KeyFor(ep), err)
+	}
+
+	var keys []ed25519.PublicKey
+	if manifest.Signature != "" {
+		if keys, err = loadPublicKeys(); err != nil {
+			return ep, fmt.Errorf("error loading external plugins public keys: %w", err)
+		}
+	}
+
+	if err = external.VerifyExecutable(filesystem, *manifest, ep.Path, keys); err != nil {
+		return ep, fmt.Errorf("refusing to load external plugin %q: %w", plugin.KeyFor(ep), err)
+	}
+
+	if len(manifest.SupportedProjectVersions) != 0 {
+		ep.PSupportedProjectVersions = make([]config.Version, 0, len(manifest.SupportedProjectVersions))
+		for _, v := range manifest.SupportedProjectVersions {
+			var projectVersion config.Version
+			if err = projectVersion.Parse(v); err != nil {
+				return ep, fmt.Errorf("invalid project version %q in manifest of external plugin %q: %w",
+					v, plugin.KeyFor(ep), err)
+			}
+			ep.PSupportedProjectVersions = append(ep.PSupportedProjectVersions, projectVersion)
+		}
+	}
+	ep.PDescription = manifest.Description
+	ep.PSubcommands = manifest.Subcommands
+
+	return ep, nil
+}
+
 // isPluginExecutable checks if a plugin is an executable based on the bitmask and returns true or false.
 func isPluginExecutable(mode fs.FileMode) bool {
 	return mode&0o111 != 0
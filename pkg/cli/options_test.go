@@ -17,6 +17,8 @@ limitations under the License.
 package cli
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io/fs"
@@ -33,6 +35,7 @@ import (
 	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
 	"sigs.k8s.io/kubebuilder/v4/pkg/model/stage"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/external"
 )
 
 var _ = Describe("Discover external plugins", func() {
@@ -308,6 +311,85 @@ var _ = Describe("Discover external plugins", func() {
 			Expect(plugins[1].Name()).To(Equal("myotherexternalPlugin"))
 		})
 
+		It("should discover WebAssembly plugins that are not executables", func() {
+			err = filesystem.FS.RemoveAll(filepath.Dir(pluginFilePath))
+			Expect(err).ToNot(HaveOccurred())
+
+			pluginFilePath = filepath.Join(pluginPath, "wasmPlugin", "v1", "wasmPlugin.wasm")
+
+			err = filesystem.FS.MkdirAll(filepath.Dir(pluginFilePath), 0o700)
+			Expect(err).ToNot(HaveOccurred())
+
+			err = afero.WriteFile(filesystem.FS, pluginFilePath, []byte("\x00asm"), 0o644)
+			Expect(err).ToNot(HaveOccurred())
+
+			plugins, err = DiscoverExternalPlugins(filesystem.FS)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(plugins).To(HaveLen(1))
+			Expect(plugins[0].Name()).To(Equal("wasmPlugin"))
+			Expect(plugins[0].(external.Plugin).Path).To(Equal(pluginFilePath))
+		})
+
+		Context("with a plugin manifest", func() {
+			var manifestPath string
+
+			BeforeEach(func() {
+				_, err = f.WriteString(testPluginScript)
+				Expect(err).ToNot(HaveOccurred())
+				err = filesystem.FS.Chmod(pluginFilePath, filePermissions)
+				Expect(err).ToNot(HaveOccurred())
+
+				manifestPath = filepath.Join(filepath.Dir(pluginFilePath), "plugin.yaml")
+			})
+
+			It("should use the manifest information when the checksum matches", func() {
+				sum := sha256.Sum256([]byte(testPluginScript))
+				manifest := fmt.Sprintf(`name: externalPlugin
+version: v1
+description: An external plugin
+supportedProjectVersions: ["3"]
+subcommands: ["edit"]
+sha256: %s
+`, hex.EncodeToString(sum[:]))
+				Expect(afero.WriteFile(filesystem.FS, manifestPath, []byte(manifest), 0o644)).To(Succeed())
+
+				plugins, err = DiscoverExternalPlugins(filesystem.FS)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(plugins).To(HaveLen(1))
+				Expect(plugins[0].(plugin.Describable).Description()).To(Equal("An external plugin"))
+				Expect(plugins[0].(external.Plugin).PSubcommands).To(Equal([]string{"edit"}))
+			})
+
+			It("should skip the plugin when the checksum does not match", func() {
+				manifest := "name: externalPlugin\nversion: v1\nsha256: 0000\n"
+				Expect(afero.WriteFile(filesystem.FS, manifestPath, []byte(manifest), 0o644)).To(Succeed())
+
+				plugins, err = DiscoverExternalPlugins(filesystem.FS)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(plugins).To(BeEmpty())
+			})
+
+			It("should keep discovering the other plugins when one of them was tampered with", func() {
+				sum := sha256.Sum256([]byte(testPluginScript))
+				manifest := fmt.Sprintf("name: externalPlugin\nversion: v1\nsha256: %s\n", hex.EncodeToString(sum[:]))
+				Expect(afero.WriteFile(filesystem.FS, manifestPath, []byte(manifest), 0o644)).To(Succeed())
+
+				tamperedPath := filepath.Join(pluginPath, "tamperedPlugin", "v1", "tamperedPlugin.sh")
+				Expect(filesystem.FS.MkdirAll(filepath.Dir(tamperedPath), 0o700)).To(Succeed())
+				Expect(afero.WriteFile(filesystem.FS, tamperedPath,
+					[]byte(testPluginScript+"# tampered\n"), filePermissions)).To(Succeed())
+				tamperedManifest := fmt.Sprintf("name: tamperedPlugin\nversion: v1\nsha256: %s\n",
+					hex.EncodeToString(sum[:]))
+				Expect(afero.WriteFile(filesystem.FS, filepath.Join(filepath.Dir(tamperedPath), "plugin.yaml"),
+					[]byte(tamperedManifest), 0o644)).To(Succeed())
+
+				plugins, err = DiscoverExternalPlugins(filesystem.FS)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(plugins).To(HaveLen(1))
+				Expect(plugins[0].Name()).To(Equal("externalPlugin"))
+			})
+		})
+
 		Context("that are invalid", func() {
 			BeforeEach(func() {
 				filesystem = machinery.Filesystem{
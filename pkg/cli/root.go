@@ -204,7 +204,8 @@ func (c CLI) getPluginTableFilteredWithOptions(filter func(plugin.Plugin) bool,
 		var desc string
 		if describable, ok := p.(plugin.Describable); ok {
 			desc = describable.Description()
-		} else {
+		}
+		if desc == "" {
 			desc = getPluginDescription(pluginKey)
 		}
 
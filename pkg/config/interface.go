@@ -89,6 +89,9 @@ type Config interface {
 	AddResource(res resource.Resource) error
 	// UpdateResource adds the provided resource if it was not present, modifies it if it was already present.
 	UpdateResource(res resource.Resource) error
+	// ReplaceResource replaces the stored resource matching the GVK of the provided one.
+	// Unlike UpdateResource, fields that are unset in the provided resource are cleared.
+	ReplaceResource(res resource.Resource) error
 
 	// HasGroup checks if the provided group is the same as any of the tracked resources.
 	HasGroup(group string) bool
@@ -277,6 +277,26 @@ func (c *Cfg) UpdateResource(res resource.Resource) error {
 	return nil
 }
 
+// ReplaceResource implements config.Config
+func (c *Cfg) ReplaceResource(res resource.Resource) error {
+	// As res is passed by value it is already a shallow copy, but we need to make a deep copy
+	res = res.Copy()
+
+	// Plural is only stored if irregular
+	if res.Plural == resource.RegularPlural(res.Kind) {
+		res.Plural = ""
+	}
+
+	for i, r := range c.Resources {
+		if res.IsEqualTo(r.GVK) {
+			c.Resources[i] = res
+			return nil
+		}
+	}
+
+	return config.ResourceNotFoundError{GVK: res.GVK}
+}
+
 // HasGroup implements config.Config
 func (c Cfg) HasGroup(group string) bool {
 	// Return true if the target group is found in the tracked resources
@@ -376,6 +396,10 @@ func (c Cfg) MarshalYAML() ([]byte, error) {
 		if r.Webhooks != nil && r.Webhooks.IsEmpty() {
 			c.Resources[i].Webhooks = nil
 		}
+		// If Policies is empty, omit it (prevents `policies: {}`).
+		if r.Policies != nil && r.Policies.IsEmpty() {
+			c.Resources[i].Policies = nil
+		}
 	}
 
 	content, err := yaml.Marshal(c)
@@ -276,6 +276,24 @@ var _ = Describe("Cfg", func() {
 			checkResource(c.Resources[0], resWithoutPlural)
 		})
 
+		It("ReplaceResource should fail if the resource does not exist", func() {
+			Expect(c.ReplaceResource(res)).To(HaveOccurred())
+		})
+
+		It("ReplaceResource should replace the resource if it already exists", func() {
+			c.Resources = append(c.Resources, resWithoutPlural)
+
+			r := res.Copy()
+			r.Webhooks.Defaulting = false
+			r.Webhooks.Conversion = false
+			r.API.Namespaced = false
+			Expect(c.ReplaceResource(r)).To(Succeed())
+			Expect(c.Resources).To(HaveLen(1))
+
+			r.Plural = ""
+			checkResource(c.Resources[0], r)
+		})
+
 		It("HasGroup should return false with no tracked resources", func() {
 			Expect(c.HasGroup(res.Group)).To(BeFalse())
 		})
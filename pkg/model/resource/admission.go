@@ -0,0 +1,226 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+var (
+	failurePolicies      = []string{"Fail", "Ignore"}
+	sideEffectClasses    = []string{"None", "NoneOnDryRun"}
+	matchPolicies        = []string{"Exact", "Equivalent"}
+	reinvocationPolicies = []string{"Never", "IfNeeded"}
+	operations           = []string{"CREATE", "UPDATE", "DELETE", "CONNECT"}
+)
+
+// AdmissionConfig holds the admission configuration of a defaulting or validating webhook.
+// Unset fields keep the defaults of the scaffolded +kubebuilder:webhook marker.
+type AdmissionConfig struct {
+	// FailurePolicy defines how errors calling the webhook are handled, Fail or Ignore.
+	FailurePolicy string `json:"failurePolicy,omitempty"`
+
+	// SideEffects states whether the webhook has side effects, None or NoneOnDryRun.
+	SideEffects string `json:"sideEffects,omitempty"`
+
+	// TimeoutSeconds is the timeout of the webhook calls, between 1 and 30 seconds.
+	TimeoutSeconds int `json:"timeoutSeconds,omitempty"`
+
+	// MatchPolicy defines how the rules match the incoming requests, Exact or Equivalent.
+	MatchPolicy string `json:"matchPolicy,omitempty"`
+
+	// ReinvocationPolicy states whether a mutating webhook can be called again, Never or IfNeeded.
+	ReinvocationPolicy string `json:"reinvocationPolicy,omitempty"`
+
+	// Operations are the operations the webhook is called for, e.g. CREATE, UPDATE, DELETE or CONNECT.
+	Operations []string `json:"operations,omitempty"`
+
+	// NamespaceSelector holds the labels the namespace of an object must have for the webhook to be called.
+	NamespaceSelector map[string]string `json:"namespaceSelector,omitempty"`
+
+	// ObjectSelector holds the labels an object must have for the webhook to be called.
+	ObjectSelector map[string]string `json:"objectSelector,omitempty"`
+}
+
+// Validate checks that the AdmissionConfig is valid for a mutating or a validating webhook.
+func (c AdmissionConfig) Validate(mutating bool) error {
+	if err := validateEnum("failure policy", c.FailurePolicy, failurePolicies); err != nil {
+		return err
+	}
+	if err := validateEnum("side effects", c.SideEffects, sideEffectClasses); err != nil {
+		return err
+	}
+	if err := validateEnum("match policy", c.MatchPolicy, matchPolicies); err != nil {
+		return err
+	}
+	if err := validateEnum("reinvocation policy", c.ReinvocationPolicy, reinvocationPolicies); err != nil {
+		return err
+	}
+	if c.ReinvocationPolicy != "" && !mutating {
+		return fmt.Errorf("reinvocation policy can only be set for defaulting webhooks")
+	}
+
+	if c.TimeoutSeconds < 0 || c.TimeoutSeconds > 30 {
+		return fmt.Errorf("invalid timeout %d: must be between 1 and 30 seconds", c.TimeoutSeconds)
+	}
+
+	seen := make(map[string]bool)
+	for _, operation := range c.Operations {
+		if err := validateEnum("operation", operation, operations); err != nil {
+			return err
+		}
+		if seen[operation] {
+			return fmt.Errorf("duplicate operation %q", operation)
+		}
+		seen[operation] = true
+	}
+
+	for name, selector := range map[string]map[string]string{
+		"namespace selector": c.NamespaceSelector,
+		"object selector":    c.ObjectSelector,
+	} {
+		for key, value := range selector {
+			if errors := validation.IsQualifiedName(key); len(errors) != 0 {
+				return fmt.Errorf("invalid %s label %q: %s", name, key, strings.Join(errors, ", "))
+			}
+			if errors := validation.IsValidLabelValue(value); len(errors) != 0 {
+				return fmt.Errorf("invalid %s value %q: %s", name, value, strings.Join(errors, ", "))
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateEnum(name, value string, allowed []string) error {
+	if value != "" && !slices.Contains(allowed, value) {
+		return fmt.Errorf("invalid %s %q: must be one of %s", name, value, strings.Join(allowed, ", "))
+	}
+	return nil
+}
+
+// IsEmpty returns if the AdmissionConfig's fields all contain zero-values.
+func (c *AdmissionConfig) IsEmpty() bool {
+	return c == nil || (c.FailurePolicy == "" && c.SideEffects == "" && c.TimeoutSeconds == 0 &&
+		c.MatchPolicy == "" && c.ReinvocationPolicy == "" && len(c.Operations) == 0 && !c.HasSelectors())
+}
+
+// HasSelectors returns true if a namespace or an object selector is set.
+func (c *AdmissionConfig) HasSelectors() bool {
+	return c != nil && (len(c.NamespaceSelector) != 0 || len(c.ObjectSelector) != 0)
+}
+
+// Copy returns a deep copy of the AdmissionConfig.
+func (c *AdmissionConfig) Copy() *AdmissionConfig {
+	if c == nil {
+		return nil
+	}
+
+	return &AdmissionConfig{
+		FailurePolicy:      c.FailurePolicy,
+		SideEffects:        c.SideEffects,
+		TimeoutSeconds:     c.TimeoutSeconds,
+		MatchPolicy:        c.MatchPolicy,
+		ReinvocationPolicy: c.ReinvocationPolicy,
+		Operations:         slices.Clone(c.Operations),
+		NamespaceSelector:  maps.Clone(c.NamespaceSelector),
+		ObjectSelector:     maps.Clone(c.ObjectSelector),
+	}
+}
+
+// Equal returns true if both AdmissionConfigs hold the same configuration, nil being equal to empty.
+func (c *AdmissionConfig) Equal(other *AdmissionConfig) bool {
+	if c.IsEmpty() || other.IsEmpty() {
+		return c.IsEmpty() == other.IsEmpty()
+	}
+
+	return c.FailurePolicy == other.FailurePolicy && c.SideEffects == other.SideEffects &&
+		c.TimeoutSeconds == other.TimeoutSeconds && c.MatchPolicy == other.MatchPolicy &&
+		c.ReinvocationPolicy == other.ReinvocationPolicy && slices.Equal(c.Operations, other.Operations) &&
+		maps.Equal(c.NamespaceSelector, other.NamespaceSelector) && maps.Equal(c.ObjectSelector, other.ObjectSelector)
+}
+
+// AdmissionWebhook describes a defaulting or validating webhook of a resource,
+// either one of its default webhooks or a named one.
+type AdmissionWebhook struct {
+	// Name is the name of the named webhook, empty for the default webhooks.
+	Name string
+
+	// Mutating is true for defaulting webhooks and false for validating webhooks.
+	Mutating bool
+
+	// Path holds the custom path for the webhook, if any.
+	Path string
+
+	// Admission holds the admission configuration of the webhook, if any.
+	Admission *AdmissionConfig
+}
+
+// ConfigurationName returns the name of the webhook in its {Mutating,Validating}WebhookConfiguration,
+// e.g. mcaptain-v1.kb.io for the defaulting webhook of Captain or vcaptain-cross-object-v1.kb.io
+// for its cross-object named validating webhook.
+func (w AdmissionWebhook) ConfigurationName(r Resource) string {
+	prefix := "v"
+	if w.Mutating {
+		prefix = "m"
+	}
+
+	name := strings.ToLower(r.Kind)
+	if w.Name != "" {
+		name += "-" + w.Name
+	}
+
+	return fmt.Sprintf("%s%s-%s.kb.io", prefix, name, r.Version)
+}
+
+// GetAdmissionWebhooks returns the defaulting and validating webhooks of the resource,
+// the default ones first and then the named ones.
+func (r Resource) GetAdmissionWebhooks() []AdmissionWebhook {
+	if r.Webhooks == nil {
+		return nil
+	}
+
+	webhooks := make([]AdmissionWebhook, 0)
+	if r.Webhooks.Defaulting {
+		webhooks = append(webhooks, AdmissionWebhook{
+			Mutating:  true,
+			Path:      r.Webhooks.DefaultingPath,
+			Admission: r.Webhooks.DefaultingAdmission,
+		})
+	}
+	if r.Webhooks.Validation {
+		webhooks = append(webhooks, AdmissionWebhook{
+			Path:      r.Webhooks.ValidationPath,
+			Admission: r.Webhooks.ValidationAdmission,
+		})
+	}
+	for _, webhook := range r.Webhooks.Named {
+		webhooks = append(webhooks, AdmissionWebhook{
+			Name:      webhook.Name,
+			Mutating:  webhook.Type == DefaultingWebhook,
+			Path:      webhook.Path,
+			Admission: webhook.Admission,
+		})
+	}
+
+	return webhooks
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"testing"
+)
+
+func TestAdmissionConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   AdmissionConfig
+		mutating bool
+		wantErr  bool
+	}{
+		{
+			name:    "empty config",
+			config:  AdmissionConfig{},
+			wantErr: false,
+		},
+		{
+			name: "valid config",
+			config: AdmissionConfig{
+				FailurePolicy:     "Ignore",
+				SideEffects:       "NoneOnDryRun",
+				TimeoutSeconds:    5,
+				MatchPolicy:       "Equivalent",
+				Operations:        []string{"CREATE", "DELETE"},
+				NamespaceSelector: map[string]string{"kubernetes.io/metadata.name": "prod"},
+				ObjectSelector:    map[string]string{"app": "frigate"},
+			},
+			wantErr: false,
+		},
+		{
+			name:     "reinvocation policy for a mutating webhook",
+			config:   AdmissionConfig{ReinvocationPolicy: "IfNeeded"},
+			mutating: true,
+			wantErr:  false,
+		},
+		{
+			name:    "reinvocation policy for a validating webhook",
+			config:  AdmissionConfig{ReinvocationPolicy: "IfNeeded"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid failure policy",
+			config:  AdmissionConfig{FailurePolicy: "fail"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid side effects",
+			config:  AdmissionConfig{SideEffects: "Some"},
+			wantErr: true,
+		},
+		{
+			name:    "timeout too long",
+			config:  AdmissionConfig{TimeoutSeconds: 31},
+			wantErr: true,
+		},
+		{
+			name:    "invalid operation",
+			config:  AdmissionConfig{Operations: []string{"PATCH"}},
+			wantErr: true,
+		},
+		{
+			name:    "duplicate operation",
+			config:  AdmissionConfig{Operations: []string{"CREATE", "CREATE"}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid selector label",
+			config:  AdmissionConfig{ObjectSelector: map[string]string{"app name": "frigate"}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid selector value",
+			config:  AdmissionConfig{NamespaceSelector: map[string]string{"env": "prod/eu"}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate(tt.mutating)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("AdmissionConfig.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAdmissionConfig_Equal(t *testing.T) {
+	config := &AdmissionConfig{FailurePolicy: "Ignore", ObjectSelector: map[string]string{"app": "frigate"}}
+
+	tests := []struct {
+		name  string
+		other *AdmissionConfig
+		want  bool
+	}{
+		{
+			name:  "copy",
+			other: config.Copy(),
+			want:  true,
+		},
+		{
+			name:  "nil",
+			other: nil,
+			want:  false,
+		},
+		{
+			name:  "different selector",
+			other: &AdmissionConfig{FailurePolicy: "Ignore", ObjectSelector: map[string]string{"app": "sloop"}},
+			want:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := config.Equal(tt.other); got != tt.want {
+				t.Errorf("AdmissionConfig.Equal() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	var empty *AdmissionConfig
+	if !empty.Equal(&AdmissionConfig{}) {
+		t.Errorf("expected a nil AdmissionConfig to be equal to an empty one")
+	}
+}
+
+func TestResource_GetAdmissionWebhooks(t *testing.T) {
+	res := Resource{
+		GVK: GVK{Group: "crew", Version: "v1", Kind: "Captain"},
+		Webhooks: &Webhooks{
+			Defaulting:          true,
+			Validation:          true,
+			ValidationAdmission: &AdmissionConfig{FailurePolicy: "Ignore"},
+			Named: NamedWebhooks{
+				{Name: "cross-object", Type: ValidationWebhook},
+			},
+		},
+	}
+
+	want := []string{"mcaptain-v1.kb.io", "vcaptain-v1.kb.io", "vcaptain-cross-object-v1.kb.io"}
+	webhooks := res.GetAdmissionWebhooks()
+	if len(webhooks) != len(want) {
+		t.Fatalf("GetAdmissionWebhooks() returned %d webhooks, want %d", len(webhooks), len(want))
+	}
+	for i, webhook := range webhooks {
+		if got := webhook.ConfigurationName(res); got != want[i] {
+			t.Errorf("ConfigurationName() = %q, want %q", got, want[i])
+		}
+	}
+	if webhooks[1].Admission.FailurePolicy != "Ignore" {
+		t.Errorf("expected the admission configuration of the validation webhook to be returned")
+	}
+}
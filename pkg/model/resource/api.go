@@ -18,8 +18,72 @@ package resource
 
 import (
 	"fmt"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
+// printColumnTypes are the OpenAPI types accepted by the +kubebuilder:printcolumn marker.
+var printColumnTypes = []string{"integer", "number", "string", "boolean", "date"}
+
+// PrintColumn describes an additional printer column shown by kubectl get.
+type PrintColumn struct {
+	// Name is the human readable name of the column.
+	Name string `json:"name"`
+
+	// JSONPath is the simple JSON path used to extract the column value, e.g. ".status.ready".
+	JSONPath string `json:"jsonPath"`
+
+	// Type is the OpenAPI type of the column value.
+	Type string `json:"type"`
+}
+
+// ParsePrintColumn parses a printer column in the `name:jsonpath:type` format.
+func ParsePrintColumn(value string) (PrintColumn, error) {
+	parts := strings.Split(value, ":")
+	if len(parts) < 3 {
+		return PrintColumn{}, fmt.Errorf("printer column %q must follow the format name:jsonpath:type", value)
+	}
+
+	column := PrintColumn{
+		Name:     parts[0],
+		JSONPath: strings.Join(parts[1:len(parts)-1], ":"),
+		Type:     parts[len(parts)-1],
+	}
+	if err := column.Validate(); err != nil {
+		return PrintColumn{}, err
+	}
+
+	return column, nil
+}
+
+// Validate checks that the printer column is valid.
+func (c PrintColumn) Validate() error {
+	if strings.TrimSpace(c.Name) == "" {
+		return fmt.Errorf("printer column name cannot be empty")
+	}
+	if strings.ContainsAny(c.Name, "\"\n") {
+		return fmt.Errorf("printer column name %q cannot contain quotes or new lines", c.Name)
+	}
+
+	if !strings.HasPrefix(c.JSONPath, ".") {
+		return fmt.Errorf("printer column %q JSONPath %q must start with a dot", c.Name, c.JSONPath)
+	}
+
+	if !slices.Contains(printColumnTypes, c.Type) {
+		return fmt.Errorf("printer column %q type %q must be one of: %s",
+			c.Name, c.Type, strings.Join(printColumnTypes, ", "))
+	}
+
+	return nil
+}
+
+// String returns the printer column in the `name:jsonpath:type` format.
+func (c PrintColumn) String() string {
+	return fmt.Sprintf("%s:%s:%s", c.Name, c.JSONPath, c.Type)
+}
+
 // API contains information about scaffolded APIs
 type API struct {
 	// CRDVersion holds the CustomResourceDefinition API version used for the resource.
@@ -27,6 +91,27 @@ type API struct {
 
 	// Namespaced is true if the API is namespaced.
 	Namespaced bool `json:"namespaced,omitempty"`
+
+	// ShortNames are the short names for the resource, e.g. `kubectl get <shortname>`.
+	ShortNames []string `json:"shortNames,omitempty"`
+
+	// Categories are the grouped resources this resource belongs to, e.g. `kubectl get all`.
+	Categories []string `json:"categories,omitempty"`
+
+	// PrintColumns are the additional printer columns shown by `kubectl get`.
+	PrintColumns []PrintColumn `json:"printColumns,omitempty"`
+
+	// ScaleSubresource is true if the API enables the scale subresource.
+	ScaleSubresource bool `json:"scaleSubresource,omitempty"`
+
+	// StorageVersion is true if this version is the storage version of the CRD.
+	StorageVersion bool `json:"storageVersion,omitempty"`
+
+	// Conditions is true if the controllers of the API manage its status conditions.
+	Conditions bool `json:"conditions,omitempty"`
+
+	// Clients is true if typed clients, listers, informers and apply configurations are generated for the API.
+	Clients bool `json:"clients,omitempty"`
 }
 
 // Validate checks that the API is valid.
@@ -36,6 +121,42 @@ func (api API) Validate() error {
 		return fmt.Errorf("invalid CRD version: %w", err)
 	}
 
+	// Validate the short names and categories
+	if err := validateNameList("short name", api.ShortNames); err != nil {
+		return err
+	}
+	if err := validateNameList("category", api.Categories); err != nil {
+		return err
+	}
+
+	// Validate the printer columns
+	names := make(map[string]struct{}, len(api.PrintColumns))
+	for _, column := range api.PrintColumns {
+		if err := column.Validate(); err != nil {
+			return fmt.Errorf("invalid printer column: %w", err)
+		}
+		if _, found := names[column.Name]; found {
+			return fmt.Errorf("duplicated printer column %q", column.Name)
+		}
+		names[column.Name] = struct{}{}
+	}
+
+	return nil
+}
+
+// validateNameList checks that every name is a lowercase DNS-1035 label and that there are no duplicates.
+func validateNameList(kind string, names []string) error {
+	seen := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		if errors := validation.IsDNS1035Label(name); len(errors) != 0 {
+			return fmt.Errorf("invalid %s %q (%s)", kind, name, strings.Join(errors, ", "))
+		}
+		if _, found := seen[name]; found {
+			return fmt.Errorf("duplicated %s %q", kind, name)
+		}
+		seen[name] = struct{}{}
+	}
+
 	return nil
 }
 
@@ -43,6 +164,9 @@ func (api API) Validate() error {
 func (api API) Copy() API {
 	// As this function doesn't use a pointer receiver, api is already a shallow copy.
 	// Any field that is a pointer, slice or map needs to be deep copied.
+	api.ShortNames = slices.Clone(api.ShortNames)
+	api.Categories = slices.Clone(api.Categories)
+	api.PrintColumns = slices.Clone(api.PrintColumns)
 	return api
 }
 
@@ -65,10 +189,46 @@ func (api *API) Update(other *API) error {
 	// Update the namespace.
 	api.Namespaced = api.Namespaced || other.Namespaced
 
+	// Update the short names and categories.
+	api.ShortNames = appendMissing(api.ShortNames, other.ShortNames...)
+	api.Categories = appendMissing(api.Categories, other.Categories...)
+
+	// Update the printer columns, replacing the ones with the same name.
+	for _, column := range other.PrintColumns {
+		i := slices.IndexFunc(api.PrintColumns, func(c PrintColumn) bool { return c.Name == column.Name })
+		if i == -1 {
+			api.PrintColumns = append(api.PrintColumns, column)
+		} else {
+			api.PrintColumns[i] = column
+		}
+	}
+
+	// Update the subresources and storage version.
+	api.ScaleSubresource = api.ScaleSubresource || other.ScaleSubresource
+	api.StorageVersion = api.StorageVersion || other.StorageVersion
+
+	// Update the status conditions.
+	api.Conditions = api.Conditions || other.Conditions
+
+	// Update the generated clients.
+	api.Clients = api.Clients || other.Clients
+
 	return nil
 }
 
+// appendMissing appends the values that are not already present in list.
+func appendMissing(list []string, values ...string) []string {
+	for _, value := range values {
+		if !slices.Contains(list, value) {
+			list = append(list, value)
+		}
+	}
+	return list
+}
+
 // IsEmpty returns if the API's fields all contain zero-values.
 func (api API) IsEmpty() bool {
-	return api.CRDVersion == "" && !api.Namespaced
+	return api.CRDVersion == "" && !api.Namespaced &&
+		len(api.ShortNames) == 0 && len(api.Categories) == 0 && len(api.PrintColumns) == 0 &&
+		!api.ScaleSubresource && !api.StorageVersion && !api.Conditions && !api.Clients
 }
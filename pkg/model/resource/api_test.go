@@ -28,14 +28,81 @@ var _ = Describe("API", func() {
 			Expect(API{CRDVersion: v1}.Validate()).To(Succeed())
 		})
 
+		It("should succeed for a valid API with resource modelling settings", func() {
+			Expect(API{
+				CRDVersion:   v1,
+				ShortNames:   []string{"fs"},
+				Categories:   []string{"all", "crew"},
+				PrintColumns: []PrintColumn{{Name: "Ready", JSONPath: ".status.ready", Type: "boolean"}},
+			}.Validate()).To(Succeed())
+		})
+
 		DescribeTable("should fail for invalid APIs",
 			func(api API) { Expect(api.Validate()).NotTo(Succeed()) },
 			// Ensure that the rest of the fields are valid to check each part
 			Entry("empty CRD version", API{}),
 			Entry("invalid CRD version", API{CRDVersion: "1"}),
+			Entry("invalid short name", API{CRDVersion: v1, ShortNames: []string{"Foo"}}),
+			Entry("duplicated short name", API{CRDVersion: v1, ShortNames: []string{"fs", "fs"}}),
+			Entry("invalid category", API{CRDVersion: v1, Categories: []string{"my_category"}}),
+			Entry("invalid printer column type", API{CRDVersion: v1, PrintColumns: []PrintColumn{
+				{Name: "Ready", JSONPath: ".status.ready", Type: "bool"},
+			}}),
+			Entry("invalid printer column JSONPath", API{CRDVersion: v1, PrintColumns: []PrintColumn{
+				{Name: "Ready", JSONPath: "status.ready", Type: "boolean"},
+			}}),
+			Entry("duplicated printer column", API{CRDVersion: v1, PrintColumns: []PrintColumn{
+				{Name: "Ready", JSONPath: ".status.ready", Type: "boolean"},
+				{Name: "Ready", JSONPath: ".status.phase", Type: "string"},
+			}}),
 		)
 	})
 
+	Context("ParsePrintColumn", func() {
+		It("should parse a printer column", func() {
+			column, err := ParsePrintColumn("Ready:.status.ready:boolean")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(column).To(Equal(PrintColumn{Name: "Ready", JSONPath: ".status.ready", Type: "boolean"}))
+			Expect(column.String()).To(Equal("Ready:.status.ready:boolean"))
+		})
+
+		It("should keep colons inside the JSONPath", func() {
+			column, err := ParsePrintColumn(`Ready:.status.conditions[?(@.type=="a:b")].status:string`)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(column.JSONPath).To(Equal(`.status.conditions[?(@.type=="a:b")].status`))
+		})
+
+		DescribeTable("should fail for invalid printer columns",
+			func(value string) {
+				_, err := ParsePrintColumn(value)
+				Expect(err).To(HaveOccurred())
+			},
+			Entry("missing type", "Ready:.status.ready"),
+			Entry("empty name", ":.status.ready:boolean"),
+			Entry("invalid type", "Ready:.status.ready:bool"),
+		)
+	})
+
+	Context("Copy", func() {
+		It("should deep copy the slices", func() {
+			api := API{
+				CRDVersion:   v1,
+				ShortNames:   []string{"fs"},
+				Categories:   []string{"crew"},
+				PrintColumns: []PrintColumn{{Name: "Ready", JSONPath: ".status.ready", Type: "boolean"}},
+			}
+			apiCopy := api.Copy()
+			Expect(apiCopy).To(Equal(api))
+
+			apiCopy.ShortNames[0] = "other"
+			apiCopy.Categories[0] = "other"
+			apiCopy.PrintColumns[0].Name = "Other"
+			Expect(api.ShortNames[0]).To(Equal("fs"))
+			Expect(api.Categories[0]).To(Equal("crew"))
+			Expect(api.PrintColumns[0].Name).To(Equal("Ready"))
+		})
+	})
+
 	Context("Update", func() {
 		var api, other API
 
@@ -112,6 +179,36 @@ var _ = Describe("API", func() {
 				Expect(api.Namespaced).To(BeFalse())
 			})
 		})
+
+		Context("Resource modelling", func() {
+			It("should merge short names and categories without duplicates", func() {
+				api = API{ShortNames: []string{"fs"}, Categories: []string{"crew"}}
+				other = API{ShortNames: []string{"fs", "frs"}, Categories: []string{"all"}}
+				Expect(api.Update(&other)).To(Succeed())
+				Expect(api.ShortNames).To(Equal([]string{"fs", "frs"}))
+				Expect(api.Categories).To(Equal([]string{"crew", "all"}))
+			})
+
+			It("should merge printer columns by name", func() {
+				api = API{PrintColumns: []PrintColumn{{Name: "Ready", JSONPath: ".status.ready", Type: "string"}}}
+				other = API{PrintColumns: []PrintColumn{
+					{Name: "Ready", JSONPath: ".status.ready", Type: "boolean"},
+					{Name: "Age", JSONPath: ".metadata.creationTimestamp", Type: "date"},
+				}}
+				Expect(api.Update(&other)).To(Succeed())
+				Expect(api.PrintColumns).To(Equal(other.PrintColumns))
+			})
+
+			It("should set the scale subresource, storage version, conditions and clients", func() {
+				api = API{}
+				other = API{ScaleSubresource: true, StorageVersion: true, Conditions: true, Clients: true}
+				Expect(api.Update(&other)).To(Succeed())
+				Expect(api.ScaleSubresource).To(BeTrue())
+				Expect(api.StorageVersion).To(BeTrue())
+				Expect(api.Conditions).To(BeTrue())
+				Expect(api.Clients).To(BeTrue())
+			})
+		})
 	})
 
 	Context("IsEmpty", func() {
@@ -142,6 +239,11 @@ var _ = Describe("API", func() {
 			},
 			Entry("cluster-scope", func() API { return cluster }),
 			Entry("namespace-scope", func() API { return namespaced }),
+			Entry("short names", func() API { return API{ShortNames: []string{"fs"}} }),
+			Entry("printer columns", func() API { return API{PrintColumns: []PrintColumn{{Name: "Ready"}}} }),
+			Entry("storage version", func() API { return API{StorageVersion: true} }),
+			Entry("conditions", func() API { return API{Conditions: true} }),
+			Entry("clients", func() API { return API{Clients: true} }),
 		)
 	})
 })
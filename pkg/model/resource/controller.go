@@ -18,6 +18,8 @@ package resource
 
 import (
 	"fmt"
+	"go/token"
+	"slices"
 	"strings"
 
 	"k8s.io/apimachinery/pkg/util/validation"
@@ -29,6 +31,24 @@ type Controller struct {
 	// Name is the controller identifier, unique within a resource.
 	// Must be a valid DNS label (lowercase, alphanumeric, hyphens, max 63 chars).
 	Name string `json:"name,omitempty"`
+
+	// Owns are the resources created by the controller, whose changes trigger the reconciliation of their owner.
+	Owns []GVK `json:"owns,omitempty"`
+
+	// Watches are the other resources watched by the controller.
+	Watches []Watch `json:"watches,omitempty"`
+
+	// Finalizer is true when the controller adds a finalizer to the resources it reconciles.
+	Finalizer bool `json:"finalizer,omitempty"`
+}
+
+// Watch represents a resource watched by a controller.
+type Watch struct {
+	// GVK contains the watched resource's Group-Version-Kind triplet.
+	GVK `json:",inline"`
+
+	// Mapper is the name of the function that maps the events of the watched resource to reconcile requests.
+	Mapper string `json:"mapper,omitempty"`
 }
 
 // Validate checks that the Controller is valid.
@@ -42,6 +62,63 @@ func (c Controller) Validate() error {
 		return fmt.Errorf("invalid controller name %q: %s", c.Name, strings.Join(errors, ", "))
 	}
 
+	seen := make(map[GVK]bool, len(c.Owns)+len(c.Watches))
+	for _, gvk := range c.Owns {
+		if err := gvk.Validate(); err != nil {
+			return fmt.Errorf("invalid owned resource %+v of controller %q: %w", gvk, c.Name, err)
+		}
+		if seen[gvk] {
+			return fmt.Errorf("resource %+v is owned or watched more than once by controller %q", gvk, c.Name)
+		}
+		seen[gvk] = true
+	}
+	mappers := make(map[string]bool, len(c.Watches))
+	for _, watch := range c.Watches {
+		if err := watch.Validate(); err != nil {
+			return fmt.Errorf("invalid watched resource of controller %q: %w", c.Name, err)
+		}
+		if seen[watch.GVK] {
+			return fmt.Errorf("resource %+v is owned or watched more than once by controller %q", watch.GVK, c.Name)
+		}
+		seen[watch.GVK] = true
+		if mappers[watch.Mapper] {
+			return fmt.Errorf("mapper %q is used by more than one watched resource of controller %q",
+				watch.Mapper, c.Name)
+		}
+		mappers[watch.Mapper] = true
+	}
+
+	return nil
+}
+
+// Copy returns a deep copy of the Controller that can be safely modified without affecting the original.
+func (c Controller) Copy() Controller {
+	// As this function doesn't use a pointer receiver, c is already a shallow copy.
+	// Any field that is a pointer, slice or map needs to be deep copied.
+	if c.Owns != nil {
+		owns := make([]GVK, len(c.Owns))
+		copy(owns, c.Owns)
+		c.Owns = owns
+	}
+	if c.Watches != nil {
+		watches := make([]Watch, len(c.Watches))
+		copy(watches, c.Watches)
+		c.Watches = watches
+	}
+	return c
+}
+
+// Validate checks that the Watch is valid.
+func (w Watch) Validate() error {
+	if err := w.GVK.Validate(); err != nil {
+		return fmt.Errorf("invalid GVK %+v: %w", w.GVK, err)
+	}
+
+	if !token.IsIdentifier(w.Mapper) {
+		return fmt.Errorf("invalid mapper %q of the watched resource %+v: must be a valid Go identifier",
+			w.Mapper, w.GVK)
+	}
+
 	return nil
 }
 
@@ -117,14 +194,7 @@ func NormalizeReconcilerName(controllerName, kind string) string {
 	}
 
 	// Convert hyphenated name to PascalCase (e.g., "captain-backup" -> "CaptainBackup")
-	parts := strings.Split(controllerName, "-")
-	var result strings.Builder
-	for _, part := range parts {
-		if len(part) > 0 {
-			result.WriteString(strings.ToUpper(part[:1]) + part[1:])
-		}
-	}
-	return result.String() + "Reconciler"
+	return toPascalCase(controllerName) + "Reconciler"
 }
 
 // GetControllerName returns the runtime name used in Named() and error logs.
@@ -199,13 +269,16 @@ func (c *Controllers) Copy() Controllers {
 		return Controllers{}
 	}
 
-	controllers := make(Controllers, len(*c))
-	copy(controllers, *c)
+	controllers := make(Controllers, 0, len(*c))
+	for _, controller := range *c {
+		controllers = append(controllers, controller.Copy())
+	}
 	return controllers
 }
 
 // Update combines fields of two Controllers.
-// It adds controllers from other that don't exist in c.
+// It adds controllers from other that don't exist in c, and the owned and watched resources
+// of the controllers that exist in both.
 func (c *Controllers) Update(other *Controllers) error {
 	if c == nil {
 		return fmt.Errorf("cannot update a nil Controllers")
@@ -216,10 +289,42 @@ func (c *Controllers) Update(other *Controllers) error {
 	}
 
 	for _, controller := range *other {
-		if !c.HasController(controller.Name) {
-			*c = append(*c, controller)
+		existing := c.get(controller.Name)
+		if existing == nil {
+			*c = append(*c, controller.Copy())
+			continue
 		}
+		existing.update(controller)
+	}
+
+	return nil
+}
+
+// get returns the controller with the given name, or nil if there is none.
+func (c *Controllers) get(name string) *Controller {
+	if c.IsEmpty() {
+		return nil
 	}
 
+	for i := range *c {
+		if (*c)[i].Name == name {
+			return &(*c)[i]
+		}
+	}
 	return nil
 }
+
+// update adds the owned and watched resources of other that c does not have yet.
+func (c *Controller) update(other Controller) {
+	c.Finalizer = c.Finalizer || other.Finalizer
+	for _, gvk := range other.Owns {
+		if !slices.Contains(c.Owns, gvk) {
+			c.Owns = append(c.Owns, gvk)
+		}
+	}
+	for _, watch := range other.Watches {
+		if !slices.ContainsFunc(c.Watches, func(w Watch) bool { return w.GVK == watch.GVK }) {
+			c.Watches = append(c.Watches, watch)
+		}
+	}
+}
@@ -110,6 +110,51 @@ func TestControllers_Validate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "owned and watched resources",
+			ctrls: &Controllers{
+				{
+					Name:    "controller-1",
+					Owns:    []GVK{{Group: "apps", Version: "v1", Kind: "Deployment"}},
+					Watches: []Watch{{GVK: GVK{Group: "core", Version: "v1", Kind: "Secret"}, Mapper: "mapSecret"}},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "resource both owned and watched",
+			ctrls: &Controllers{
+				{
+					Name:    "controller-1",
+					Owns:    []GVK{{Group: "core", Version: "v1", Kind: "Secret"}},
+					Watches: []Watch{{GVK: GVK{Group: "core", Version: "v1", Kind: "Secret"}, Mapper: "mapSecret"}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "watched resource without mapper",
+			ctrls: &Controllers{
+				{
+					Name:    "controller-1",
+					Watches: []Watch{{GVK: GVK{Group: "core", Version: "v1", Kind: "Secret"}}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "mapper used by several watched resources",
+			ctrls: &Controllers{
+				{
+					Name: "controller-1",
+					Watches: []Watch{
+						{GVK: GVK{Group: "core", Version: "v1", Kind: "Secret"}, Mapper: "mapObject"},
+						{GVK: GVK{Group: "core", Version: "v1", Kind: "ConfigMap"}, Mapper: "mapObject"},
+					},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "normalization collision: case insensitive",
 			ctrls: &Controllers{
@@ -379,3 +424,48 @@ func TestResource_GetControllerNames(t *testing.T) {
 		})
 	}
 }
+
+func TestControllers_Update_MergesWatchedResources(t *testing.T) {
+	deployment := GVK{Group: "apps", Version: "v1", Kind: "Deployment"}
+	service := GVK{Group: "core", Version: "v1", Kind: "Service"}
+	secret := Watch{GVK: GVK{Group: "core", Version: "v1", Kind: "Secret"}, Mapper: "mapSecret"}
+
+	ctrls := &Controllers{
+		{Name: "captain", Owns: []GVK{deployment}},
+	}
+	other := &Controllers{
+		{Name: "captain", Owns: []GVK{deployment, service}, Watches: []Watch{secret}, Finalizer: true},
+		{Name: "captain-backup"},
+	}
+
+	if err := ctrls.Update(other); err != nil {
+		t.Fatalf("Controllers.Update() unexpected error = %v", err)
+	}
+
+	if len(*ctrls) != 2 {
+		t.Fatalf("Controllers.Update() len = %d, want 2", len(*ctrls))
+	}
+	captain := (*ctrls)[0]
+	if len(captain.Owns) != 2 || captain.Owns[1] != service {
+		t.Errorf("Controllers.Update() owns = %v, want [%v %v]", captain.Owns, deployment, service)
+	}
+	if len(captain.Watches) != 1 || captain.Watches[0] != secret {
+		t.Errorf("Controllers.Update() watches = %v, want [%v]", captain.Watches, secret)
+	}
+	if !captain.Finalizer {
+		t.Errorf("Controllers.Update() did not record the finalizer")
+	}
+}
+
+func TestControllers_Copy(t *testing.T) {
+	ctrls := &Controllers{
+		{Name: "captain", Owns: []GVK{{Group: "apps", Version: "v1", Kind: "Deployment"}}},
+	}
+
+	copied := ctrls.Copy()
+	copied[0].Owns[0].Kind = "StatefulSet"
+
+	if (*ctrls)[0].Owns[0].Kind != "Deployment" {
+		t.Errorf("Controllers.Copy() did not deep copy the owned resources")
+	}
+}
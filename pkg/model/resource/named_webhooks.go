@@ -0,0 +1,216 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// WebhookType is the type of admission webhook.
+type WebhookType string
+
+const (
+	// DefaultingWebhook is a mutating admission webhook which sets default values.
+	DefaultingWebhook WebhookType = "defaulting"
+	// ValidationWebhook is a validating admission webhook.
+	ValidationWebhook WebhookType = "validation"
+)
+
+// NamedWebhook represents a named admission webhook for a resource.
+// Each named webhook is scaffolded in its own file and registered on its own path,
+// so several of them can be deployed for the same resource (GVK).
+type NamedWebhook struct {
+	// Name is the webhook identifier, unique within a resource.
+	// Must be a valid DNS label (lowercase, alphanumeric, hyphens, max 63 chars).
+	Name string `json:"name"`
+
+	// Type is the type of admission webhook, either defaulting or validation.
+	Type WebhookType `json:"type"`
+
+	// Path holds the custom path for the webhook.
+	// This path is used in the +kubebuilder:webhook marker annotation.
+	Path string `json:"path,omitempty"`
+
+	// Admission holds the admission configuration of the webhook.
+	Admission *AdmissionConfig `json:"admission,omitempty"`
+}
+
+// Validate checks that the NamedWebhook is valid.
+func (w NamedWebhook) Validate() error {
+	if w.Name == "" {
+		return fmt.Errorf("webhook name cannot be empty")
+	}
+
+	// Webhook names must be valid DNS labels
+	if errors := validation.IsDNS1035Label(w.Name); len(errors) != 0 {
+		return fmt.Errorf("invalid webhook name %q: %s", w.Name, strings.Join(errors, ", "))
+	}
+
+	if w.Type != DefaultingWebhook && w.Type != ValidationWebhook {
+		return fmt.Errorf("invalid type %q for webhook %q: must be %q or %q",
+			w.Type, w.Name, DefaultingWebhook, ValidationWebhook)
+	}
+
+	if w.Admission != nil {
+		if err := w.Admission.Validate(w.Type == DefaultingWebhook); err != nil {
+			return fmt.Errorf("invalid admission for webhook %q: %w", w.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// NamedWebhooks holds a list of named admission webhooks for a resource.
+type NamedWebhooks []NamedWebhook
+
+// IsEmpty returns true if there are no named webhooks.
+func (w NamedWebhooks) IsEmpty() bool {
+	return len(w) == 0
+}
+
+// Validate checks that all named webhooks are valid and have unique names.
+// It also detects name collisions that would occur after normalization,
+// such as "cross-object" and "crossobject" both becoming "CrossObject".
+func (w NamedWebhooks) Validate() error {
+	names := make(map[string]bool)
+	normalizedNames := make(map[string]string) // Maps normalized name to original name
+
+	for _, webhook := range w {
+		if err := webhook.Validate(); err != nil {
+			return err
+		}
+
+		// Check for exact duplicate names
+		if names[webhook.Name] {
+			return fmt.Errorf("duplicate webhook name %q", webhook.Name)
+		}
+		names[webhook.Name] = true
+
+		// Check for normalization collisions where different names would generate the same types
+		normalized := normalizeControllerName(webhook.Name)
+		if existingName, exists := normalizedNames[normalized]; exists {
+			return fmt.Errorf("webhook name %q conflicts with %q: both normalize to %q",
+				webhook.Name, existingName, toPascalCase(webhook.Name))
+		}
+		normalizedNames[normalized] = webhook.Name
+	}
+
+	return nil
+}
+
+// HasWebhook returns true if a webhook with the given name exists.
+func (w NamedWebhooks) HasWebhook(name string) bool {
+	for _, webhook := range w {
+		if webhook.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// HasType returns true if at least one webhook is of the given type.
+func (w NamedWebhooks) HasType(webhookType WebhookType) bool {
+	for _, webhook := range w {
+		if webhook.Type == webhookType {
+			return true
+		}
+	}
+	return false
+}
+
+// AddWebhook adds a new named webhook.
+// Returns an error if a webhook with that name already exists.
+func (w *NamedWebhooks) AddWebhook(webhook NamedWebhook) error {
+	if err := webhook.Validate(); err != nil {
+		return err
+	}
+
+	if w.HasWebhook(webhook.Name) {
+		return fmt.Errorf("webhook with name %q already exists", webhook.Name)
+	}
+
+	*w = append(*w, webhook)
+	return nil
+}
+
+// GetWebhookNames returns a slice of all webhook names.
+func (w NamedWebhooks) GetWebhookNames() []string {
+	if w.IsEmpty() {
+		return nil
+	}
+
+	names := make([]string, 0, len(w))
+	for _, webhook := range w {
+		names = append(names, webhook.Name)
+	}
+	return names
+}
+
+// Copy returns a deep copy of the NamedWebhooks.
+func (w NamedWebhooks) Copy() NamedWebhooks {
+	if w == nil {
+		return nil
+	}
+
+	webhooks := make(NamedWebhooks, len(w))
+	for i, webhook := range w {
+		webhook.Admission = webhook.Admission.Copy()
+		webhooks[i] = webhook
+	}
+	return webhooks
+}
+
+// Update combines fields of two NamedWebhooks.
+// It adds webhooks from other that don't exist in w.
+func (w *NamedWebhooks) Update(other NamedWebhooks) {
+	for _, webhook := range other {
+		if !w.HasWebhook(webhook.Name) {
+			*w = append(*w, webhook)
+			continue
+		}
+		if !webhook.Admission.IsEmpty() {
+			for i := range *w {
+				if (*w)[i].Name == webhook.Name {
+					(*w)[i].Admission = webhook.Admission.Copy()
+				}
+			}
+		}
+	}
+}
+
+// NormalizeWebhookTypeName returns the prefix of the types and of the setup function of a named webhook.
+// Example: "cross-object" for the kind Captain becomes "CaptainCrossObject", which is used to scaffold
+// CaptainCrossObjectCustomValidator and SetupCaptainCrossObjectWebhookWithManager.
+func NormalizeWebhookTypeName(webhookName, kind string) string {
+	return kind + toPascalCase(webhookName)
+}
+
+// toPascalCase converts a hyphenated name to PascalCase.
+// Example: "captain-backup" becomes "CaptainBackup".
+func toPascalCase(name string) string {
+	parts := strings.Split(name, "-")
+	var result strings.Builder
+	for _, part := range parts {
+		if len(part) > 0 {
+			result.WriteString(strings.ToUpper(part[:1]) + part[1:])
+		}
+	}
+	return result.String()
+}
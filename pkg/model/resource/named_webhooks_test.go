@@ -0,0 +1,160 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"testing"
+)
+
+func TestNamedWebhook_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		webhook NamedWebhook
+		wantErr bool
+	}{
+		{
+			name:    "valid validation webhook",
+			webhook: NamedWebhook{Name: "cross-object", Type: ValidationWebhook},
+			wantErr: false,
+		},
+		{
+			name:    "valid defaulting webhook with path",
+			webhook: NamedWebhook{Name: "defaults", Type: DefaultingWebhook, Path: "/mutate-defaults"},
+			wantErr: false,
+		},
+		{
+			name:    "empty webhook name",
+			webhook: NamedWebhook{Type: ValidationWebhook},
+			wantErr: true,
+		},
+		{
+			name:    "invalid webhook name with uppercase",
+			webhook: NamedWebhook{Name: "CrossObject", Type: ValidationWebhook},
+			wantErr: true,
+		},
+		{
+			name:    "invalid webhook type",
+			webhook: NamedWebhook{Name: "cross-object", Type: "conversion"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.webhook.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NamedWebhook.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNamedWebhooks_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		webhooks NamedWebhooks
+		wantErr  bool
+	}{
+		{
+			name:     "nil webhooks",
+			webhooks: nil,
+			wantErr:  false,
+		},
+		{
+			name: "unique webhooks",
+			webhooks: NamedWebhooks{
+				{Name: "syntax", Type: ValidationWebhook},
+				{Name: "cross-object", Type: ValidationWebhook},
+			},
+			wantErr: false,
+		},
+		{
+			name: "duplicate webhook names",
+			webhooks: NamedWebhooks{
+				{Name: "syntax", Type: ValidationWebhook},
+				{Name: "syntax", Type: DefaultingWebhook},
+			},
+			wantErr: true,
+		},
+		{
+			name: "webhook names normalizing to the same types",
+			webhooks: NamedWebhooks{
+				{Name: "cross-object", Type: ValidationWebhook},
+				{Name: "crossobject", Type: ValidationWebhook},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.webhooks.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NamedWebhooks.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNamedWebhooks_AddWebhook(t *testing.T) {
+	webhooks := NamedWebhooks{}
+
+	if err := webhooks.AddWebhook(NamedWebhook{Name: "syntax", Type: ValidationWebhook}); err != nil {
+		t.Fatalf("AddWebhook() unexpected error = %v", err)
+	}
+	if err := webhooks.AddWebhook(NamedWebhook{Name: "syntax", Type: DefaultingWebhook}); err == nil {
+		t.Error("AddWebhook() expected an error for a duplicate name")
+	}
+	if err := webhooks.AddWebhook(NamedWebhook{Name: "Invalid", Type: DefaultingWebhook}); err == nil {
+		t.Error("AddWebhook() expected an error for an invalid name")
+	}
+
+	if !webhooks.HasType(ValidationWebhook) || webhooks.HasType(DefaultingWebhook) {
+		t.Errorf("HasType() returned unexpected results for %v", webhooks)
+	}
+}
+
+func TestNamedWebhooks_Update(t *testing.T) {
+	webhooks := NamedWebhooks{{Name: "syntax", Type: ValidationWebhook}}
+	webhooks.Update(NamedWebhooks{
+		{Name: "syntax", Type: ValidationWebhook, Path: "/ignored"},
+		{Name: "defaults", Type: DefaultingWebhook},
+	})
+
+	if len(webhooks) != 2 || webhooks[0].Path != "" || webhooks[1].Name != "defaults" {
+		t.Errorf("Update() = %v, want the existing webhook kept and the new one appended", webhooks)
+	}
+}
+
+func TestNormalizeWebhookTypeName(t *testing.T) {
+	tests := []struct {
+		webhookName string
+		kind        string
+		want        string
+	}{
+		{webhookName: "syntax", kind: "Captain", want: "CaptainSyntax"},
+		{webhookName: "cross-object", kind: "Captain", want: "CaptainCrossObject"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.webhookName, func(t *testing.T) {
+			if got := NormalizeWebhookTypeName(tt.webhookName, tt.kind); got != tt.want {
+				t.Errorf("NormalizeWebhookTypeName() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
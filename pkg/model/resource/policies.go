@@ -0,0 +1,102 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"fmt"
+)
+
+// PolicyType is the type of an admission policy scaffolded for a resource.
+type PolicyType string
+
+const (
+	// ValidatingPolicy is a ValidatingAdmissionPolicy, which rejects the requests that do not match its rules.
+	ValidatingPolicy PolicyType = "validating"
+	// MutatingPolicy is a MutatingAdmissionPolicy, which applies its mutations to the requests.
+	MutatingPolicy PolicyType = "mutating"
+)
+
+// Validate checks that the policy type is supported.
+func (t PolicyType) Validate() error {
+	switch t {
+	case ValidatingPolicy, MutatingPolicy:
+		return nil
+	default:
+		return fmt.Errorf("policy type %q is not supported, must be one of %q or %q",
+			t, ValidatingPolicy, MutatingPolicy)
+	}
+}
+
+// Policies contains information about the admission policies scaffolded for a resource.
+type Policies struct {
+	// Validating is true if a ValidatingAdmissionPolicy was scaffolded for the resource.
+	Validating bool `json:"validating,omitempty"`
+
+	// Mutating is true if a MutatingAdmissionPolicy was scaffolded for the resource.
+	Mutating bool `json:"mutating,omitempty"`
+}
+
+// Copy returns a deep copy of the Policies that can be safely modified without affecting the original.
+func (policies Policies) Copy() Policies {
+	// As this function doesn't use a pointer receiver, policies is already a shallow copy.
+	// Any field that is a pointer, slice or map needs to be deep copied.
+	return policies
+}
+
+// Update combines fields of the policies of two resources.
+func (policies *Policies) Update(other *Policies) error {
+	// If other is nil, nothing to merge
+	if other == nil {
+		return nil
+	}
+
+	policies.Validating = policies.Validating || other.Validating
+	policies.Mutating = policies.Mutating || other.Mutating
+
+	return nil
+}
+
+// IsEmpty returns if the Policies' fields all contain zero-values.
+func (policies Policies) IsEmpty() bool {
+	return !policies.Validating && !policies.Mutating
+}
+
+// Has returns true if a policy of the provided type was scaffolded.
+func (policies Policies) Has(policyType PolicyType) bool {
+	switch policyType {
+	case ValidatingPolicy:
+		return policies.Validating
+	case MutatingPolicy:
+		return policies.Mutating
+	default:
+		return false
+	}
+}
+
+// Set records that a policy of the provided type was scaffolded.
+func (policies *Policies) Set(policyType PolicyType) error {
+	switch policyType {
+	case ValidatingPolicy:
+		policies.Validating = true
+	case MutatingPolicy:
+		policies.Mutating = true
+	default:
+		return policyType.Validate()
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Policies", func() {
+	Context("PolicyType", func() {
+		It("should accept the supported types", func() {
+			Expect(ValidatingPolicy.Validate()).To(Succeed())
+			Expect(MutatingPolicy.Validate()).To(Succeed())
+		})
+
+		It("should fail for unsupported types", func() {
+			Expect(PolicyType("").Validate()).NotTo(Succeed())
+			Expect(PolicyType("Validating").Validate()).NotTo(Succeed())
+		})
+	})
+
+	Context("Update", func() {
+		It("should do nothing if provided a nil pointer", func() {
+			policies := Policies{Validating: true}
+			Expect(policies.Update(nil)).To(Succeed())
+			Expect(policies).To(Equal(Policies{Validating: true}))
+		})
+
+		It("should merge the policies", func() {
+			policies := Policies{Validating: true}
+			Expect(policies.Update(&Policies{Mutating: true})).To(Succeed())
+			Expect(policies).To(Equal(Policies{Validating: true, Mutating: true}))
+		})
+	})
+
+	Context("Set", func() {
+		It("should record the policy type", func() {
+			policies := Policies{}
+			Expect(policies.IsEmpty()).To(BeTrue())
+			Expect(policies.Set(MutatingPolicy)).To(Succeed())
+			Expect(policies.Has(MutatingPolicy)).To(BeTrue())
+			Expect(policies.Has(ValidatingPolicy)).To(BeFalse())
+			Expect(policies.IsEmpty()).To(BeFalse())
+		})
+
+		It("should fail for unsupported types", func() {
+			policies := Policies{}
+			Expect(policies.Set("audit")).NotTo(Succeed())
+			Expect(policies.IsEmpty()).To(BeTrue())
+		})
+	})
+})
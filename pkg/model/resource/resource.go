@@ -47,6 +47,9 @@ type Resource struct {
 	// Webhooks holds the information related to the associated webhooks.
 	Webhooks *Webhooks `json:"webhooks,omitempty"`
 
+	// Policies holds the information related to the associated admission policies.
+	Policies *Policies `json:"policies,omitempty"`
+
 	// External specifies if the resource is defined externally.
 	External bool `json:"external,omitempty"`
 
@@ -165,6 +168,26 @@ func (r Resource) HasConversionWebhook() bool {
 	return r.Webhooks != nil && r.Webhooks.Conversion
 }
 
+// HasNamedWebhooks returns true if the resource has at least one named admission webhook.
+func (r Resource) HasNamedWebhooks() bool {
+	return r.Webhooks != nil && !r.Webhooks.Named.IsEmpty()
+}
+
+// HasMutatingWebhook returns true if the resource has a defaulting webhook, either the default or a named one.
+func (r Resource) HasMutatingWebhook() bool {
+	return r.HasDefaultingWebhook() || (r.Webhooks != nil && r.Webhooks.Named.HasType(DefaultingWebhook))
+}
+
+// HasValidatingWebhook returns true if the resource has a validation webhook, either the default or a named one.
+func (r Resource) HasValidatingWebhook() bool {
+	return r.HasValidationWebhook() || (r.Webhooks != nil && r.Webhooks.Named.HasType(ValidationWebhook))
+}
+
+// HasPolicy returns true if the resource has an associated admission policy of the provided type.
+func (r Resource) HasPolicy(policyType PolicyType) bool {
+	return r.Policies != nil && r.Policies.Has(policyType)
+}
+
 // IsExternal returns true if the resource was scaffold as external.
 func (r Resource) IsExternal() bool {
 	return r.External
@@ -209,6 +232,10 @@ func (r Resource) Copy() Resource {
 		webhooks := r.Webhooks.Copy()
 		r.Webhooks = &webhooks
 	}
+	if r.Policies != nil {
+		policies := r.Policies.Copy()
+		r.Policies = &policies
+	}
 	return r
 }
 
@@ -276,6 +303,14 @@ func (r *Resource) Update(other Resource) error {
 		r.Controller = r.Controller || other.Controller
 	}
 
+	// Update Policies.
+	if r.Policies == nil && other.Policies != nil {
+		r.Policies = &Policies{}
+	}
+	if err := r.Policies.Update(other.Policies); err != nil {
+		return err
+	}
+
 	// Update Webhooks.
 	if r.Webhooks == nil && other.Webhooks != nil {
 		r.Webhooks = &Webhooks{}
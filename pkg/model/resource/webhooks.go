@@ -44,6 +44,15 @@ type Webhooks struct {
 	// ValidationPath holds the custom path for the validation webhook.
 	// This path is used in the +kubebuilder:webhook marker annotation.
 	ValidationPath string `json:"validationPath,omitempty"`
+
+	// DefaultingAdmission holds the admission configuration of the defaulting webhook.
+	DefaultingAdmission *AdmissionConfig `json:"defaultingAdmission,omitempty"`
+
+	// ValidationAdmission holds the admission configuration of the validation webhook.
+	ValidationAdmission *AdmissionConfig `json:"validationAdmission,omitempty"`
+
+	// Named holds the named admission webhooks, scaffolded in addition to the defaulting and validation ones.
+	Named NamedWebhooks `json:"named,omitempty"`
 }
 
 // Validate checks that the Webhooks is valid.
@@ -62,6 +71,23 @@ func (webhooks Webhooks) Validate() error {
 		seen[version] = true
 	}
 
+	// Validate the admission configurations
+	if webhooks.DefaultingAdmission != nil {
+		if err := webhooks.DefaultingAdmission.Validate(true); err != nil {
+			return fmt.Errorf("invalid defaulting webhook admission: %w", err)
+		}
+	}
+	if webhooks.ValidationAdmission != nil {
+		if err := webhooks.ValidationAdmission.Validate(false); err != nil {
+			return fmt.Errorf("invalid validation webhook admission: %w", err)
+		}
+	}
+
+	// Validate the named webhooks
+	if err := webhooks.Named.Validate(); err != nil {
+		return fmt.Errorf("invalid named webhooks: %w", err)
+	}
+
 	return nil
 }
 
@@ -84,6 +110,10 @@ func (webhooks Webhooks) Copy() Webhooks {
 		Spoke:          spokeCopy,
 		DefaultingPath: webhooks.DefaultingPath,
 		ValidationPath: webhooks.ValidationPath,
+		Named:          webhooks.Named.Copy(),
+
+		DefaultingAdmission: webhooks.DefaultingAdmission.Copy(),
+		ValidationAdmission: webhooks.ValidationAdmission.Copy(),
 	}
 }
 
@@ -133,6 +163,17 @@ func (webhooks *Webhooks) Update(other *Webhooks) error {
 		webhooks.ValidationPath = other.ValidationPath
 	}
 
+	// Update admission configurations (other takes precedence if not empty)
+	if !other.DefaultingAdmission.IsEmpty() {
+		webhooks.DefaultingAdmission = other.DefaultingAdmission.Copy()
+	}
+	if !other.ValidationAdmission.IsEmpty() {
+		webhooks.ValidationAdmission = other.ValidationAdmission.Copy()
+	}
+
+	// Update named webhooks (merge without duplicates)
+	webhooks.Named.Update(other.Named)
+
 	return nil
 }
 
@@ -141,7 +182,9 @@ func (webhooks Webhooks) IsEmpty() bool {
 	return webhooks.WebhookVersion == "" &&
 		!webhooks.Defaulting && !webhooks.Validation &&
 		!webhooks.Conversion && len(webhooks.Spoke) == 0 &&
-		webhooks.DefaultingPath == "" && webhooks.ValidationPath == ""
+		webhooks.DefaultingPath == "" && webhooks.ValidationPath == "" &&
+		webhooks.DefaultingAdmission.IsEmpty() && webhooks.ValidationAdmission.IsEmpty() &&
+		webhooks.Named.IsEmpty()
 }
 
 // AddSpoke adds a new spoke version to the Webhooks configuration.
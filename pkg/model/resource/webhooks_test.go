@@ -167,6 +167,16 @@ var _ = Describe("Webhooks", func() {
 			})
 		})
 
+		Context("Admission", func() {
+			It("should set the admission configuration if provided", func() {
+				webhook = Webhooks{Validation: true, ValidationAdmission: &AdmissionConfig{FailurePolicy: "Fail"}}
+				other = Webhooks{Defaulting: true, DefaultingAdmission: &AdmissionConfig{TimeoutSeconds: 5}}
+				Expect(webhook.Update(&other)).To(Succeed())
+				Expect(webhook.DefaultingAdmission).To(Equal(&AdmissionConfig{TimeoutSeconds: 5}))
+				Expect(webhook.ValidationAdmission).To(Equal(&AdmissionConfig{FailurePolicy: "Fail"}))
+			})
+		})
+
 		Context("Conversion", func() {
 			It("should set the conversion webhook if provided and not previously set", func() {
 				webhook = Webhooks{}
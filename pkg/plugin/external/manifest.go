@@ -0,0 +1,52 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+// ManifestFileName is the name of the optional manifest file placed next to the external plugin executable,
+// i.e. `<plugins root>/<name>/<version>/plugin.yaml`.
+const ManifestFileName = "plugin.yaml"
+
+// Manifest describes an external plugin before it gets executed and allows Kubebuilder
+// to verify the integrity of its executable.
+type Manifest struct {
+	// Name is the name of the plugin. It must match the name of the plugin directory.
+	Name string `json:"name"`
+
+	// Version is the version of the plugin. It must match the name of the version directory.
+	Version string `json:"version"`
+
+	// Description is a short description of the plugin shown in the help output.
+	Description string `json:"description,omitempty"`
+
+	// APIVersion is the version of the PluginRequest and PluginResponse schema used by the plugin.
+	APIVersion string `json:"apiVersion,omitempty"`
+
+	// SupportedProjectVersions lists the project versions supported by the plugin, e.g. ["3"].
+	// Defaults to the latest project version when empty.
+	SupportedProjectVersions []string `json:"supportedProjectVersions,omitempty"`
+
+	// Subcommands lists the subcommands supported by the plugin: `init`, `create api`, `create webhook`
+	// and `edit`. Defaults to all of them when empty.
+	Subcommands []string `json:"subcommands,omitempty"`
+
+	// SHA256 is the hex encoded SHA-256 checksum of the plugin executable.
+	SHA256 string `json:"sha256"`
+
+	// Signature is the optional base64 encoded ed25519 signature of the plugin executable.
+	// It is verified against the public keys configured locally.
+	Signature string `json:"signature,omitempty"`
+}
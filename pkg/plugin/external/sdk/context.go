@@ -0,0 +1,146 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sdk
+
+import (
+	"errors"
+	"fmt"
+
+	"sigs.k8s.io/yaml"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	_ "sigs.k8s.io/kubebuilder/v4/pkg/config/v3" // Register the supported project versions.
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/external"
+)
+
+// ErrNoConfig is returned by Context.Config when the request does not contain the PROJECT file configuration,
+// which is the case during the first `init`.
+var ErrNoConfig = errors.New("the request does not contain the project configuration")
+
+// Context holds the request being handled and the response being built.
+type Context struct {
+	// Request is the request sent by Kubebuilder.
+	Request external.PluginRequest
+	// Response is the response that will be returned to Kubebuilder.
+	Response *external.PluginResponse
+	// Flags holds the flags declared by the subcommand, parsed from the request arguments.
+	Flags *FlagSet
+}
+
+// Command returns the subcommand being executed, e.g. `create api`.
+func (c *Context) Command() string {
+	return c.Request.Command
+}
+
+// Phase returns the phase the request was sent for: external.PhasePreScaffold, external.PhasePostScaffold,
+// or empty for the main scaffold phase.
+func (c *Context) Phase() string {
+	return c.Request.Phase
+}
+
+// ReadFile returns the contents of a project file, including the changes made while handling this request.
+func (c *Context) ReadFile(path string) (string, bool) {
+	if content, found := c.Response.Universe[path]; found {
+		return content, true
+	}
+	content, found := c.Request.Universe[path]
+	return content, found
+}
+
+// WriteFile creates or overwrites a project file.
+func (c *Context) WriteFile(path, content string) {
+	if c.Response.Universe == nil {
+		c.Response.Universe = map[string]string{}
+	}
+	c.Response.Universe[path] = content
+}
+
+// RequestFiles asks Kubebuilder for the contents of more files listed in the request UniverseFiles.
+// Kubebuilder will send the request again with those files, so the handler should return right after.
+func (c *Context) RequestFiles(paths ...string) {
+	c.Response.RequestedFiles = append(c.Response.RequestedFiles, paths...)
+}
+
+// Config returns the PROJECT file configuration sent in the request.
+// It returns ErrNoConfig if the request does not contain it.
+func (c *Context) Config() (config.Config, error) {
+	return ConfigFromRequest(c.Request)
+}
+
+// Resource returns a copy of the resource model sent for the `create api` and `create webhook` subcommands,
+// or nil for other subcommands.
+func (c *Context) Resource() *resource.Resource {
+	if c.Request.Resource == nil {
+		return nil
+	}
+	res := c.Request.Resource.Copy()
+	return &res
+}
+
+// SetResource returns the modified resource model to Kubebuilder, which stores it in the PROJECT file.
+func (c *Context) SetResource(res resource.Resource) {
+	c.Response.Resource = &res
+}
+
+// SetPluginConfig returns the plugin configuration to Kubebuilder, which stores it in the PROJECT file
+// under the plugin key. configObj is converted to a map using its JSON tags.
+func (c *Context) SetPluginConfig(configObj any) error {
+	content, err := yaml.Marshal(configObj)
+	if err != nil {
+		return fmt.Errorf("error marshalling plugin config: %w", err)
+	}
+
+	pluginConfig := map[string]any{}
+	if err = yaml.Unmarshal(content, &pluginConfig); err != nil {
+		return fmt.Errorf("error unmarshalling plugin config: %w", err)
+	}
+
+	c.Response.PluginConfig = pluginConfig
+	return nil
+}
+
+// ConfigFromRequest loads the PROJECT file configuration sent in the request into a config.Config.
+// It returns ErrNoConfig if the request does not contain it.
+func ConfigFromRequest(req external.PluginRequest) (config.Config, error) {
+	if len(req.Config) == 0 {
+		return nil, ErrNoConfig
+	}
+
+	content, err := yaml.Marshal(req.Config)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling project configuration: %w", err)
+	}
+
+	versioned := struct {
+		Version config.Version `json:"version"`
+	}{}
+	if err = yaml.Unmarshal(content, &versioned); err != nil {
+		return nil, fmt.Errorf("error reading project version: %w", err)
+	}
+
+	cfg, err := config.New(versioned.Version)
+	if err != nil {
+		return nil, fmt.Errorf("error creating project configuration: %w", err)
+	}
+
+	if err = cfg.UnmarshalYAML(content); err != nil {
+		return nil, fmt.Errorf("error loading project configuration: %w", err)
+	}
+
+	return cfg, nil
+}
@@ -0,0 +1,43 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package sdk provides the building blocks to write Kubebuilder external plugins in Go.
+//
+// A Router reads the external.PluginRequest sent by Kubebuilder on stdin, answers the
+// special `flags` and `metadata` requests from the declared Subcommand definitions,
+// dispatches the other requests to their handlers and writes the external.PluginResponse
+// on stdout:
+//
+//	func main() {
+//		router := sdk.NewRouter()
+//		router.Edit(sdk.Subcommand{
+//			Metadata: plugin.SubcommandMetadata{Description: "Adds monitoring to the project"},
+//			BindFlags: func(fs *sdk.FlagSet) {
+//				fs.Bool("prometheus", true, "add the Prometheus manifests")
+//			},
+//			Scaffold: func(ctx *sdk.Context) error {
+//				if enabled, _ := ctx.Flags.GetBool("prometheus"); enabled {
+//					ctx.WriteFile("config/prometheus/monitor.yaml", monitor)
+//				}
+//				return nil
+//			},
+//		})
+//		router.Run()
+//	}
+//
+// The Harness type plays Kubebuilder's side of the protocol in memory, so plugins can be tested
+// without installing them.
+package sdk
@@ -0,0 +1,125 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sdk
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/pflag"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/external"
+)
+
+// FlagSet declares the typed flags of a subcommand. The declared flags are returned to
+// Kubebuilder for the `flags` request and parsed from the request arguments.
+type FlagSet struct {
+	fs    *pflag.FlagSet
+	flags []external.Flag
+}
+
+// NewFlagSet creates an empty FlagSet.
+// Unknown flags are ignored when parsing, as the arguments also contain the flags of other plugins of the chain.
+func NewFlagSet(name string) *FlagSet {
+	fs := pflag.NewFlagSet(name, pflag.ContinueOnError)
+	fs.ParseErrorsAllowlist = pflag.ParseErrorsAllowlist{UnknownFlags: true}
+	fs.Usage = func() {}
+	return &FlagSet{fs: fs}
+}
+
+// String declares a string flag.
+func (f *FlagSet) String(name, value, usage string) *string {
+	f.flags = append(f.flags, external.Flag{Name: name, Type: "string", Default: value, Usage: usage})
+	return f.fs.String(name, value, usage)
+}
+
+// Bool declares a bool flag.
+func (f *FlagSet) Bool(name string, value bool, usage string) *bool {
+	f.flags = append(f.flags, external.Flag{Name: name, Type: "bool", Default: strconv.FormatBool(value), Usage: usage})
+	return f.fs.Bool(name, value, usage)
+}
+
+// Int declares an int flag.
+func (f *FlagSet) Int(name string, value int, usage string) *int {
+	f.flags = append(f.flags, external.Flag{Name: name, Type: "int", Default: strconv.Itoa(value), Usage: usage})
+	return f.fs.Int(name, value, usage)
+}
+
+// Float64 declares a float flag.
+func (f *FlagSet) Float64(name string, value float64, usage string) *float64 {
+	f.flags = append(f.flags, external.Flag{
+		Name:    name,
+		Type:    "float",
+		Default: strconv.FormatFloat(value, 'g', -1, 64),
+		Usage:   usage,
+	})
+	return f.fs.Float64(name, value, usage)
+}
+
+// GetString returns the value of a string flag.
+func (f *FlagSet) GetString(name string) (string, error) {
+	value, err := f.fs.GetString(name)
+	if err != nil {
+		return "", fmt.Errorf("error getting flag %q: %w", name, err)
+	}
+	return value, nil
+}
+
+// GetBool returns the value of a bool flag.
+func (f *FlagSet) GetBool(name string) (bool, error) {
+	value, err := f.fs.GetBool(name)
+	if err != nil {
+		return false, fmt.Errorf("error getting flag %q: %w", name, err)
+	}
+	return value, nil
+}
+
+// GetInt returns the value of an int flag.
+func (f *FlagSet) GetInt(name string) (int, error) {
+	value, err := f.fs.GetInt(name)
+	if err != nil {
+		return 0, fmt.Errorf("error getting flag %q: %w", name, err)
+	}
+	return value, nil
+}
+
+// GetFloat64 returns the value of a float flag.
+func (f *FlagSet) GetFloat64(name string) (float64, error) {
+	value, err := f.fs.GetFloat64(name)
+	if err != nil {
+		return 0, fmt.Errorf("error getting flag %q: %w", name, err)
+	}
+	return value, nil
+}
+
+// Changed reports whether the flag was set in the request arguments.
+func (f *FlagSet) Changed(name string) bool {
+	return f.fs.Changed(name)
+}
+
+// Flags returns the declared flags in the format expected by Kubebuilder.
+func (f *FlagSet) Flags() []external.Flag {
+	return append([]external.Flag(nil), f.flags...)
+}
+
+// Parse parses the request arguments.
+func (f *FlagSet) Parse(args []string) error {
+	if err := f.fs.Parse(args); err != nil {
+		return fmt.Errorf("error parsing flags: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,309 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sdk
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/external"
+)
+
+// maxUniverseRequests limits how many times a plugin can ask for more files in a single subcommand run,
+// as Kubebuilder does.
+const maxUniverseRequests = 10
+
+// Harness plays Kubebuilder's side of the external plugin protocol in memory, so plugins built with
+// a Router can be tested without installing them.
+//
+// The requests and responses are serialized to JSON, as they would be by Kubebuilder, and the changes
+// returned by the plugin are applied to the Universe, the Resource and the Config of the Harness.
+type Harness struct {
+	// Router is the plugin under test.
+	Router *Router
+
+	// Universe holds the project files, keyed by their path relative to the project root.
+	Universe map[string]string
+
+	// Config is the project configuration sent to the plugin. It can be nil, e.g. to test the first `init`.
+	Config config.Config
+
+	// Resource is the resource model sent for the `create api` and `create webhook` subcommands.
+	Resource *resource.Resource
+
+	// PluginChain is the plugin chain sent to the plugin.
+	PluginChain []string
+
+	// PluginKey is the key under which the plugin configuration is stored in Config.
+	PluginKey string
+
+	// resourceModified is set when the plugin returned a modified resource during the current run.
+	resourceModified bool
+}
+
+// NewHarness creates a Harness for the provided Router with an empty project.
+func NewHarness(router *Router) *Harness {
+	return &Harness{
+		Router:   router,
+		Universe: map[string]string{},
+	}
+}
+
+// Flags returns the flags declared by the plugin for the provided command, e.g. `create api`.
+func (h *Harness) Flags(command string) ([]external.Flag, error) {
+	res, err := h.special(flagsCommand, command)
+	if err != nil {
+		return nil, err
+	}
+	return res.Flags, nil
+}
+
+// Metadata returns the response of the plugin to the `metadata` request for the provided command,
+// e.g. `create api`.
+func (h *Harness) Metadata(command string) (*external.PluginResponse, error) {
+	return h.special(metadataCommand, command)
+}
+
+// Run executes the provided command, e.g. `create api`, with the provided arguments through all the phases
+// declared by the plugin, and applies the returned changes. It returns the response of the scaffold phase.
+func (h *Harness) Run(command string, args ...string) (*external.PluginResponse, error) {
+	metadata, err := h.Metadata(command)
+	if err != nil {
+		return nil, err
+	}
+
+	h.resourceModified = false
+
+	var scaffoldRes *external.PluginResponse
+	for _, phase := range []string{external.PhasePreScaffold, "", external.PhasePostScaffold} {
+		if phase != "" && !slices.Contains(metadata.Phases, phase) {
+			continue
+		}
+
+		req, err := h.request(command, phase, args, metadata.UniverseFilters)
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := h.send(req)
+		if err != nil {
+			return nil, err
+		}
+
+		if err = h.apply(res, phase); err != nil {
+			return nil, err
+		}
+
+		if phase == "" {
+			scaffoldRes = res
+		}
+	}
+
+	return scaffoldRes, nil
+}
+
+// Subcommands returns the extra subcommands declared by the plugin.
+func (h *Harness) Subcommands() ([]external.Subcommand, error) {
+	res, err := h.roundTrip(external.PluginRequest{
+		APIVersion: APIVersion,
+		Command:    subcommandsCommand,
+		Universe:   map[string]string{},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Subcommands, nil
+}
+
+// special sends a `flags` or `metadata` request for the provided command. For extra subcommands,
+// the response is built from their declaration, as Kubebuilder does not send those requests for them.
+func (h *Harness) special(special, command string) (*external.PluginResponse, error) {
+	arg := ""
+	for subcommandArg, subcommand := range subcommandArgs {
+		if subcommand == command {
+			arg = subcommandArg
+		}
+	}
+	if arg == "" {
+		return h.extraSubcommand(command)
+	}
+
+	return h.roundTrip(external.PluginRequest{
+		APIVersion: APIVersion,
+		Command:    special,
+		Args:       []string{arg},
+		Universe:   map[string]string{},
+	})
+}
+
+// extraSubcommand returns the declaration of the provided extra subcommand as a metadata response.
+func (h *Harness) extraSubcommand(command string) (*external.PluginResponse, error) {
+	subcommands, err := h.Subcommands()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, s := range subcommands {
+		if s.Command == command {
+			return &external.PluginResponse{
+				APIVersion:      APIVersion,
+				Command:         command,
+				Metadata:        s.Metadata,
+				Flags:           s.Flags,
+				UniverseFilters: s.UniverseFilters,
+				Phases:          s.Phases,
+			}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unknown command %q", command)
+}
+
+// request builds the request for the provided command and phase.
+func (h *Harness) request(command, phase string, args, universeFilters []string) (external.PluginRequest, error) {
+	req := external.PluginRequest{
+		APIVersion:  APIVersion,
+		Command:     command,
+		Args:        args,
+		PluginChain: h.PluginChain,
+		Phase:       phase,
+	}
+
+	if len(universeFilters) == 0 {
+		req.Universe = maps.Clone(h.Universe)
+	} else {
+		req.Universe = map[string]string{}
+		req.UniverseFiles = slices.Sorted(maps.Keys(h.Universe))
+		for _, file := range req.UniverseFiles {
+			if external.MatchUniverseFilters(universeFilters, file) {
+				req.Universe[file] = h.Universe[file]
+			}
+		}
+	}
+
+	if h.Config != nil {
+		content, err := h.Config.MarshalYAML()
+		if err != nil {
+			return req, fmt.Errorf("error marshalling project configuration: %w", err)
+		}
+		if err = yaml.Unmarshal(content, &req.Config); err != nil {
+			return req, fmt.Errorf("error unmarshalling project configuration: %w", err)
+		}
+	}
+
+	if h.Resource != nil && (command == CreateAPICommand || command == CreateWebhookCommand) {
+		res := h.Resource.Copy()
+		req.Resource = &res
+	}
+
+	return req, nil
+}
+
+// send sends the request, adding the files requested by the plugin to the universe until it stops asking for more.
+func (h *Harness) send(req external.PluginRequest) (*external.PluginResponse, error) {
+	for range maxUniverseRequests {
+		res, err := h.roundTrip(req)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(res.RequestedFiles) == 0 {
+			return res, nil
+		}
+
+		for _, file := range res.RequestedFiles {
+			if !slices.Contains(req.UniverseFiles, file) {
+				return nil, fmt.Errorf("requested file %q is not available in the universe", file)
+			}
+			req.Universe[file] = h.Universe[file]
+		}
+	}
+
+	return nil, fmt.Errorf("plugin requested more files over %d times", maxUniverseRequests)
+}
+
+// roundTrip serializes the request, serves it with the Router and deserializes the response.
+func (h *Harness) roundTrip(req external.PluginRequest) (*external.PluginResponse, error) {
+	in, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling plugin request: %w", err)
+	}
+
+	out := &bytes.Buffer{}
+	if err = h.Router.Serve(bytes.NewReader(in), out); err != nil {
+		return nil, err
+	}
+
+	res := &external.PluginResponse{}
+	if err = json.Unmarshal(out.Bytes(), res); err != nil {
+		return nil, fmt.Errorf("error unmarshalling plugin response: %w", err)
+	}
+
+	if res.Error {
+		return nil, errors.New(strings.Join(res.ErrorMsgs, "\n"))
+	}
+
+	return res, nil
+}
+
+// apply applies the changes returned by the plugin for the provided phase, as Kubebuilder does.
+func (h *Harness) apply(res *external.PluginResponse, phase string) error {
+	maps.Copy(h.Universe, res.Universe)
+
+	// Changes to the resource and the plugin configuration are ignored in the post-scaffold phase.
+	if phase == external.PhasePostScaffold {
+		return nil
+	}
+
+	if res.Resource != nil {
+		if h.Resource == nil {
+			return errors.New("plugin returned a resource for a subcommand that does not require one")
+		}
+		if !h.Resource.IsEqualTo(res.Resource.GVK) {
+			return fmt.Errorf("plugin cannot modify the group, version or kind of the resource %q", h.Resource.GVK)
+		}
+		*h.Resource = res.Resource.Copy()
+		h.resourceModified = true
+	}
+
+	// Resources are only stored once the plugin scaffolded its files.
+	if phase == "" && h.resourceModified && h.Config != nil {
+		if err := h.Config.UpdateResource(*h.Resource); err != nil {
+			return fmt.Errorf("error updating resource %q in the configuration: %w", h.Resource.GVK, err)
+		}
+	}
+
+	if res.PluginConfig != nil {
+		if h.Config == nil || h.PluginKey == "" {
+			return errors.New("plugin returned a plugin config but no project configuration or plugin key is set")
+		}
+		if err := h.Config.EncodePluginConfig(h.PluginKey, res.PluginConfig); err != nil {
+			return fmt.Errorf("error encoding the plugin config for %q: %w", h.PluginKey, err)
+		}
+	}
+
+	return nil
+}
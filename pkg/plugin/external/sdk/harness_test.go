@@ -0,0 +1,169 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sdk_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	cfgv3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/external"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/external/sdk"
+)
+
+type samplePluginConfig struct {
+	Resources []string `json:"resources"`
+}
+
+var _ = Describe("Harness", func() {
+	var (
+		router  *sdk.Router
+		harness *sdk.Harness
+		cfg     config.Config
+		res     resource.Resource
+	)
+
+	BeforeEach(func() {
+		cfg = cfgv3.New()
+		Expect(cfg.SetDomain("my.domain")).To(Succeed())
+		Expect(cfg.SetRepository("github.com/example/sample")).To(Succeed())
+
+		res = resource.Resource{
+			GVK:      resource.GVK{Group: "crew", Domain: "my.domain", Version: "v1", Kind: "Captain"},
+			Plural:   "captains",
+			Path:     "github.com/example/sample/api/v1",
+			API:      &resource.API{CRDVersion: "v1", Namespaced: true},
+			External: false,
+		}
+
+		router = sdk.NewRouter()
+		harness = sdk.NewHarness(router)
+		harness.Config = cfg
+		harness.Resource = &res
+		harness.PluginKey = "sample.example.com/v1"
+		harness.Universe["PROJECT"] = "domain: my.domain\n"
+		harness.Universe["api/v1/captain_types.go"] = "package v1\n"
+	})
+
+	It("should return the flags and the metadata", func() {
+		router.CreateAPI(sdk.Subcommand{
+			BindFlags: func(fs *sdk.FlagSet) { fs.Bool("sample", false, "sample flag") },
+			Scaffold:  func(*sdk.Context) error { return nil },
+		})
+
+		flags, err := harness.Flags(sdk.CreateAPICommand)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(flags).To(ConsistOf(external.Flag{Name: "sample", Type: "bool", Default: "false", Usage: "sample flag"}))
+
+		_, err = harness.Metadata("create policy")
+		Expect(err).To(MatchError(ContainSubstring("unknown command")))
+	})
+
+	It("should run the declared phases and apply the changes", func() {
+		var phases []string
+		router.CreateAPI(sdk.Subcommand{
+			PreScaffold: func(ctx *sdk.Context) error {
+				phases = append(phases, ctx.Phase())
+				r := ctx.Resource()
+				r.Webhooks = &resource.Webhooks{WebhookVersion: "v1", Defaulting: true}
+				ctx.SetResource(*r)
+				return nil
+			},
+			Scaffold: func(ctx *sdk.Context) error {
+				phases = append(phases, ctx.Phase())
+				Expect(ctx.Resource().HasDefaultingWebhook()).To(BeTrue())
+
+				c, err := ctx.Config()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(c.GetDomain()).To(Equal("my.domain"))
+
+				ctx.WriteFile("internal/sample.go", "package internal\n")
+				return ctx.SetPluginConfig(samplePluginConfig{Resources: []string{ctx.Resource().Kind}})
+			},
+			PostScaffold: func(ctx *sdk.Context) error {
+				phases = append(phases, ctx.Phase())
+				content, found := ctx.ReadFile("internal/sample.go")
+				Expect(found).To(BeTrue())
+				ctx.WriteFile("internal/sample.go", content+"// post-scaffold\n")
+				return nil
+			},
+		})
+
+		_, err := harness.Run(sdk.CreateAPICommand)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(phases).To(Equal([]string{external.PhasePreScaffold, "", external.PhasePostScaffold}))
+		Expect(harness.Universe).To(HaveKeyWithValue("internal/sample.go", "package internal\n// post-scaffold\n"))
+		Expect(res.HasDefaultingWebhook()).To(BeTrue())
+
+		stored, err := cfg.GetResource(res.GVK)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stored.HasDefaultingWebhook()).To(BeTrue())
+
+		pluginConfig := samplePluginConfig{}
+		Expect(cfg.DecodePluginConfig("sample.example.com/v1", &pluginConfig)).To(Succeed())
+		Expect(pluginConfig.Resources).To(Equal([]string{"Captain"}))
+	})
+
+	It("should refuse changes to the group, version or kind of the resource", func() {
+		router.CreateAPI(sdk.Subcommand{
+			Scaffold: func(ctx *sdk.Context) error {
+				r := ctx.Resource()
+				r.Kind = "FirstMate"
+				ctx.SetResource(*r)
+				return nil
+			},
+		})
+
+		_, err := harness.Run(sdk.CreateAPICommand)
+		Expect(err).To(MatchError(ContainSubstring("cannot modify the group, version or kind")))
+	})
+
+	It("should only send the filtered universe and the requested files", func() {
+		router.Edit(sdk.Subcommand{
+			UniverseFilters: []string{"PROJECT"},
+			Scaffold: func(ctx *sdk.Context) error {
+				Expect(ctx.Request.UniverseFiles).To(ConsistOf("PROJECT", "api/v1/captain_types.go"))
+				if _, found := ctx.ReadFile("api/v1/captain_types.go"); !found {
+					ctx.RequestFiles("api/v1/captain_types.go")
+					return nil
+				}
+				ctx.WriteFile("done", "true")
+				return nil
+			},
+		})
+
+		_, err := harness.Run(sdk.EditCommand)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(harness.Universe).To(HaveKeyWithValue("done", "true"))
+	})
+
+	It("should return the plugin errors", func() {
+		router.Init(sdk.Subcommand{
+			Scaffold: func(ctx *sdk.Context) error {
+				_, err := ctx.Config()
+				return err
+			},
+		})
+		harness.Config = nil
+
+		_, err := harness.Run(sdk.InitCommand)
+		Expect(err).To(MatchError(sdk.ErrNoConfig.Error()))
+	})
+})
@@ -0,0 +1,268 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sdk
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/external"
+)
+
+const (
+	// APIVersion is the version of the PluginRequest and PluginResponse schema implemented by the Router.
+	APIVersion = "v1alpha1"
+
+	// InitCommand is the command sent by Kubebuilder for the `init` subcommand.
+	InitCommand = "init"
+	// CreateAPICommand is the command sent by Kubebuilder for the `create api` subcommand.
+	CreateAPICommand = "create api"
+	// CreateWebhookCommand is the command sent by Kubebuilder for the `create webhook` subcommand.
+	CreateWebhookCommand = "create webhook"
+	// EditCommand is the command sent by Kubebuilder for the `edit` subcommand.
+	EditCommand = "edit"
+
+	// flagsCommand is the command sent by Kubebuilder to get the flags of a subcommand.
+	flagsCommand = "flags"
+	// metadataCommand is the command sent by Kubebuilder to get the metadata of a subcommand.
+	metadataCommand = "metadata"
+	// subcommandsCommand is the command sent by Kubebuilder to get the extra subcommands of the plugin.
+	subcommandsCommand = "subcommands"
+)
+
+// subcommandArgs maps the argument sent with the `flags` and `metadata` requests to the subcommand it refers to.
+var subcommandArgs = map[string]string{
+	"--init":    InitCommand,
+	"--api":     CreateAPICommand,
+	"--webhook": CreateWebhookCommand,
+	"--edit":    EditCommand,
+}
+
+// HandlerFunc handles a request for a subcommand phase.
+// Returning an error reports the plugin failure to Kubebuilder.
+type HandlerFunc func(ctx *Context) error
+
+// Subcommand defines how a plugin implements one of the Kubebuilder subcommands.
+type Subcommand struct {
+	// Metadata is the help text returned to Kubebuilder.
+	Metadata plugin.SubcommandMetadata
+
+	// Short is the short description of an extra subcommand, shown in the help of its parent command.
+	Short string
+
+	// ProjectAccess is the access to the PROJECT file that an extra subcommand needs. Defaults to write.
+	ProjectAccess plugin.ProjectAccess
+
+	// UniverseFilters are the glob patterns of the files the plugin needs in the request universe.
+	// The whole project is sent when empty.
+	UniverseFilters []string
+
+	// BindFlags declares the flags of the subcommand.
+	BindFlags func(fs *FlagSet)
+
+	// PreScaffold is the optional handler of the pre-scaffold phase.
+	PreScaffold HandlerFunc
+
+	// Scaffold is the handler of the main scaffold phase.
+	Scaffold HandlerFunc
+
+	// PostScaffold is the optional handler of the post-scaffold phase.
+	PostScaffold HandlerFunc
+}
+
+// phases returns the optional phases implemented by the subcommand.
+func (s Subcommand) phases() []string {
+	var phases []string
+	if s.PreScaffold != nil {
+		phases = append(phases, external.PhasePreScaffold)
+	}
+	if s.PostScaffold != nil {
+		phases = append(phases, external.PhasePostScaffold)
+	}
+	return phases
+}
+
+// handler returns the handler of the provided phase.
+func (s Subcommand) handler(phase string) HandlerFunc {
+	switch phase {
+	case external.PhasePreScaffold:
+		return s.PreScaffold
+	case external.PhasePostScaffold:
+		return s.PostScaffold
+	default:
+		return s.Scaffold
+	}
+}
+
+// flagSet returns a new FlagSet with the flags declared by the subcommand.
+func (s Subcommand) flagSet(command string) *FlagSet {
+	fs := NewFlagSet(command)
+	if s.BindFlags != nil {
+		s.BindFlags(fs)
+	}
+	return fs
+}
+
+// Router dispatches the requests sent by Kubebuilder to the subcommands implemented by the plugin.
+type Router struct {
+	subcommands map[string]Subcommand
+	// extraCommands are the commands of the extra subcommands, in registration order.
+	extraCommands []string
+}
+
+// NewRouter creates a Router without subcommands.
+func NewRouter() *Router {
+	return &Router{subcommands: map[string]Subcommand{}}
+}
+
+// Init registers the implementation of the `init` subcommand.
+func (r *Router) Init(s Subcommand) {
+	r.subcommands[InitCommand] = s
+}
+
+// CreateAPI registers the implementation of the `create api` subcommand.
+func (r *Router) CreateAPI(s Subcommand) {
+	r.subcommands[CreateAPICommand] = s
+}
+
+// CreateWebhook registers the implementation of the `create webhook` subcommand.
+func (r *Router) CreateWebhook(s Subcommand) {
+	r.subcommands[CreateWebhookCommand] = s
+}
+
+// Edit registers the implementation of the `edit` subcommand.
+func (r *Router) Edit(s Subcommand) {
+	r.subcommands[EditCommand] = s
+}
+
+// AddSubcommand registers an extra subcommand that the plugin adds to the CLI, e.g. `create policy`.
+func (r *Router) AddSubcommand(command string, s Subcommand) {
+	command = strings.Join(strings.Fields(command), " ")
+	if _, found := r.subcommands[command]; !found {
+		r.extraCommands = append(r.extraCommands, command)
+	}
+	r.subcommands[command] = s
+}
+
+// Run serves a single request read from stdin and writes the response to stdout.
+// It exits with a non-zero code if the request could not be read or the response could not be written.
+func (r *Router) Run() {
+	if err := r.Serve(os.Stdin, os.Stdout); err != nil {
+		_, _ = fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// Serve reads a single request from in and writes the response to out.
+func (r *Router) Serve(in io.Reader, out io.Writer) error {
+	req := external.PluginRequest{}
+	if err := json.NewDecoder(in).Decode(&req); err != nil {
+		return fmt.Errorf("error decoding plugin request: %w", err)
+	}
+
+	if err := json.NewEncoder(out).Encode(r.Handle(req)); err != nil {
+		return fmt.Errorf("error encoding plugin response: %w", err)
+	}
+
+	return nil
+}
+
+// Handle returns the response to the provided request.
+func (r *Router) Handle(req external.PluginRequest) external.PluginResponse {
+	res := external.PluginResponse{
+		APIVersion: APIVersion,
+		Command:    req.Command,
+		Universe:   req.Universe,
+	}
+
+	switch req.Command {
+	case subcommandsCommand:
+		res.Subcommands = make([]external.Subcommand, 0, len(r.extraCommands))
+		for _, command := range r.extraCommands {
+			s := r.subcommands[command]
+			res.Subcommands = append(res.Subcommands, external.Subcommand{
+				Command:         command,
+				Short:           s.Short,
+				Metadata:        s.Metadata,
+				Flags:           s.flagSet(command).Flags(),
+				ProjectAccess:   s.ProjectAccess,
+				UniverseFilters: s.UniverseFilters,
+				Phases:          s.phases(),
+			})
+		}
+		return res
+	case flagsCommand, metadataCommand:
+		command, s, err := r.subcommandFromArgs(req.Args)
+		if err != nil {
+			return withError(res, err)
+		}
+		if req.Command == flagsCommand {
+			res.Flags = s.flagSet(command).Flags()
+		} else {
+			res.Metadata = s.Metadata
+			res.UniverseFilters = s.UniverseFilters
+			res.Phases = s.phases()
+		}
+		return res
+	}
+
+	s, found := r.subcommands[req.Command]
+	if !found {
+		return withError(res, fmt.Errorf("unsupported command %q", req.Command))
+	}
+
+	handler := s.handler(req.Phase)
+	if handler == nil {
+		return res
+	}
+
+	ctx := &Context{Request: req, Response: &res, Flags: s.flagSet(req.Command)}
+	if err := ctx.Flags.Parse(req.Args); err != nil {
+		return withError(res, err)
+	}
+
+	if err := handler(ctx); err != nil {
+		return withError(res, err)
+	}
+
+	return res
+}
+
+// subcommandFromArgs returns the subcommand referred to by the arguments of a `flags` or `metadata` request.
+func (r *Router) subcommandFromArgs(args []string) (string, Subcommand, error) {
+	for _, arg := range args {
+		if command, found := subcommandArgs[arg]; found {
+			s, registered := r.subcommands[command]
+			if !registered {
+				return "", Subcommand{}, fmt.Errorf("unsupported subcommand %q", command)
+			}
+			return command, s, nil
+		}
+	}
+	return "", Subcommand{}, fmt.Errorf("unknown subcommand in arguments %q", strings.Join(args, " "))
+}
+
+// withError marks the response as failed with the provided error.
+func withError(res external.PluginResponse, err error) external.PluginResponse {
+	res.Error = true
+	res.ErrorMsgs = append(res.ErrorMsgs, err.Error())
+	return res
+}
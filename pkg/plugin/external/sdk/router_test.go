@@ -0,0 +1,171 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sdk_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/external"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/external/sdk"
+)
+
+var _ = Describe("Router", func() {
+	var router *sdk.Router
+
+	BeforeEach(func() {
+		router = sdk.NewRouter()
+		router.Edit(sdk.Subcommand{
+			Metadata:        plugin.SubcommandMetadata{Description: "edit the project"},
+			UniverseFilters: []string{"PROJECT"},
+			BindFlags: func(fs *sdk.FlagSet) {
+				fs.String("name", "default", "name of the file")
+				fs.Bool("enabled", true, "enable the feature")
+				fs.Int("replicas", 1, "number of replicas")
+				fs.Float64("ratio", 0.5, "ratio")
+			},
+			PreScaffold: func(*sdk.Context) error { return nil },
+			Scaffold: func(ctx *sdk.Context) error {
+				name, err := ctx.Flags.GetString("name")
+				if err != nil {
+					return err
+				}
+				if name == "fail" {
+					return errors.New("failed on purpose")
+				}
+				ctx.WriteFile(name+".txt", "content")
+				return nil
+			},
+		})
+	})
+
+	It("should return the declared flags", func() {
+		res := router.Handle(external.PluginRequest{Command: "flags", Args: []string{"--edit"}})
+
+		Expect(res.Error).To(BeFalse())
+		Expect(res.Flags).To(Equal([]external.Flag{
+			{Name: "name", Type: "string", Default: "default", Usage: "name of the file"},
+			{Name: "enabled", Type: "bool", Default: "true", Usage: "enable the feature"},
+			{Name: "replicas", Type: "int", Default: "1", Usage: "number of replicas"},
+			{Name: "ratio", Type: "float", Default: "0.5", Usage: "ratio"},
+		}))
+	})
+
+	It("should return the metadata, universe filters and phases", func() {
+		res := router.Handle(external.PluginRequest{Command: "metadata", Args: []string{"--edit"}})
+
+		Expect(res.Error).To(BeFalse())
+		Expect(res.Metadata.Description).To(Equal("edit the project"))
+		Expect(res.UniverseFilters).To(Equal([]string{"PROJECT"}))
+		Expect(res.Phases).To(Equal([]string{external.PhasePreScaffold}))
+	})
+
+	It("should fail the flags request for a subcommand that is not registered", func() {
+		res := router.Handle(external.PluginRequest{Command: "flags", Args: []string{"--api"}})
+
+		Expect(res.Error).To(BeTrue())
+		Expect(res.ErrorMsgs).To(ContainElement(ContainSubstring("unsupported subcommand")))
+	})
+
+	It("should dispatch the request with the parsed flags", func() {
+		res := router.Handle(external.PluginRequest{
+			Command:  sdk.EditCommand,
+			Args:     []string{"--name", "sample", "--other-plugin-flag", "value"},
+			Universe: map[string]string{"PROJECT": "version: \"3\"\n"},
+		})
+
+		Expect(res.Error).To(BeFalse())
+		Expect(res.Command).To(Equal(sdk.EditCommand))
+		Expect(res.Universe).To(HaveKeyWithValue("sample.txt", "content"))
+		Expect(res.Universe).To(HaveKey("PROJECT"))
+	})
+
+	It("should report the handler errors", func() {
+		res := router.Handle(external.PluginRequest{Command: sdk.EditCommand, Args: []string{"--name", "fail"}})
+
+		Expect(res.Error).To(BeTrue())
+		Expect(res.ErrorMsgs).To(Equal([]string{"failed on purpose"}))
+	})
+
+	It("should fail for unsupported commands", func() {
+		res := router.Handle(external.PluginRequest{Command: sdk.InitCommand})
+
+		Expect(res.Error).To(BeTrue())
+		Expect(res.ErrorMsgs).To(ContainElement(ContainSubstring("unsupported command")))
+	})
+
+	It("should serve JSON requests", func() {
+		in, err := json.Marshal(external.PluginRequest{APIVersion: sdk.APIVersion, Command: sdk.EditCommand})
+		Expect(err).NotTo(HaveOccurred())
+
+		out := &bytes.Buffer{}
+		Expect(router.Serve(bytes.NewReader(in), out)).To(Succeed())
+
+		res := external.PluginResponse{}
+		Expect(json.Unmarshal(out.Bytes(), &res)).To(Succeed())
+		Expect(res.APIVersion).To(Equal(sdk.APIVersion))
+		Expect(res.Universe).To(HaveKeyWithValue("default.txt", "content"))
+	})
+
+	It("should fail to serve invalid requests", func() {
+		Expect(router.Serve(bytes.NewReader([]byte("{")), &bytes.Buffer{})).
+			To(MatchError(ContainSubstring("error decoding plugin request")))
+	})
+	Context("extra subcommands", func() {
+		BeforeEach(func() {
+			router.AddSubcommand("create  policy", sdk.Subcommand{
+				Short:         "Scaffold a policy",
+				ProjectAccess: plugin.ProjectAccessRead,
+				BindFlags: func(fs *sdk.FlagSet) {
+					fs.String("kind", "", "kind of the policy")
+				},
+				Scaffold: func(ctx *sdk.Context) error {
+					kind, err := ctx.Flags.GetString("kind")
+					if err != nil {
+						return err
+					}
+					ctx.WriteFile(kind+".yaml", "kind: "+kind)
+					return nil
+				},
+			})
+		})
+
+		It("should list the extra subcommands", func() {
+			res := router.Handle(external.PluginRequest{Command: "subcommands"})
+
+			Expect(res.Error).To(BeFalse())
+			Expect(res.Subcommands).To(Equal([]external.Subcommand{{
+				Command:       "create policy",
+				Short:         "Scaffold a policy",
+				Flags:         []external.Flag{{Name: "kind", Type: "string", Usage: "kind of the policy"}},
+				ProjectAccess: plugin.ProjectAccessRead,
+			}}))
+		})
+
+		It("should dispatch the request to the extra subcommand", func() {
+			res := router.Handle(external.PluginRequest{Command: "create policy", Args: []string{"--kind", "Audit"}})
+
+			Expect(res.Error).To(BeFalse())
+			Expect(res.Universe).To(HaveKeyWithValue("Audit.yaml", "kind: Audit"))
+		})
+	})
+})
@@ -16,7 +16,20 @@ limitations under the License.
 
 package external
 
-import "sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+import (
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+)
+
+const (
+	// PhasePreScaffold is the phase of the requests sent before any plugin scaffolds files.
+	// Plugins can use it to validate the request, and to modify the resource model or their plugin config.
+	PhasePreScaffold = "pre-scaffold"
+
+	// PhasePostScaffold is the phase of the requests sent after all the plugins scaffolded their files
+	// and the PROJECT file was saved.
+	PhasePostScaffold = "post-scaffold"
+)
 
 // PluginRequest contains all information kubebuilder received from the CLI
 // and plugins executed before it.
@@ -44,6 +57,22 @@ type PluginRequest struct {
 	// Config contains the PROJECT file config. This field may be empty if the
 	// project is being initialized and the PROJECT file has not been created yet.
 	Config map[string]any `json:"config,omitempty"`
+
+	// UniverseFiles lists the relative paths of every project file that Kubebuilder can provide
+	// to the plugin, after excluding the paths ignored by the project .gitignore files.
+	// It is only set when the plugin declared UniverseFilters: in that case Universe only holds the
+	// contents of the files matching those filters, and the contents of any other file listed here
+	// can be requested on demand through PluginResponse.RequestedFiles.
+	UniverseFiles []string `json:"universeFiles,omitempty"`
+
+	// Phase is the phase of the subcommand the request is sent for: PhasePreScaffold, PhasePostScaffold,
+	// or empty for the main scaffold phase. Requests for the pre-scaffold and post-scaffold phases are only
+	// sent to plugins that declared them in PluginResponse.Phases.
+	Phase string `json:"phase,omitempty"`
+
+	// Resource contains the resource model for the `create api` and `create webhook` subcommands,
+	// as built from the CLI flags and the plugins executed before.
+	Resource *resource.Resource `json:"resource,omitempty"`
 }
 
 // PluginResponse is returned to kubebuilder by the plugin and contains all files
@@ -72,6 +101,62 @@ type PluginResponse struct {
 	// Flags contains the plugin specific flags that the plugin returns to Kubebuilder when it receives
 	// a request for a list of supported flags from Kubebuilder
 	Flags []Flag `json:"flags,omitempty"`
+
+	// UniverseFilters contains glob patterns (e.g. "PROJECT", "api/**/*_types.go") that the plugin returns
+	// to Kubebuilder when it receives the `metadata` request. When set, Kubebuilder only sends the contents of
+	// the matching files in the PluginRequest universe instead of the whole project tree.
+	UniverseFilters []string `json:"universeFilters,omitempty"`
+
+	// RequestedFiles contains the relative paths, taken from PluginRequest.UniverseFiles, of additional files
+	// whose contents the plugin needs. When set, Kubebuilder adds those files to the universe and sends the
+	// request again, so Universe and the other fields of this response are ignored.
+	RequestedFiles []string `json:"requestedFiles,omitempty"`
+
+	// Phases contains the optional phases (PhasePreScaffold, PhasePostScaffold) that the plugin supports,
+	// and that it returns to Kubebuilder when it receives the `metadata` request.
+	Phases []string `json:"phases,omitempty"`
+
+	// Resource contains the resource model modified by the plugin, e.g. to set its API or Webhooks fields.
+	// Kubebuilder replaces the resource of the `create api` and `create webhook` subcommands with it and
+	// stores it in the PROJECT file. The group, version and kind of the resource cannot be modified.
+	Resource *resource.Resource `json:"resource,omitempty"`
+
+	// PluginConfig contains the plugin specific configuration that Kubebuilder stores in the PROJECT file
+	// under the plugin key, overwriting any previous value.
+	PluginConfig map[string]any `json:"pluginConfig,omitempty"`
+
+	// Subcommands contains the subcommands that the plugin adds to the CLI in addition to init, create api,
+	// create webhook and edit. The plugin returns them to Kubebuilder when it receives the `subcommands` request.
+	Subcommands []Subcommand `json:"subcommands,omitempty"`
+}
+
+// Subcommand describes a subcommand that an external plugin adds to the CLI, e.g. `create policy`.
+// Kubebuilder sends the requests of the subcommand with its Command, following the same phases as
+// the other subcommands.
+type Subcommand struct {
+	// Command is the space separated path of the subcommand below the root command,
+	// e.g. "create policy" or "alpha audit". Missing parent commands are created.
+	Command string `json:"command"`
+
+	// Short is the short description shown in the help of the parent command.
+	Short string `json:"short,omitempty"`
+
+	// Metadata contains the help text of the subcommand.
+	Metadata plugin.SubcommandMetadata `json:"metadata"`
+
+	// Flags contains the flags of the subcommand.
+	Flags []Flag `json:"flags,omitempty"`
+
+	// ProjectAccess is the access to the PROJECT file that the subcommand needs: "none", "read" or "write".
+	// With "none", the subcommand can run outside a project and the request has no config.
+	// Defaults to "write".
+	ProjectAccess plugin.ProjectAccess `json:"projectAccess,omitempty"`
+
+	// UniverseFilters has the same meaning as PluginResponse.UniverseFilters for the subcommand requests.
+	UniverseFilters []string `json:"universeFilters,omitempty"`
+
+	// Phases has the same meaning as PluginResponse.Phases for the subcommand requests.
+	Phases []string `json:"phases,omitempty"`
 }
 
 // Flag is meant to represent a CLI flag that is used by Kubebuilder to define flags that are parsed
@@ -76,6 +76,21 @@ type Edit interface {
 	GetEditSubcommand() EditSubcommand
 }
 
+// EditAPI is an interface for plugins that provide an `edit api` subcommand.
+type EditAPI interface {
+	Plugin
+	// GetEditAPISubcommand returns the underlying EditAPISubcommand interface.
+	GetEditAPISubcommand() EditAPISubcommand
+}
+
+// HasExtraSubcommands is an interface for plugins that provide subcommands in addition to
+// `init`, `create api`, `create webhook` and `edit`, e.g. `create policy` or `alpha audit`.
+type HasExtraSubcommands interface {
+	Plugin
+	// GetExtraSubcommands returns the additional subcommands provided by the plugin.
+	GetExtraSubcommands() []ExtraSubcommand
+}
+
 // Full is an interface for plugins that provide `init`, `create api`, `create webhook` and `edit` subcommands.
 type Full interface {
 	Init
@@ -17,6 +17,8 @@ limitations under the License.
 package plugin
 
 import (
+	"fmt"
+
 	"github.com/spf13/pflag"
 
 	"sigs.k8s.io/kubebuilder/v4/pkg/config"
@@ -92,3 +94,50 @@ type CreateWebhookSubcommand interface {
 type EditSubcommand interface {
 	Subcommand
 }
+
+// EditAPISubcommand is an interface that represents an `edit api` subcommand.
+// The injected resource is the one being edited and must already exist in the project configuration.
+type EditAPISubcommand interface {
+	Subcommand
+	RequiresResource
+}
+
+// ProjectAccess is the access to the project configuration that an ExtraSubcommand needs.
+type ProjectAccess string
+
+const (
+	// ProjectAccessNone is used by subcommands that do not use the project configuration,
+	// which can therefore be run outside a project.
+	ProjectAccessNone ProjectAccess = "none"
+	// ProjectAccessRead is used by subcommands that read the project configuration without modifying it.
+	ProjectAccessRead ProjectAccess = "read"
+	// ProjectAccessWrite is used by subcommands that modify the project configuration,
+	// which is saved after the scaffold.
+	ProjectAccessWrite ProjectAccess = "write"
+)
+
+// Validate checks that the project access is one of the supported values.
+func (a ProjectAccess) Validate() error {
+	switch a {
+	case ProjectAccessNone, ProjectAccessRead, ProjectAccessWrite:
+		return nil
+	default:
+		return fmt.Errorf("unsupported project access %q, supported: %q, %q, %q",
+			a, ProjectAccessNone, ProjectAccessRead, ProjectAccessWrite)
+	}
+}
+
+// ExtraSubcommand is a subcommand that a plugin adds to the CLI.
+type ExtraSubcommand struct {
+	// Command is the space separated path of the subcommand below the root command,
+	// e.g. "create policy" or "alpha audit". Missing parent commands are created.
+	Command string
+	// Short is the short description shown in the help of the parent command.
+	Short string
+	// ProjectAccess is the access to the project configuration that the subcommand needs.
+	// Subcommands that require a resource need at least ProjectAccessRead.
+	ProjectAccess ProjectAccess
+	// Subcommand implements the subcommand. It supports the same optional interfaces
+	// as the other subcommands, e.g. HasFlags, RequiresConfig or RequiresResource.
+	Subcommand Subcommand
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/pflag"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/common/kustomize/v2/scaffolds"
+)
+
+var (
+	_ plugin.Subcommand       = &addVersionSubcommand{}
+	_ plugin.RequiresConfig   = &addVersionSubcommand{}
+	_ plugin.RequiresResource = &addVersionSubcommand{}
+)
+
+type addVersionSubcommand struct {
+	config   config.Config
+	resource *resource.Resource
+
+	// from is the existing version of the kind the new version is added to
+	from string
+}
+
+func (p *addVersionSubcommand) UpdateMetadata(cliMeta plugin.CLIMetadata, subcmdMeta *plugin.SubcommandMetadata) {
+	subcmdMeta.Description = `Scaffold the kustomize manifests of a new API version of an existing kind.
+
+A sample of the new version is added to config/samples, and the conversion webhook of the CRD is enabled
+in config/crd and config/default. The job migrating the stored objects to the new version is added to
+config/migration.
+`
+	subcmdMeta.Examples = fmt.Sprintf(`  # Scaffold the manifests of Group: ship, Kind: Frigate, added to v1 from v1beta1
+  %[1]s alpha add-version --group ship --version v1 --kind Frigate --from v1beta1
+`, cliMeta.CommandName)
+}
+
+func (p *addVersionSubcommand) BindFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&p.from, "from", "", "existing version of the kind whose types are copied to the new version")
+}
+
+func (p *addVersionSubcommand) InjectConfig(c config.Config) error {
+	p.config = c
+	return nil
+}
+
+func (p *addVersionSubcommand) InjectResource(res *resource.Resource) error {
+	if p.from == "" {
+		return errors.New("--from is required, set it to the existing version whose types are copied")
+	}
+
+	p.resource = res
+	return nil
+}
+
+func (p *addVersionSubcommand) Scaffold(fs machinery.Filesystem) error {
+	// The resource is completed by the language plugin when it is part of the chain,
+	// otherwise the new version is recorded here with the API of the existing one.
+	if !p.resource.HasAPI() {
+		source := p.resource.GVK
+		source.Version = p.from
+		existing, err := p.config.GetResource(source)
+		if err != nil || !existing.HasAPI() {
+			return fmt.Errorf("no API found for group %q, version %q and kind %q",
+				source.Group, source.Version, source.Kind)
+		}
+		api := existing.API.Copy()
+		api.StorageVersion = true
+		p.resource.Plural = existing.Plural
+		p.resource.API = &api
+		p.resource.Webhooks = &resource.Webhooks{WebhookVersion: "v1", Conversion: true}
+	}
+
+	apiScaffolder := scaffolds.NewAPIScaffolder(p.config, *p.resource, false)
+	apiScaffolder.InjectFS(fs)
+	if err := apiScaffolder.Scaffold(); err != nil {
+		return fmt.Errorf("failed to scaffold the manifests of the new version: %w", err)
+	}
+
+	// The conversion webhook of the CRD is already configured when it moves from another version
+	if p.hasConversionWebhook() {
+		return nil
+	}
+
+	webhookScaffolder := scaffolds.NewWebhookScaffolder(p.config, *p.resource, false)
+	webhookScaffolder.InjectFS(fs)
+	if err := webhookScaffolder.Scaffold(); err != nil {
+		return fmt.Errorf("failed to scaffold the manifests of the conversion webhook: %w", err)
+	}
+
+	return nil
+}
+
+// hasConversionWebhook returns true if another version of the kind has a conversion webhook.
+func (p *addVersionSubcommand) hasConversionWebhook() bool {
+	resources, err := p.config.GetResources()
+	if err != nil {
+		return false
+	}
+	for _, res := range resources {
+		if res.Group == p.resource.Group && res.Kind == p.resource.Kind && res.Version != p.resource.Version &&
+			res.HasConversionWebhook() {
+			return true
+		}
+	}
+	return false
+}
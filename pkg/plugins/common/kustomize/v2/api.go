@@ -19,22 +19,53 @@ package v2
 import (
 	"fmt"
 
+	"github.com/spf13/pflag"
+
 	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/common/kustomize/v2/scaffolds"
+	goPlugin "sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang"
 )
 
 var _ plugin.CreateAPISubcommand = &createAPISubcommand{}
 
 type createAPISubcommand struct {
 	createSubcommand
+
+	// fromCRD is the path of the CRD manifest that the API is created from
+	fromCRD string
+}
+
+func (p *createAPISubcommand) BindFlags(fs *pflag.FlagSet) {
+	p.createSubcommand.BindFlags(fs)
+
+	fs.StringVar(&p.fromCRD, "from-crd", "",
+		"path of a CRD manifest to create the API from, scaffolding the manifests of all its served versions")
 }
 
 func (p *createAPISubcommand) Scaffold(fs machinery.Filesystem) error {
-	scaffolder := scaffolds.NewAPIScaffolder(p.config, *p.resource, p.force)
-	scaffolder.InjectFS(fs)
-	if err := scaffolder.Scaffold(); err != nil {
-		return fmt.Errorf("failed to scaffold api subcommand: %w", err)
+	resources := []resource.Resource{*p.resource}
+
+	// The other served versions of the CRD are created with the API
+	if p.fromCRD != "" {
+		crd, err := goPlugin.LoadCRD(p.fromCRD)
+		if err != nil {
+			return fmt.Errorf("invalid value for '--from-crd': %w", err)
+		}
+		for _, res := range crd.Resources(p.config) {
+			if res.Version != p.resource.Version {
+				resources = append(resources, res)
+			}
+		}
+	}
+
+	for _, res := range resources {
+		scaffolder := scaffolds.NewAPIScaffolder(p.config, res, p.force)
+		scaffolder.InjectFS(fs)
+		if err := scaffolder.Scaffold(); err != nil {
+			return fmt.Errorf("failed to scaffold api subcommand: %w", err)
+		}
 	}
 
 	return nil
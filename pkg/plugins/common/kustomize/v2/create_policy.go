@@ -0,0 +1,99 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	"fmt"
+
+	"github.com/spf13/pflag"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/common/kustomize/v2/scaffolds"
+)
+
+var (
+	_ plugin.Subcommand       = &createPolicySubcommand{}
+	_ plugin.RequiresConfig   = &createPolicySubcommand{}
+	_ plugin.RequiresResource = &createPolicySubcommand{}
+)
+
+type createPolicySubcommand struct {
+	config   config.Config
+	resource *resource.Resource
+
+	// policyType is the type of the admission policy to scaffold
+	policyType string
+
+	// force indicates that the policy manifests should be scaffolded even if they exist
+	force bool
+}
+
+func (p *createPolicySubcommand) UpdateMetadata(cliMeta plugin.CLIMetadata, subcmdMeta *plugin.SubcommandMetadata) {
+	subcmdMeta.Description = `Scaffold the kustomize manifests of an admission policy for a resource.
+
+The policy and its binding are scaffolded under config/policies, which is added to the resources
+of config/default/kustomization.yaml.
+`
+	subcmdMeta.Examples = fmt.Sprintf(`  # Create the manifests of a ValidatingAdmissionPolicy for the Frigate API
+  %[1]s create policy --group ship --version v1beta1 --kind Frigate --type validating
+`, cliMeta.CommandName)
+}
+
+func (p *createPolicySubcommand) BindFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&p.policyType, "type", "",
+		fmt.Sprintf("type of the admission policy, one of %q or %q", resource.ValidatingPolicy, resource.MutatingPolicy))
+	fs.BoolVar(&p.force, "force", false, "attempt to create policy manifests even if they already exist")
+}
+
+func (p *createPolicySubcommand) InjectConfig(c config.Config) error {
+	p.config = c
+	return nil
+}
+
+func (p *createPolicySubcommand) InjectResource(res *resource.Resource) error {
+	if err := resource.PolicyType(p.policyType).Validate(); err != nil {
+		return fmt.Errorf("invalid --type: %w", err)
+	}
+
+	p.resource = res
+	return nil
+}
+
+func (p *createPolicySubcommand) Scaffold(fs machinery.Filesystem) error {
+	policyType := resource.PolicyType(p.policyType)
+
+	// The resource is completed by the language plugin when it is part of the chain,
+	// otherwise the policy is recorded here.
+	if !p.resource.HasPolicy(policyType) {
+		if p.resource.Policies == nil {
+			p.resource.Policies = &resource.Policies{}
+		}
+		if err := p.resource.Policies.Set(policyType); err != nil {
+			return fmt.Errorf("error setting policy: %w", err)
+		}
+	}
+
+	scaffolder := scaffolds.NewPolicyScaffolder(p.config, *p.resource, policyType, p.force)
+	scaffolder.InjectFS(fs)
+	if err := scaffolder.Scaffold(); err != nil {
+		return fmt.Errorf("failed to scaffold create policy subcommand: %w", err)
+	}
+	return nil
+}
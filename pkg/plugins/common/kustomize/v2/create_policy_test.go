@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	"github.com/spf13/pflag"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	cfgv3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+)
+
+var _ = Describe("createPolicySubcommand", func() {
+	const defaultKustomization = `resources:
+- ../crd
+- ../rbac
+- ../manager
+`
+
+	var (
+		subCmd *createPolicySubcommand
+		cfg    config.Config
+		res    *resource.Resource
+		fs     machinery.Filesystem
+	)
+
+	BeforeEach(func() {
+		subCmd = &createPolicySubcommand{}
+		cfg = cfgv3.New()
+		Expect(cfg.SetProjectName("test")).To(Succeed())
+		res = &resource.Resource{
+			GVK:    resource.GVK{Group: "crew", Domain: "test.io", Version: "v1", Kind: "Captain"},
+			Plural: "captains",
+		}
+
+		fs = machinery.Filesystem{FS: afero.NewMemMapFs()}
+		Expect(afero.WriteFile(fs.FS, "config/default/kustomization.yaml",
+			[]byte(defaultKustomization), 0o644)).To(Succeed())
+	})
+
+	scaffold := func(args ...string) error {
+		flags := pflag.NewFlagSet("create-policy", pflag.ContinueOnError)
+		subCmd.BindFlags(flags)
+		Expect(flags.Parse(args)).To(Succeed())
+		Expect(subCmd.InjectConfig(cfg)).To(Succeed())
+		if err := subCmd.InjectResource(res); err != nil {
+			return err
+		}
+		return subCmd.Scaffold(fs)
+	}
+
+	It("should fail for an unsupported type", func() {
+		Expect(scaffold("--type", "audit")).NotTo(Succeed())
+	})
+
+	It("should scaffold the policy, its binding and deploy them", func() {
+		Expect(scaffold("--type", "validating")).To(Succeed())
+
+		policy, err := afero.ReadFile(fs.FS, "config/policies/crew_v1_captain_validating_policy.yaml")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(policy)).To(ContainSubstring("kind: ValidatingAdmissionPolicy\n"))
+		Expect(string(policy)).To(ContainSubstring("name: captain-v1-validating-policy\n"))
+		Expect(string(policy)).To(ContainSubstring(`- apiGroups: ["crew.test.io"]`))
+
+		binding, err := afero.ReadFile(fs.FS, "config/policies/crew_v1_captain_validating_binding.yaml")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(binding)).To(ContainSubstring("policyName: captain-v1-validating-policy\n"))
+
+		kustomization, err := afero.ReadFile(fs.FS, "config/policies/kustomization.yaml")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(kustomization)).To(ContainSubstring("- crew_v1_captain_validating_policy.yaml\n" +
+			"- crew_v1_captain_validating_binding.yaml\n"))
+
+		defaultContent, err := afero.ReadFile(fs.FS, "config/default/kustomization.yaml")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(defaultContent)).To(HaveSuffix("- ../manager\n" +
+			"# [POLICIES] Admission policies scaffolded with 'create policy', evaluated by the API server.\n" +
+			"- ../policies\n"))
+
+		stored, err := cfg.GetResource(res.GVK)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stored.HasPolicy(resource.ValidatingPolicy)).To(BeTrue())
+	})
+
+	It("should match the core group of core types", func() {
+		res = &resource.Resource{
+			GVK:    resource.GVK{Group: "core", Version: "v1", Kind: "Pod"},
+			Plural: "pods",
+			Core:   true,
+		}
+		Expect(scaffold("--type", "mutating")).To(Succeed())
+
+		policy, err := afero.ReadFile(fs.FS, "config/policies/core_v1_pod_mutating_policy.yaml")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(policy)).To(ContainSubstring("kind: MutatingAdmissionPolicy\n"))
+		Expect(string(policy)).To(ContainSubstring(`- apiGroups: [""]`))
+	})
+})
@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	"fmt"
+
+	"github.com/spf13/pflag"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/common/kustomize/v2/scaffolds"
+)
+
+var _ plugin.EditAPISubcommand = &editAPISubcommand{}
+
+type editAPISubcommand struct {
+	config   config.Config
+	resource *resource.Resource
+
+	// storageVersion indicates whether to scaffold the migration to the storage version
+	storageVersion bool
+}
+
+func (p *editAPISubcommand) BindFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&p.storageVersion, "storage-version", false,
+		"make the version the storage version of the CRD, and scaffold the migration of the stored objects to it")
+}
+
+func (p *editAPISubcommand) InjectConfig(c config.Config) error {
+	p.config = c
+	return nil
+}
+
+func (p *editAPISubcommand) InjectResource(res *resource.Resource) error {
+	p.resource = res
+	return nil
+}
+
+func (p *editAPISubcommand) Scaffold(fs machinery.Filesystem) error {
+	// The resource is edited by the language plugin, the project configuration still holds the previous one.
+	previous, err := p.config.GetResource(p.resource.GVK)
+	if err != nil {
+		return fmt.Errorf("error getting resource %q: %w", p.resource.Kind, err)
+	}
+
+	// Only the webhooks that are added require kustomize manifests,
+	// the ones of the removed webhooks are left for the user to clean up.
+	if addsWebhook(previous, *p.resource) {
+		scaffolder := scaffolds.NewWebhookScaffolder(p.config, *p.resource, false)
+		scaffolder.InjectFS(fs)
+		if err = scaffolder.Scaffold(); err != nil {
+			return fmt.Errorf("failed to scaffold edit api subcommand: %w", err)
+		}
+	}
+
+	// The selectors of the webhooks can only be set with kustomize patches, which follow their edition.
+	scaffolder := scaffolds.NewWebhookSelectorsScaffolder(p.config, previous, *p.resource)
+	scaffolder.InjectFS(fs)
+	if err = scaffolder.Scaffold(); err != nil {
+		return fmt.Errorf("failed to scaffold webhook selectors patches: %w", err)
+	}
+
+	if p.storageVersion {
+		migrationScaffolder := scaffolds.NewMigrationScaffolder(p.config, *p.resource)
+		migrationScaffolder.InjectFS(fs)
+		if err = migrationScaffolder.Scaffold(); err != nil {
+			return fmt.Errorf("failed to scaffold the storage version migration: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// addsWebhook returns true if the edited resource has a webhook type that the previous one did not have.
+func addsWebhook(previous, edited resource.Resource) bool {
+	return (edited.HasDefaultingWebhook() && !previous.HasDefaultingWebhook()) ||
+		(edited.HasValidationWebhook() && !previous.HasValidationWebhook()) ||
+		(edited.HasConversionWebhook() && !previous.HasConversionWebhook())
+}
@@ -38,6 +38,9 @@ var (
 	_ plugin.Init          = Plugin{}
 	_ plugin.CreateAPI     = Plugin{}
 	_ plugin.CreateWebhook = Plugin{}
+	_ plugin.EditAPI       = Plugin{}
+
+	_ plugin.HasExtraSubcommands = Plugin{}
 )
 
 // Plugin implements the plugin.Full interface
@@ -45,6 +48,9 @@ type Plugin struct {
 	initSubcommand
 	createAPISubcommand
 	createWebhookSubcommand
+	editAPISubcommand
+	createPolicySubcommand
+	addVersionSubcommand
 }
 
 // Name returns the name of the plugin
@@ -67,6 +73,28 @@ func (p Plugin) GetCreateWebhookSubcommand() plugin.CreateWebhookSubcommand {
 	return &p.createWebhookSubcommand
 }
 
+// GetEditAPISubcommand will return the subcommand which is responsible for scaffolding the kustomize
+// manifests of the webhooks added to an existing API
+func (p Plugin) GetEditAPISubcommand() plugin.EditAPISubcommand { return &p.editAPISubcommand }
+
+// GetExtraSubcommands returns the subcommands that the plugin adds to the CLI
+func (p Plugin) GetExtraSubcommands() []plugin.ExtraSubcommand {
+	return []plugin.ExtraSubcommand{
+		{
+			Command:       "create policy",
+			Short:         "Scaffold a ValidatingAdmissionPolicy or MutatingAdmissionPolicy for a resource",
+			ProjectAccess: plugin.ProjectAccessWrite,
+			Subcommand:    &p.createPolicySubcommand,
+		},
+		{
+			Command:       "alpha add-version",
+			Short:         "Add a new API version to an existing kind, converted from its other versions",
+			ProjectAccess: plugin.ProjectAccessWrite,
+			Subcommand:    &p.addVersionSubcommand,
+		},
+	}
+}
+
 // Description returns a short description of the plugin
 func (Plugin) Description() string {
 	return "Scaffolds base Kustomize configuration"
@@ -128,6 +128,21 @@ func (s *apiScaffolder) Scaffold() error {
 			log.Error("failed to append empty line at the end of the file",
 				"file_path", rbacKustomizeFilePath)
 		}
+
+		// The objects stored in the previous storage version of the kind need to be migrated
+		if s.resource.API.StorageVersion {
+			migrate, err := hasOtherVersions(s.config, s.resource)
+			if err != nil {
+				return err
+			}
+			if migrate {
+				scaffolder := NewMigrationScaffolder(s.config, s.resource)
+				scaffolder.InjectFS(s.fs)
+				if err = scaffolder.Scaffold(); err != nil {
+					return fmt.Errorf("error scaffolding the storage version migration: %w", err)
+				}
+			}
+		}
 	}
 
 	return nil
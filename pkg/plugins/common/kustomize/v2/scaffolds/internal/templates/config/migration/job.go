@@ -0,0 +1,199 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migration
+
+import (
+	"path/filepath"
+	"strings"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+)
+
+var _ machinery.Template = &Job{}
+
+// KubectlImage is the image running kubectl in the migration jobs
+const KubectlImage = "registry.k8s.io/kubectl:v1.35.0"
+
+// ManifestName returns the name of the file, without extension, holding the migration job of res.
+func ManifestName(res resource.Resource, multiGroup bool) string {
+	if multiGroup && res.Group != "" {
+		return strings.ToLower(res.Group) + "_" + strings.ToLower(res.Kind) + "_migration"
+	}
+	return strings.ToLower(res.Kind) + "_migration"
+}
+
+// Job scaffolds a file that defines the job migrating the stored objects of a kind to the
+// storage version of its CRD, with the role allowing it to rewrite them.
+type Job struct {
+	machinery.TemplateMixin
+	machinery.MultiGroupMixin
+	machinery.ResourceMixin
+	machinery.ProjectNameMixin
+
+	// Name is the name of the job, its role and role binding
+	Name string
+	// Image is the image running kubectl
+	Image string
+}
+
+// SetTemplateDefaults implements machinery.Template
+func (f *Job) SetTemplateDefaults() error {
+	if f.Path == "" {
+		f.Path = filepath.Join("config", "migration", ManifestName(*f.Resource, f.MultiGroup)+".yaml")
+	}
+
+	if f.Name == "" {
+		f.Name = strings.ReplaceAll(ManifestName(*f.Resource, f.MultiGroup), "_", "-")
+	}
+	if f.Image == "" {
+		f.Image = KubectlImage
+	}
+
+	f.TemplateBody = jobTemplate
+
+	// The storage version of the CRD is set when the job is scaffolded, so it follows its changes
+	f.IfExistsAction = machinery.OverwriteFile
+
+	return nil
+}
+
+const jobTemplate = `# Migrates the stored {{ .Resource.Kind }} objects to the storage version
+# {{ .Resource.Version }} of the CRD. Every object is rewritten, which stores it in the
+# storage version, and the previous versions are then removed from the stored
+# versions in the status of the CRD.
+# TODO(user): Keep the version of the kubectl image in line with the version of your cluster.
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  labels:
+    app.kubernetes.io/name: {{ .ProjectName }}
+    app.kubernetes.io/managed-by: kustomize
+  name: {{ .Name }}
+rules:
+- apiGroups:
+  - {{ .Resource.QualifiedGroup }}
+  resources:
+  - {{ .Resource.Plural }}
+  verbs:
+  - get
+  - list
+  - patch
+- apiGroups:
+  - apiextensions.k8s.io
+  resources:
+  - customresourcedefinitions
+  - customresourcedefinitions/status
+  resourceNames:
+  - {{ .Resource.Plural }}.{{ .Resource.QualifiedGroup }}
+  verbs:
+  - get
+  - patch
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRoleBinding
+metadata:
+  labels:
+    app.kubernetes.io/name: {{ .ProjectName }}
+    app.kubernetes.io/managed-by: kustomize
+  name: {{ .Name }}
+roleRef:
+  apiGroup: rbac.authorization.k8s.io
+  kind: ClusterRole
+  name: {{ .Name }}
+subjects:
+- kind: ServiceAccount
+  name: storage-version-migrator
+  namespace: system
+---
+apiVersion: batch/v1
+kind: Job
+metadata:
+  labels:
+    app.kubernetes.io/name: {{ .ProjectName }}
+    app.kubernetes.io/component: storage-version-migration
+    app.kubernetes.io/managed-by: kustomize
+  name: {{ .Name }}
+  namespace: system
+spec:
+  backoffLimit: 3
+  template:
+    metadata:
+      labels:
+        app.kubernetes.io/name: {{ .ProjectName }}
+        app.kubernetes.io/component: storage-version-migration
+    spec:
+      restartPolicy: Never
+      serviceAccountName: storage-version-migrator
+      securityContext:
+        runAsNonRoot: true
+        runAsUser: 65532
+        seccompProfile:
+          type: RuntimeDefault
+      initContainers:
+      # The annotation changes on every run, so that each object is written again
+      - name: rewrite
+        image: {{ .Image }}
+        args:
+        - annotate
+        - {{ .Resource.Plural }}.{{ .Resource.QualifiedGroup }}
+        - --all
+        - --all-namespaces
+        - --overwrite
+        - migration.kubebuilder.io/rewritten-by=$(POD_UID)
+        env:
+        - name: POD_UID
+          valueFrom:
+            fieldRef:
+              fieldPath: metadata.uid
+        - name: HOME
+          value: /tmp
+        securityContext:
+          readOnlyRootFilesystem: true
+          allowPrivilegeEscalation: false
+          capabilities:
+            drop:
+            - "ALL"
+        volumeMounts:
+        - name: tmp
+          mountPath: /tmp
+      containers:
+      - name: update-stored-versions
+        image: {{ .Image }}
+        args:
+        - patch
+        - customresourcedefinitions.apiextensions.k8s.io
+        - {{ .Resource.Plural }}.{{ .Resource.QualifiedGroup }}
+        - --subresource=status
+        - --type=merge
+        - '--patch={"status":{"storedVersions":["{{ .Resource.Version }}"]}}'
+        env:
+        - name: HOME
+          value: /tmp
+        securityContext:
+          readOnlyRootFilesystem: true
+          allowPrivilegeEscalation: false
+          capabilities:
+            drop:
+            - "ALL"
+        volumeMounts:
+        - name: tmp
+          mountPath: /tmp
+      volumes:
+      - name: tmp
+        emptyDir: {}
+`
@@ -0,0 +1,78 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migration
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+)
+
+var (
+	_ machinery.Template = &Kustomization{}
+	_ machinery.Inserter = &Kustomization{}
+)
+
+// Kustomization scaffolds a file that defines the kustomization scheme for the migration folder
+type Kustomization struct {
+	machinery.TemplateMixin
+	machinery.MultiGroupMixin
+	machinery.ResourceMixin
+	machinery.ProjectNameMixin
+}
+
+// SetTemplateDefaults implements machinery.Template
+func (f *Kustomization) SetTemplateDefaults() error {
+	if f.Path == "" {
+		f.Path = filepath.Join("config", "migration", "kustomization.yaml")
+	}
+
+	f.TemplateBody = fmt.Sprintf(kustomizationTemplate, machinery.NewMarkerFor(f.Path, migrationMarker))
+
+	return nil
+}
+
+const migrationMarker = "migrationkustomizeresource"
+
+// GetMarkers implements file.Inserter
+func (f *Kustomization) GetMarkers() []machinery.Marker {
+	return []machinery.Marker{machinery.NewMarkerFor(f.Path, migrationMarker)}
+}
+
+const migrationCodeFragment = `- %s.yaml
+`
+
+// GetCodeFragments implements file.Inserter
+func (f *Kustomization) GetCodeFragments() machinery.CodeFragmentsMap {
+	return machinery.CodeFragmentsMap{
+		machinery.NewMarkerFor(f.Path, migrationMarker): []string{
+			fmt.Sprintf(migrationCodeFragment, ManifestName(*f.Resource, f.MultiGroup)),
+		},
+	}
+}
+
+const kustomizationTemplate = `# The jobs of this folder migrate the objects stored in the cluster to the
+# storage version of their CRD. They are not deployed by config/default: once
+# the new storage version is deployed, run them with 'make migrate-storage'.
+namespace: {{ .ProjectName }}-system
+namePrefix: {{ .ProjectName }}-
+
+resources:
+- service_account.yaml
+%s
+`
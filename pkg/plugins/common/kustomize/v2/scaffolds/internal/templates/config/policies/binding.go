@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policies
+
+import (
+	"path/filepath"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+)
+
+var _ machinery.Template = &Binding{}
+
+// Binding scaffolds a file that binds the admission policy of a resource
+type Binding struct {
+	machinery.TemplateMixin
+	machinery.MultiGroupMixin
+	machinery.ResourceMixin
+	machinery.ProjectNameMixin
+
+	// Type is the type of the bound policy
+	Type resource.PolicyType
+
+	// PolicyName is the name of the bound policy object
+	PolicyName string
+
+	Force bool
+}
+
+// SetTemplateDefaults implements machinery.Template
+func (f *Binding) SetTemplateDefaults() error {
+	if f.Path == "" {
+		f.Path = filepath.Join("config", "policies", ManifestName(*f.Resource, f.Type)+"_binding.yaml")
+	}
+
+	if f.PolicyName == "" {
+		f.PolicyName = PolicyName(*f.Resource, f.MultiGroup, f.Type)
+	}
+
+	switch f.Type {
+	case resource.MutatingPolicy:
+		f.TemplateBody = mutatingBindingTemplate
+	default:
+		f.TemplateBody = validatingBindingTemplate
+	}
+
+	if f.Force {
+		f.IfExistsAction = machinery.OverwriteFile
+	} else {
+		f.IfExistsAction = machinery.Error
+	}
+
+	return nil
+}
+
+const validatingBindingTemplate = `# The following binding enforces the ValidatingAdmissionPolicy {{ .PolicyName }}.
+# TODO(user): Use spec.matchResources to only enforce the policy in some namespaces.
+apiVersion: admissionregistration.k8s.io/v1
+kind: ValidatingAdmissionPolicyBinding
+metadata:
+  labels:
+    app.kubernetes.io/name: {{ .ProjectName }}
+    app.kubernetes.io/managed-by: kustomize
+  name: {{ .PolicyName }}-binding
+spec:
+  policyName: {{ .PolicyName }}
+  validationActions: [Deny]
+`
+
+const mutatingBindingTemplate = `# The following binding enforces the MutatingAdmissionPolicy {{ .PolicyName }}.
+# TODO(user): Use spec.matchResources to only enforce the policy in some namespaces.
+apiVersion: admissionregistration.k8s.io/v1beta1
+kind: MutatingAdmissionPolicyBinding
+metadata:
+  labels:
+    app.kubernetes.io/name: {{ .ProjectName }}
+    app.kubernetes.io/managed-by: kustomize
+  name: {{ .PolicyName }}-binding
+spec:
+  policyName: {{ .PolicyName }}
+`
@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policies
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+)
+
+var (
+	_ machinery.Template = &Kustomization{}
+	_ machinery.Inserter = &Kustomization{}
+)
+
+// Kustomization scaffolds a file that defines the kustomization scheme for the policies folder
+type Kustomization struct {
+	machinery.TemplateMixin
+	machinery.ResourceMixin
+
+	// Type is the type of the policy whose manifests are added
+	Type resource.PolicyType
+}
+
+// SetTemplateDefaults implements machinery.Template
+func (f *Kustomization) SetTemplateDefaults() error {
+	if f.Path == "" {
+		f.Path = filepath.Join("config", "policies", "kustomization.yaml")
+	}
+
+	f.TemplateBody = fmt.Sprintf(kustomizationTemplate, machinery.NewMarkerFor(f.Path, policiesMarker))
+
+	return nil
+}
+
+const policiesMarker = "policieskustomizeresource"
+
+// GetMarkers implements file.Inserter
+func (f *Kustomization) GetMarkers() []machinery.Marker {
+	return []machinery.Marker{machinery.NewMarkerFor(f.Path, policiesMarker)}
+}
+
+const policiesCodeFragment = `- %s_policy.yaml
+- %s_binding.yaml
+`
+
+// GetCodeFragments implements file.Inserter
+func (f *Kustomization) GetCodeFragments() machinery.CodeFragmentsMap {
+	name := ManifestName(*f.Resource, f.Type)
+	return machinery.CodeFragmentsMap{
+		machinery.NewMarkerFor(f.Path, policiesMarker): []string{fmt.Sprintf(policiesCodeFragment, name, name)},
+	}
+}
+
+const kustomizationTemplate = `# This kustomization.yaml is not intended to be run by itself,
+# since the names of the policies are prefixed by config/default.
+# It should be run by config/default
+resources:
+%s
+
+configurations:
+- kustomizeconfig.yaml
+`
@@ -0,0 +1,60 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policies
+
+import (
+	"path/filepath"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+)
+
+var _ machinery.Template = &KustomizeConfig{}
+
+// KustomizeConfig scaffolds a file that configures the kustomization for the policies folder
+type KustomizeConfig struct {
+	machinery.TemplateMixin
+}
+
+// SetTemplateDefaults implements machinery.Template
+func (f *KustomizeConfig) SetTemplateDefaults() error {
+	if f.Path == "" {
+		f.Path = filepath.Join("config", "policies", "kustomizeconfig.yaml")
+	}
+
+	f.TemplateBody = kustomizeConfigTemplate
+
+	// If file exists (ex. because a policy was already created), skip creation.
+	f.IfExistsAction = machinery.SkipFile
+
+	return nil
+}
+
+const kustomizeConfigTemplate = `# This configuration is for teaching kustomize how to update name ref substitution
+nameReference:
+- kind: ValidatingAdmissionPolicy
+  group: admissionregistration.k8s.io
+  fieldSpecs:
+  - kind: ValidatingAdmissionPolicyBinding
+    group: admissionregistration.k8s.io
+    path: spec/policyName
+- kind: MutatingAdmissionPolicy
+  group: admissionregistration.k8s.io
+  fieldSpecs:
+  - kind: MutatingAdmissionPolicyBinding
+    group: admissionregistration.k8s.io
+    path: spec/policyName
+`
@@ -0,0 +1,157 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policies
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+)
+
+var _ machinery.Template = &Policy{}
+
+// Policy scaffolds a file that defines the admission policy of a resource
+type Policy struct {
+	machinery.TemplateMixin
+	machinery.MultiGroupMixin
+	machinery.ResourceMixin
+	machinery.ProjectNameMixin
+
+	// Type is the type of the policy
+	Type resource.PolicyType
+
+	// PolicyName is the name of the policy object
+	PolicyName string
+
+	// APIGroup is the API group of the resource as matched by the policy
+	APIGroup string
+
+	Force bool
+}
+
+// SetTemplateDefaults implements machinery.Template
+func (f *Policy) SetTemplateDefaults() error {
+	if f.Path == "" {
+		f.Path = filepath.Join("config", "policies", ManifestName(*f.Resource, f.Type)+"_policy.yaml")
+	}
+
+	if f.PolicyName == "" {
+		f.PolicyName = PolicyName(*f.Resource, f.MultiGroup, f.Type)
+	}
+
+	// Core types of the legacy core group are served by the "" API group.
+	f.APIGroup = f.Resource.QualifiedGroup()
+	if f.Resource.Core && f.APIGroup == "core" {
+		f.APIGroup = ""
+	}
+
+	switch f.Type {
+	case resource.MutatingPolicy:
+		f.TemplateBody = mutatingPolicyTemplate
+	default:
+		f.TemplateBody = validatingPolicyTemplate
+	}
+
+	if f.Force {
+		f.IfExistsAction = machinery.OverwriteFile
+	} else {
+		f.IfExistsAction = machinery.Error
+	}
+
+	return nil
+}
+
+// ManifestName returns the base name of the manifests of the policy of the provided type,
+// following the naming of the samples, e.g. crew_v1_captain_validating.
+func ManifestName(res resource.Resource, policyType resource.PolicyType) string {
+	if res.Group != "" {
+		return res.Replacer().Replace(fmt.Sprintf("%%[group]_%%[version]_%%[kind]_%s", policyType))
+	}
+	return res.Replacer().Replace(fmt.Sprintf("%%[version]_%%[kind]_%s", policyType))
+}
+
+// PolicyName returns the name of the policy object of the provided type, e.g. captain-v1-validating-policy.
+func PolicyName(res resource.Resource, multiGroup bool, policyType resource.PolicyType) string {
+	name := fmt.Sprintf("%s-%s-%s-policy", strings.ToLower(res.Kind), res.Version, policyType)
+	if multiGroup && res.Group != "" {
+		name = strings.ToLower(res.Group) + "-" + name
+	}
+	return name
+}
+
+//nolint:lll
+const validatingPolicyTemplate = `# The following ValidatingAdmissionPolicy validates the {{ .Resource.Kind }} objects with CEL rules
+# evaluated by the API server, without calling a webhook.
+# More info: https://kubernetes.io/docs/reference/access-authn-authz/validating-admission-policy/
+apiVersion: admissionregistration.k8s.io/v1
+kind: ValidatingAdmissionPolicy
+metadata:
+  labels:
+    app.kubernetes.io/name: {{ .ProjectName }}
+    app.kubernetes.io/managed-by: kustomize
+  name: {{ .PolicyName }}
+spec:
+  failurePolicy: Fail
+  matchConstraints:
+    resourceRules:
+    - apiGroups: ["{{ .APIGroup }}"]
+      apiVersions: ["{{ .Resource.Version }}"]
+      operations: ["CREATE", "UPDATE"]
+      resources: ["{{ .Resource.Plural }}"]
+  # TODO(user): Replace the following example rule with the validations of your API.
+  # Requests are rejected with the message of the first validation that evaluates to false.
+  validations:
+  - expression: "!has(object.metadata.labels) || !('example.kubebuilder.io/reject' in object.metadata.labels)"
+    message: "{{ .Resource.Kind }} objects labeled with example.kubebuilder.io/reject are not allowed"
+`
+
+//nolint:lll
+const mutatingPolicyTemplate = `# The following MutatingAdmissionPolicy mutates the {{ .Resource.Kind }} objects with CEL expressions
+# evaluated by the API server, without calling a webhook.
+# MutatingAdmissionPolicies are beta, they require the MutatingAdmissionPolicy feature gate
+# and the admissionregistration.k8s.io/v1beta1 API to be enabled in the cluster.
+# More info: https://kubernetes.io/docs/reference/access-authn-authz/mutating-admission-policy/
+apiVersion: admissionregistration.k8s.io/v1beta1
+kind: MutatingAdmissionPolicy
+metadata:
+  labels:
+    app.kubernetes.io/name: {{ .ProjectName }}
+    app.kubernetes.io/managed-by: kustomize
+  name: {{ .PolicyName }}
+spec:
+  failurePolicy: Fail
+  reinvocationPolicy: Never
+  matchConstraints:
+    resourceRules:
+    - apiGroups: ["{{ .APIGroup }}"]
+      apiVersions: ["{{ .Resource.Version }}"]
+      operations: ["CREATE"]
+      resources: ["{{ .Resource.Plural }}"]
+  # TODO(user): Replace the following example mutation with the mutations of your API.
+  mutations:
+  - patchType: ApplyConfiguration
+    applyConfiguration:
+      expression: >-
+        Object{
+          metadata: Object.metadata{
+            labels: {"example.kubebuilder.io/mutated": "true"}
+          }
+        }
+`
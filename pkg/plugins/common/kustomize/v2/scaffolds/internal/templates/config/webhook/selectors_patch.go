@@ -0,0 +1,85 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"path/filepath"
+	"strings"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+)
+
+var _ machinery.Template = &SelectorsPatch{}
+
+// SelectorsPatch scaffolds a file that defines the patch that sets the namespace and object selectors
+// of a webhook, which cannot be set with the +kubebuilder:webhook marker
+type SelectorsPatch struct {
+	machinery.TemplateMixin
+	machinery.ResourceMixin
+
+	// Webhook is the webhook to set the selectors of
+	Webhook resource.AdmissionWebhook
+
+	// WebhookName is the name of the webhook in its {Mutating,Validating}WebhookConfiguration
+	WebhookName string
+}
+
+// SelectorsPatchPath returns the path of the selectors patch of the webhook named webhookName,
+// relative to the config/webhook directory, e.g. patches/mcaptain-v1_selectors_patch.yaml.
+func SelectorsPatchPath(webhookName string) string {
+	return filepath.Join("patches", strings.TrimSuffix(webhookName, ".kb.io")+"_selectors_patch.yaml")
+}
+
+// SetTemplateDefaults implements machinery.Template
+func (f *SelectorsPatch) SetTemplateDefaults() error {
+	f.WebhookName = f.Webhook.ConfigurationName(*f.Resource)
+
+	if f.Path == "" {
+		f.Path = filepath.Join("config", "webhook", SelectorsPatchPath(f.WebhookName))
+	}
+
+	f.TemplateBody = selectorsPatchTemplate
+
+	// The patch reflects the selectors of the webhook, so it is rewritten when they are edited.
+	f.IfExistsAction = machinery.OverwriteFile
+
+	return nil
+}
+
+const selectorsPatchTemplate = `# The following patch sets the selectors of the {{ .WebhookName }} webhook
+apiVersion: admissionregistration.k8s.io/v1
+kind: {{ if .Webhook.Mutating }}Mutating{{ else }}Validating{{ end }}WebhookConfiguration
+metadata:
+  name: {{ if .Webhook.Mutating }}mutating{{ else }}validating{{ end }}-webhook-configuration
+webhooks:
+- name: {{ .WebhookName }}
+  {{- with .Webhook.Admission.NamespaceSelector }}
+  namespaceSelector:
+    matchLabels:
+    {{- range $key, $value := . }}
+      {{ $key }}: "{{ $value }}"
+    {{- end }}
+  {{- end }}
+  {{- with .Webhook.Admission.ObjectSelector }}
+  objectSelector:
+    matchLabels:
+    {{- range $key, $value := . }}
+      {{ $key }}: "{{ $value }}"
+    {{- end }}
+  {{- end }}
+`
@@ -0,0 +1,221 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/common/kustomize/v2/scaffolds/internal/templates/config/migration"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/common/kustomize/v2/scaffolds/internal/templates/config/policies"
+)
+
+var _ plugins.Scaffolder = &layoutScaffolder{}
+
+type layoutScaffolder struct {
+	config config.Config
+
+	// fs is the filesystem that will be used by the scaffolder
+	fs machinery.Filesystem
+}
+
+// NewLayoutScaffolder returns a new Scaffolder that renames the manifests, and the objects they define,
+// whose names depend on the multigroup layout, e.g. config/rbac/captain_editor_role.yaml which becomes
+// config/rbac/crew_captain_editor_role.yaml. The project configuration must already hold the new layout.
+func NewLayoutScaffolder(cfg config.Config) plugins.Scaffolder {
+	return &layoutScaffolder{config: cfg}
+}
+
+// InjectFS implements plugins.Scaffolder
+func (s *layoutScaffolder) InjectFS(fs machinery.Filesystem) { s.fs = fs }
+
+// manifestRename is a manifest whose name, or the name of the objects it defines, changes with the layout.
+type manifestRename struct {
+	from, to string
+	// kustomization is the path of the kustomization listing the manifest, if its name changes
+	kustomization string
+	// names maps the names of the objects defined in the manifest to their new name
+	names map[string]string
+}
+
+// Scaffold implements plugins.Scaffolder
+func (s *layoutScaffolder) Scaffold() error {
+	resources, err := s.config.GetResources()
+	if err != nil {
+		return fmt.Errorf("error getting resources: %w", err)
+	}
+
+	// The renames are all checked before any manifest is changed. The versions of a kind share some
+	// manifests, which are renamed once.
+	var renames []manifestRename
+	planned := make(map[string]bool)
+	for _, res := range resources {
+		if res.Group == "" {
+			continue
+		}
+		for _, rename := range layoutRenames(res, !s.config.IsMultiGroup(), s.config.IsMultiGroup()) {
+			if planned[rename.from] {
+				continue
+			}
+			planned[rename.from] = true
+			if exists, existsErr := afero.Exists(s.fs.FS, rename.from); existsErr != nil {
+				return fmt.Errorf("error checking %q: %w", rename.from, existsErr)
+			} else if !exists {
+				continue
+			}
+			if rename.from != rename.to {
+				if exists, existsErr := afero.Exists(s.fs.FS, rename.to); existsErr != nil {
+					return fmt.Errorf("error checking %q: %w", rename.to, existsErr)
+				} else if exists {
+					return fmt.Errorf("unable to rename %q, %q already exists", rename.from, rename.to)
+				}
+			}
+			renames = append(renames, rename)
+		}
+	}
+
+	for _, rename := range renames {
+		if err = s.renameManifest(rename); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// layoutRenames returns the manifests of the resource whose names depend on the layout, from their
+// name in a layout to their name in the other one.
+func layoutRenames(res resource.Resource, fromMultiGroup, toMultiGroup bool) []manifestRename {
+	var renames []manifestRename
+
+	rbacPrefix := func(multiGroup bool) string {
+		if multiGroup {
+			return res.Group + "_" + strings.ToLower(res.Kind)
+		}
+		return strings.ToLower(res.Kind)
+	}
+	roleName := func(multiGroup bool, role string) string {
+		if multiGroup {
+			return fmt.Sprintf("%s-%s-%s-role", strings.ToLower(res.Group), strings.ToLower(res.Kind), role)
+		}
+		return fmt.Sprintf("%s-%s-role", strings.ToLower(res.Kind), role)
+	}
+	for _, role := range []string{"admin", "editor", "viewer"} {
+		suffix := "_" + role + "_role.yaml"
+		renames = append(renames, manifestRename{
+			from:          filepath.Join("config", "rbac", rbacPrefix(fromMultiGroup)+suffix),
+			to:            filepath.Join("config", "rbac", rbacPrefix(toMultiGroup)+suffix),
+			kustomization: filepath.Join("config", "rbac", "kustomization.yaml"),
+			names:         map[string]string{roleName(fromMultiGroup, role): roleName(toMultiGroup, role)},
+		})
+	}
+
+	patchSuffix := func(multiGroup bool) string {
+		if multiGroup {
+			return res.Group + "_" + res.Plural
+		}
+		return res.Plural
+	}
+	for _, patch := range []string{"webhook_in", "cainjection_in"} {
+		renames = append(renames, manifestRename{
+			from:          filepath.Join("config", "crd", "patches", patch+"_"+patchSuffix(fromMultiGroup)+".yaml"),
+			to:            filepath.Join("config", "crd", "patches", patch+"_"+patchSuffix(toMultiGroup)+".yaml"),
+			kustomization: filepath.Join("config", "crd", "kustomization.yaml"),
+		})
+	}
+
+	fromMigration, toMigration := migration.ManifestName(res, fromMultiGroup), migration.ManifestName(res, toMultiGroup)
+	renames = append(renames, manifestRename{
+		from:          filepath.Join("config", "migration", fromMigration+".yaml"),
+		to:            filepath.Join("config", "migration", toMigration+".yaml"),
+		kustomization: filepath.Join("config", "migration", "kustomization.yaml"),
+		names: map[string]string{
+			strings.ReplaceAll(fromMigration, "_", "-"): strings.ReplaceAll(toMigration, "_", "-"),
+		},
+	})
+
+	// The manifests of the policies keep their names, only the names of the policies change.
+	for _, policyType := range []resource.PolicyType{resource.ValidatingPolicy, resource.MutatingPolicy} {
+		names := map[string]string{
+			policies.PolicyName(res, fromMultiGroup, policyType): policies.PolicyName(res, toMultiGroup, policyType),
+		}
+		for _, suffix := range []string{"_policy.yaml", "_binding.yaml"} {
+			path := filepath.Join("config", "policies", policies.ManifestName(res, policyType)+suffix)
+			renames = append(renames, manifestRename{from: path, to: path, names: names})
+		}
+	}
+
+	return renames
+}
+
+// renameManifest renames the manifest and the objects it defines, and updates its kustomization.
+func (s *layoutScaffolder) renameManifest(rename manifestRename) error {
+	content, err := afero.ReadFile(s.fs.FS, rename.from)
+	if err != nil {
+		return fmt.Errorf("error reading %q: %w", rename.from, err)
+	}
+
+	edited := string(content)
+	for from, to := range rename.names {
+		edited = regexp.MustCompile(`([\s"':])`+regexp.QuoteMeta(from)+`\b`).ReplaceAllString(edited, "${1}"+to)
+	}
+	if err = afero.WriteFile(s.fs.FS, rename.to, []byte(edited), machinery.DefaultFilePermission); err != nil {
+		return fmt.Errorf("error writing %q: %w", rename.to, err)
+	}
+	if rename.from == rename.to {
+		return nil
+	}
+	if err = s.fs.FS.Remove(rename.from); err != nil {
+		return fmt.Errorf("error removing %q: %w", rename.from, err)
+	}
+
+	// The kustomization lists the manifest relatively to its own directory.
+	dir := filepath.Dir(rename.kustomization)
+	from, err := filepath.Rel(dir, rename.from)
+	if err != nil {
+		return fmt.Errorf("error getting the path of %q in %q: %w", rename.from, rename.kustomization, err)
+	}
+	to, err := filepath.Rel(dir, rename.to)
+	if err != nil {
+		return fmt.Errorf("error getting the path of %q in %q: %w", rename.to, rename.kustomization, err)
+	}
+
+	kustomization, err := afero.ReadFile(s.fs.FS, rename.kustomization)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("error reading %q: %w", rename.kustomization, err)
+	}
+	entry := regexp.MustCompile(`(?m)(\s)` + regexp.QuoteMeta(filepath.ToSlash(from)) + `([ \t]*)$`)
+	edited = entry.ReplaceAllString(string(kustomization), "${1}"+filepath.ToSlash(to)+"${2}")
+	if err = afero.WriteFile(s.fs.FS, rename.kustomization, []byte(edited), machinery.DefaultFilePermission); err != nil {
+		return fmt.Errorf("error writing %q: %w", rename.kustomization, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	"fmt"
+	log "log/slog"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/common/kustomize/v2/scaffolds/internal/templates/config/migration"
+)
+
+var _ plugins.Scaffolder = &migrationScaffolder{}
+
+type migrationScaffolder struct {
+	config config.Config
+	// resource is the storage version of the kind whose stored objects are migrated
+	resource resource.Resource
+
+	// fs is the filesystem that will be used by the scaffolder
+	fs machinery.Filesystem
+}
+
+// NewMigrationScaffolder returns a new Scaffolder for the job migrating the stored objects of a kind
+// to the storage version of its CRD
+func NewMigrationScaffolder(cfg config.Config, res resource.Resource) plugins.Scaffolder {
+	return &migrationScaffolder{
+		config:   cfg,
+		resource: res,
+	}
+}
+
+// InjectFS implements cmdutil.Scaffolder
+func (s *migrationScaffolder) InjectFS(fs machinery.Filesystem) { s.fs = fs }
+
+// Scaffold implements cmdutil.Scaffolder
+func (s *migrationScaffolder) Scaffold() error {
+	log.Info("Writing the storage version migration manifests...", "kind", s.resource.Kind,
+		"version", s.resource.Version)
+
+	scaffold := machinery.NewScaffold(s.fs,
+		machinery.WithConfig(s.config),
+		machinery.WithResource(&s.resource),
+	)
+
+	if err := scaffold.Execute(
+		&migration.ServiceAccount{},
+		&migration.Job{},
+		&migration.Kustomization{},
+	); err != nil {
+		return fmt.Errorf("error scaffolding the storage version migration manifests: %w", err)
+	}
+
+	return nil
+}
+
+// hasOtherVersions returns true if the project has an API for another version of the kind of res,
+// whose stored objects need to be migrated when res becomes the storage version.
+func hasOtherVersions(cfg config.Config, res resource.Resource) (bool, error) {
+	resources, err := cfg.GetResources()
+	if err != nil {
+		return false, fmt.Errorf("error getting resources: %w", err)
+	}
+	for _, r := range resources {
+		if r.Group == res.Group && r.Domain == res.Domain && r.Kind == res.Kind && r.Version != res.Version &&
+			r.HasAPI() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	"errors"
+	"fmt"
+	log "log/slog"
+	"os"
+	"strings"
+
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/common/kustomize/v2/scaffolds/internal/templates/config/policies"
+)
+
+var _ plugins.Scaffolder = &policyScaffolder{}
+
+const (
+	// policiesResource is the entry of config/default/kustomization.yaml that deploys the policies
+	policiesResource = "- ../policies\n"
+	// policiesResourceAnchor is the entry of config/default/kustomization.yaml after which the policies are added
+	policiesResourceAnchor = "- ../manager\n"
+)
+
+type policyScaffolder struct {
+	config     config.Config
+	resource   resource.Resource
+	policyType resource.PolicyType
+
+	// fs is the filesystem that will be used by the scaffolder
+	fs machinery.Filesystem
+
+	// force indicates whether to scaffold the policy files even if they exist.
+	force bool
+}
+
+// NewPolicyScaffolder returns a new Scaffolder for admission policy creation operations
+func NewPolicyScaffolder(cfg config.Config, res resource.Resource, policyType resource.PolicyType,
+	force bool,
+) plugins.Scaffolder {
+	return &policyScaffolder{
+		config:     cfg,
+		resource:   res,
+		policyType: policyType,
+		force:      force,
+	}
+}
+
+// InjectFS implements cmdutil.Scaffolder
+func (s *policyScaffolder) InjectFS(fs machinery.Filesystem) { s.fs = fs }
+
+// Scaffold implements cmdutil.Scaffolder
+func (s *policyScaffolder) Scaffold() error {
+	log.Info("Writing kustomize manifests for you to edit...")
+
+	// Initialize the machinery.Scaffold that will write the files to disk
+	scaffold := machinery.NewScaffold(s.fs,
+		machinery.WithConfig(s.config),
+		machinery.WithResource(&s.resource),
+	)
+
+	if err := s.config.UpdateResource(s.resource); err != nil {
+		return fmt.Errorf("error updating resource: %w", err)
+	}
+
+	if err := scaffold.Execute(
+		&policies.Policy{Type: s.policyType, Force: s.force},
+		&policies.Binding{Type: s.policyType, Force: s.force},
+		&policies.Kustomization{Type: s.policyType},
+		&policies.KustomizeConfig{},
+	); err != nil {
+		return fmt.Errorf("error scaffolding kustomize policy manifests: %w", err)
+	}
+
+	return s.addPoliciesResource()
+}
+
+// addPoliciesResource adds the policies folder to the resources of config/default/kustomization.yaml.
+func (s *policyScaffolder) addPoliciesResource() error {
+	content, err := afero.ReadFile(s.fs.FS, kustomizeFilePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			log.Warn("Unable to find the default kustomization to deploy the policies", "file", kustomizeFilePath)
+			return nil
+		}
+		return fmt.Errorf("error reading %q: %w", kustomizeFilePath, err)
+	}
+
+	// The policies may have been disabled by commenting the entry, which is left as is.
+	if strings.Contains(string(content), policiesResource) {
+		return nil
+	}
+	if !strings.Contains(string(content), policiesResourceAnchor) {
+		log.Warn("Unable to find where to add the policies to the resources of the default kustomization, "+
+			"add '- ../policies' to deploy them", "file", kustomizeFilePath)
+		return nil
+	}
+
+	edited := strings.Replace(string(content), policiesResourceAnchor, policiesResourceAnchor+
+		"# [POLICIES] Admission policies scaffolded with 'create policy', evaluated by the API server.\n"+
+		policiesResource, 1)
+	if err = afero.WriteFile(s.fs.FS, kustomizeFilePath, []byte(edited), machinery.DefaultFilePermission); err != nil {
+		return fmt.Errorf("error writing %q: %w", kustomizeFilePath, err)
+	}
+
+	return nil
+}
@@ -110,6 +110,12 @@ func (s *webhookScaffolder) Scaffold() error {
 		return fmt.Errorf("error scaffolding kustomize webhook manifests: %w", err)
 	}
 
+	selectors := NewWebhookSelectorsScaffolder(s.config, s.resource, s.resource)
+	selectors.InjectFS(s.fs)
+	if err := selectors.Scaffold(); err != nil {
+		return fmt.Errorf("error scaffolding webhook selectors patches: %w", err)
+	}
+
 	// Warn users about potential bootstrap problem for core type webhooks
 	if s.resource.Core {
 		log.Warn("Webhooks for core types may cause circular dependencies during deployment. " +
@@ -126,10 +132,10 @@ func (s *webhookScaffolder) Scaffold() error {
 	// - manager patches
 	// - replacements for certificate injection
 	enableWebhookDefaults()
-	if s.resource.HasValidationWebhook() {
+	if s.resource.HasValidatingWebhook() {
 		uncommentCodeForValidationWebhooks()
 	}
-	if s.resource.HasDefaultingWebhook() {
+	if s.resource.HasMutatingWebhook() {
 		uncommentCodeForDefaultWebhooks()
 	}
 	if s.resource.HasConversionWebhook() {
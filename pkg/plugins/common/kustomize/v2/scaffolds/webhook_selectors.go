@@ -0,0 +1,172 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	"errors"
+	"fmt"
+	log "log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/common/kustomize/v2/scaffolds/internal/templates/config/webhook"
+)
+
+var _ plugins.Scaffolder = &webhookSelectorsScaffolder{}
+
+const webhookKustomizationPath = "config/webhook/kustomization.yaml"
+
+type webhookSelectorsScaffolder struct {
+	config config.Config
+	// previous is the resource before the edition, whose webhooks may no longer have selectors
+	previous resource.Resource
+	resource resource.Resource
+
+	// fs is the filesystem that will be used by the scaffolder
+	fs machinery.Filesystem
+}
+
+// NewWebhookSelectorsScaffolder returns a new Scaffolder that writes the patches setting the namespace and
+// object selectors of the webhooks of the resource, and removes the ones of the webhooks that no longer have any.
+func NewWebhookSelectorsScaffolder(cfg config.Config, previous, res resource.Resource) plugins.Scaffolder {
+	return &webhookSelectorsScaffolder{
+		config:   cfg,
+		previous: previous,
+		resource: res,
+	}
+}
+
+// InjectFS implements cmdutil.Scaffolder
+func (s *webhookSelectorsScaffolder) InjectFS(fs machinery.Filesystem) { s.fs = fs }
+
+// Scaffold implements cmdutil.Scaffolder
+func (s *webhookSelectorsScaffolder) Scaffold() error {
+	scaffold := machinery.NewScaffold(s.fs,
+		machinery.WithConfig(s.config),
+		machinery.WithResource(&s.resource),
+	)
+
+	withSelectors := make(map[string]bool)
+	for _, admissionWebhook := range s.resource.GetAdmissionWebhooks() {
+		if !admissionWebhook.Admission.HasSelectors() {
+			continue
+		}
+
+		name := admissionWebhook.ConfigurationName(s.resource)
+		withSelectors[name] = true
+		if err := scaffold.Execute(&webhook.SelectorsPatch{Webhook: admissionWebhook}); err != nil {
+			return fmt.Errorf("error scaffolding the selectors patch of the webhook %s: %w", name, err)
+		}
+		if err := s.editKustomization(func(content string) string {
+			return addPatch(content, webhook.SelectorsPatchPath(name))
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, admissionWebhook := range append(s.previous.GetAdmissionWebhooks(), s.resource.GetAdmissionWebhooks()...) {
+		name := admissionWebhook.ConfigurationName(s.resource)
+		if withSelectors[name] {
+			continue
+		}
+
+		path := webhook.SelectorsPatchPath(name)
+		if err := s.fs.FS.Remove(filepath.Join("config", "webhook", path)); err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return fmt.Errorf("error removing the selectors patch of the webhook %s: %w", name, err)
+		}
+		if err := s.editKustomization(func(content string) string {
+			return removePatch(content, path)
+		}); err != nil {
+			return err
+		}
+	}
+
+	// The patches directory is removed once it holds no patch.
+	dir := filepath.Join("config", "webhook", "patches")
+	if empty, err := afero.IsEmpty(s.fs.FS, dir); err == nil && empty {
+		if err = s.fs.FS.Remove(dir); err != nil {
+			return fmt.Errorf("error removing %q: %w", dir, err)
+		}
+	}
+
+	return nil
+}
+
+// editKustomization applies edit to the content of config/webhook/kustomization.yaml.
+func (s *webhookSelectorsScaffolder) editKustomization(edit func(string) string) error {
+	content, err := afero.ReadFile(s.fs.FS, webhookKustomizationPath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			log.Warn("Unable to find the webhook kustomization to reference the selectors patches",
+				"file", webhookKustomizationPath)
+			return nil
+		}
+		return fmt.Errorf("error reading %q: %w", webhookKustomizationPath, err)
+	}
+
+	edited := edit(string(content))
+	if edited == string(content) {
+		return nil
+	}
+	if err = afero.WriteFile(s.fs.FS, webhookKustomizationPath, []byte(edited),
+		machinery.DefaultFilePermission); err != nil {
+		return fmt.Errorf("error writing %q: %w", webhookKustomizationPath, err)
+	}
+
+	return nil
+}
+
+// addPatch adds path to the patches of the kustomization, adding the patches field if needed.
+func addPatch(content, path string) string {
+	entry := "- path: " + path + "\n"
+	if strings.Contains(content, entry) {
+		return content
+	}
+
+	if strings.HasPrefix(content, "patches:\n") || strings.Contains(content, "\npatches:\n") {
+		return strings.Replace(content, "patches:\n", "patches:\n"+entry, 1)
+	}
+	return strings.TrimRight(content, "\n") + "\n\npatches:\n" + entry
+}
+
+// removePatch removes path from the patches of the kustomization, and the patches field if it is left empty.
+func removePatch(content, path string) string {
+	content = strings.Replace(content, "- path: "+path+"\n", "", 1)
+
+	lines := strings.SplitAfter(content, "\n")
+	for i, line := range lines {
+		if line != "patches:\n" {
+			continue
+		}
+		if i+1 < len(lines) && strings.HasPrefix(lines[i+1], "- ") {
+			break
+		}
+		return strings.TrimRight(strings.Join(lines[:i], ""), "\n") + "\n" + strings.Join(lines[i+1:], "")
+	}
+
+	return content
+}
@@ -26,7 +26,11 @@ import (
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/external"
 )
 
-var _ plugin.CreateAPISubcommand = &createAPISubcommand{}
+var (
+	_ plugin.CreateAPISubcommand = &createAPISubcommand{}
+	_ plugin.HasPreScaffold      = &createAPISubcommand{}
+	_ plugin.HasPostScaffold     = &createAPISubcommand{}
+)
 
 const (
 	defaultAPIVersion = "v1alpha1"
@@ -36,12 +40,13 @@ type createAPISubcommand struct {
 	Path        string
 	Args        []string
 	pluginChain []string
-	config      config.Config
+
+	runner phaseRunner
 }
 
 // InjectConfig injects the project configuration so external plugins can read the PROJECT file.
 func (p *createAPISubcommand) InjectConfig(c config.Config) error {
-	p.config = c
+	p.runner.config = c
 
 	if c == nil {
 		return nil
@@ -63,31 +68,38 @@ func (p *createAPISubcommand) SetPluginChain(chain []string) {
 	p.pluginChain = append([]string(nil), chain...)
 }
 
-func (p *createAPISubcommand) InjectResource(*resource.Resource) error {
-	// Do nothing since resource flags are passed to the external plugin directly.
+func (p *createAPISubcommand) InjectResource(res *resource.Resource) error {
+	// Resource flags are passed to the external plugin directly, the resource model is
+	// only kept to send it to the plugin and to apply the changes the plugin returns.
+	p.runner.resource = res
 	return nil
 }
 
 func (p *createAPISubcommand) UpdateMetadata(_ plugin.CLIMetadata, subcmdMeta *plugin.SubcommandMetadata) {
-	setExternalPluginMetadata("api", p.Path, subcmdMeta)
+	p.runner.metadata = setExternalPluginMetadata("api", p.Path, subcmdMeta)
 }
 
 func (p *createAPISubcommand) BindFlags(fs *pflag.FlagSet) {
 	bindExternalPluginFlags(fs, "api", p.Path, p.Args)
 }
 
+func (p *createAPISubcommand) PreScaffold(fs machinery.Filesystem) error {
+	return p.runner.preScaffold(fs, p.Path, p.newRequest())
+}
+
 func (p *createAPISubcommand) Scaffold(fs machinery.Filesystem) error {
-	req := external.PluginRequest{
+	return p.runner.scaffold(fs, p.Path, p.newRequest())
+}
+
+func (p *createAPISubcommand) PostScaffold() error {
+	return p.runner.postScaffold(p.Path, p.newRequest())
+}
+
+func (p *createAPISubcommand) newRequest() external.PluginRequest {
+	return external.PluginRequest{
 		APIVersion:  defaultAPIVersion,
 		Command:     "create api",
 		Args:        p.Args,
 		PluginChain: p.pluginChain,
 	}
-
-	err := handlePluginResponse(fs, req, p.Path, p.config)
-	if err != nil {
-		return err
-	}
-
-	return nil
 }
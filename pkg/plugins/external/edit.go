@@ -26,19 +26,24 @@ import (
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/external"
 )
 
-var _ plugin.EditSubcommand = &editSubcommand{}
+var (
+	_ plugin.EditSubcommand  = &editSubcommand{}
+	_ plugin.HasPreScaffold  = &editSubcommand{}
+	_ plugin.HasPostScaffold = &editSubcommand{}
+)
 
 type editSubcommand struct {
 	Path        string
 	Args        []string
 	pluginChain []string
-	config      config.Config
+
+	runner phaseRunner
 }
 
 // InjectConfig injects the project configuration to access plugin chain information
 
 func (p *editSubcommand) InjectConfig(c config.Config) error {
-	p.config = c
+	p.runner.config = c
 
 	if c == nil {
 		return nil
@@ -61,25 +66,30 @@ func (p *editSubcommand) SetPluginChain(chain []string) {
 }
 
 func (p *editSubcommand) UpdateMetadata(_ plugin.CLIMetadata, subcmdMeta *plugin.SubcommandMetadata) {
-	setExternalPluginMetadata("edit", p.Path, subcmdMeta)
+	p.runner.metadata = setExternalPluginMetadata("edit", p.Path, subcmdMeta)
 }
 
 func (p *editSubcommand) BindFlags(fs *pflag.FlagSet) {
 	bindExternalPluginFlags(fs, "edit", p.Path, p.Args)
 }
 
+func (p *editSubcommand) PreScaffold(fs machinery.Filesystem) error {
+	return p.runner.preScaffold(fs, p.Path, p.newRequest())
+}
+
 func (p *editSubcommand) Scaffold(fs machinery.Filesystem) error {
-	req := external.PluginRequest{
+	return p.runner.scaffold(fs, p.Path, p.newRequest())
+}
+
+func (p *editSubcommand) PostScaffold() error {
+	return p.runner.postScaffold(p.Path, p.newRequest())
+}
+
+func (p *editSubcommand) newRequest() external.PluginRequest {
+	return external.PluginRequest{
 		APIVersion:  defaultAPIVersion,
 		Command:     "edit",
 		Args:        p.Args,
 		PluginChain: p.pluginChain,
 	}
-
-	err := handlePluginResponse(fs, req, p.Path, p.config)
-	if err != nil {
-		return err
-	}
-
-	return nil
 }
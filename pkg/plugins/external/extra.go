@@ -0,0 +1,147 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	log "log/slog"
+
+	"github.com/spf13/pflag"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/external"
+)
+
+var (
+	_ plugin.HasExtraSubcommands = Plugin{}
+
+	_ plugin.Subcommand      = &extraSubcommand{}
+	_ plugin.RequiresConfig  = &extraSubcommand{}
+	_ plugin.HasPreScaffold  = &extraSubcommand{}
+	_ plugin.HasPostScaffold = &extraSubcommand{}
+)
+
+// GetExtraSubcommands returns the subcommands that the plugin adds to the CLI, as answered to the
+// `subcommands` request. Plugins that do not support the request provide no extra subcommands.
+func (p Plugin) GetExtraSubcommands() []plugin.ExtraSubcommand {
+	res, err := makePluginRequest(external.PluginRequest{
+		APIVersion: defaultAPIVersion,
+		Command:    "subcommands",
+		Universe:   map[string]string{},
+	}, p.Path)
+	if err != nil {
+		log.Debug("external plugin does not provide extra subcommands", "plugin", plugin.KeyFor(p), "error", err)
+		return nil
+	}
+
+	extras := make([]plugin.ExtraSubcommand, 0, len(res.Subcommands))
+	for _, subcommand := range res.Subcommands {
+		projectAccess := subcommand.ProjectAccess
+		if projectAccess == "" {
+			projectAccess = plugin.ProjectAccessWrite
+		}
+
+		extras = append(extras, plugin.ExtraSubcommand{
+			Command:       subcommand.Command,
+			Short:         subcommand.Short,
+			ProjectAccess: projectAccess,
+			Subcommand: &extraSubcommand{
+				Path:       p.Path,
+				Args:       p.Args,
+				subcommand: subcommand,
+				runner:     phaseRunner{plugin: p},
+			},
+		})
+	}
+
+	return extras
+}
+
+// extraSubcommand runs a subcommand that the external plugin adds to the CLI.
+type extraSubcommand struct {
+	Path        string
+	Args        []string
+	pluginChain []string
+
+	// subcommand is the subcommand as declared by the plugin.
+	subcommand external.Subcommand
+
+	runner phaseRunner
+}
+
+func (p *extraSubcommand) InjectConfig(c config.Config) error {
+	p.runner.config = c
+
+	if c == nil {
+		return nil
+	}
+
+	if chain := c.GetPluginChain(); len(chain) > 0 {
+		p.pluginChain = append([]string(nil), chain...)
+	}
+
+	return nil
+}
+
+func (p *extraSubcommand) SetPluginChain(chain []string) {
+	if len(chain) == 0 {
+		p.pluginChain = nil
+		return
+	}
+
+	p.pluginChain = append([]string(nil), chain...)
+}
+
+func (p *extraSubcommand) UpdateMetadata(_ plugin.CLIMetadata, subcmdMeta *plugin.SubcommandMetadata) {
+	if p.subcommand.Metadata.Description != "" {
+		subcmdMeta.Description = p.subcommand.Metadata.Description
+	}
+	if p.subcommand.Metadata.Examples != "" {
+		subcmdMeta.Examples = p.subcommand.Metadata.Examples
+	}
+
+	p.runner.metadata = pluginMetadata{
+		universeFilters: p.subcommand.UniverseFilters,
+		phases:          p.subcommand.Phases,
+	}
+}
+
+func (p *extraSubcommand) BindFlags(fs *pflag.FlagSet) {
+	bindSpecificFlags(fs, filterFlags(p.subcommand.Flags, []externalFlagFilterFunc{helpFlagFilter}))
+}
+
+func (p *extraSubcommand) PreScaffold(fs machinery.Filesystem) error {
+	return p.runner.preScaffold(fs, p.Path, p.newRequest())
+}
+
+func (p *extraSubcommand) Scaffold(fs machinery.Filesystem) error {
+	return p.runner.scaffold(fs, p.Path, p.newRequest())
+}
+
+func (p *extraSubcommand) PostScaffold() error {
+	return p.runner.postScaffold(p.Path, p.newRequest())
+}
+
+func (p *extraSubcommand) newRequest() external.PluginRequest {
+	return external.PluginRequest{
+		APIVersion:  defaultAPIVersion,
+		Command:     p.subcommand.Command,
+		Args:        p.Args,
+		PluginChain: p.pluginChain,
+	}
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	"github.com/spf13/pflag"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/external"
+)
+
+type mockExtraSubcommandsOutputGetter struct {
+	requests []external.PluginRequest
+}
+
+var _ ExecOutputGetter = &mockExtraSubcommandsOutputGetter{}
+
+func (m *mockExtraSubcommandsOutputGetter) GetExecOutput(request []byte, _ string) ([]byte, error) {
+	req := external.PluginRequest{}
+	if err := json.Unmarshal(request, &req); err != nil {
+		return nil, err
+	}
+	m.requests = append(m.requests, req)
+
+	res := external.PluginResponse{APIVersion: defaultAPIVersion, Command: req.Command}
+	switch req.Command {
+	case "subcommands":
+		res.Subcommands = []external.Subcommand{
+			{
+				Command:  "create policy",
+				Short:    "Scaffold a policy",
+				Metadata: plugin.SubcommandMetadata{Description: "Scaffold a policy for the project"},
+				Flags:    []external.Flag{{Name: "kind", Type: "string", Usage: "kind of the policy"}},
+			},
+			{Command: "audit", ProjectAccess: plugin.ProjectAccessNone},
+		}
+	case "create policy":
+		res.Universe = map[string]string{"policy.yaml": "kind: Policy\n"}
+	}
+
+	return json.Marshal(res)
+}
+
+var _ = Describe("extra subcommands", func() {
+	var getter *mockExtraSubcommandsOutputGetter
+
+	BeforeEach(func() {
+		getter = &mockExtraSubcommandsOutputGetter{}
+		outputGetter = getter
+		currentDirGetter = &mockValidOsWdGetter{}
+	})
+
+	It("should return the subcommands declared by the plugin", func() {
+		extras := Plugin{PName: "sample", PVersion: plugin.Version{Number: 1}}.GetExtraSubcommands()
+
+		Expect(extras).To(HaveLen(2))
+		Expect(extras[0].Command).To(Equal("create policy"))
+		Expect(extras[0].Short).To(Equal("Scaffold a policy"))
+		Expect(extras[0].ProjectAccess).To(Equal(plugin.ProjectAccessWrite))
+		Expect(extras[1].ProjectAccess).To(Equal(plugin.ProjectAccessNone))
+
+		meta := plugin.CLIMetadata{CommandName: "kubebuilder"}
+		subcmdMeta := &plugin.SubcommandMetadata{}
+		extras[0].Subcommand.(plugin.UpdatesMetadata).UpdateMetadata(meta, subcmdMeta)
+		Expect(subcmdMeta.Description).To(Equal("Scaffold a policy for the project"))
+
+		fs := pflag.NewFlagSet("create policy", pflag.ContinueOnError)
+		extras[0].Subcommand.(plugin.HasFlags).BindFlags(fs)
+		Expect(fs.Lookup("kind")).NotTo(BeNil())
+	})
+
+	It("should run the subcommand with its command name", func() {
+		extras := Plugin{PName: "sample", PVersion: plugin.Version{Number: 1}}.GetExtraSubcommands()
+		fs := machinery.Filesystem{FS: afero.NewMemMapFs()}
+
+		Expect(extras[0].Subcommand.Scaffold(fs)).To(Succeed())
+		Expect(getter.requests[len(getter.requests)-1].Command).To(Equal("create policy"))
+
+		content, err := afero.ReadFile(fs.FS, "tmp/externalPlugin/policy.yaml")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(content)).To(Equal("kind: Policy\n"))
+	})
+
+	It("should provide no subcommands if the plugin does not support the request", func() {
+		outputGetter = &mockInValidOutputGetter{}
+
+		Expect(Plugin{PName: "sample", PVersion: plugin.Version{Number: 1}}.GetExtraSubcommands()).To(BeEmpty())
+	})
+})
@@ -0,0 +1,63 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	"errors"
+	"fmt"
+)
+
+// subcommandMetadataArgs maps the subcommands to the argument of their `metadata` request.
+var subcommandMetadataArgs = map[string]string{
+	"init":           "init",
+	"create api":     "api",
+	"create webhook": "webhook",
+	"edit":           "edit",
+}
+
+// Handshake checks that the external plugin found in path speaks the plugin protocol by sending it
+// a `metadata` request for each of the provided subcommands, or for all of them if none are provided.
+// It succeeds if the plugin answers at least one of the requests with a valid response.
+func Handshake(path string, subcommands []string) error {
+	if len(subcommands) == 0 {
+		subcommands = supportedSubcommands
+	}
+
+	var errs []error
+	for _, subcommand := range subcommands {
+		arg, found := subcommandMetadataArgs[subcommand]
+		if !found {
+			return fmt.Errorf("unsupported subcommand %q", subcommand)
+		}
+
+		res, err := getExternalPluginMetadata(arg, path)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", subcommand, err))
+			continue
+		}
+
+		if res.APIVersion != defaultAPIVersion {
+			errs = append(errs, fmt.Errorf("%s: unsupported plugin protocol apiVersion %q, supported: %q",
+				subcommand, res.APIVersion, defaultAPIVersion))
+			continue
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("external plugin %q did not answer the metadata request: %w", path, errors.Join(errs...))
+}
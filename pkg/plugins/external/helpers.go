@@ -20,8 +20,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
-	"io"
-	iofs "io/fs"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -29,7 +27,6 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/spf13/afero"
 	"github.com/spf13/pflag"
 	"sigs.k8s.io/yaml"
 
@@ -56,6 +53,10 @@ type ExecOutputGetter interface {
 type execOutputGetter struct{}
 
 func (e *execOutputGetter) GetExecOutput(request []byte, path string) ([]byte, error) {
+	if IsWasmPlugin(path) {
+		return getWasmOutput(request, path)
+	}
+
 	cmd := exec.Command(path) //nolint:gosec
 	cmd.Stdin = bytes.NewBuffer(request)
 	cmd.Stderr = os.Stderr
@@ -114,50 +115,53 @@ func makePluginRequest(req external.PluginRequest, path string) (*external.Plugi
 // It will return a map[string]string where the keys are relative paths to files in the directory
 // and values are the contents, or an error if an issue occurred while reading one of the files.
 func getUniverseMap(fs machinery.Filesystem) (map[string]string, error) {
-	universe := map[string]string{}
-
-	err := afero.Walk(fs.FS, ".", func(path string, info iofs.FileInfo, err error) error {
-		if err != nil {
-			return fmt.Errorf("error walking path %q: %w", path, err)
-		}
-
-		if info.IsDir() {
-			return nil
-		}
-
-		file, err := fs.FS.Open(path)
-		if err != nil {
-			return fmt.Errorf("error opening file %q: %w", path, err)
-		}
-
-		defer func() {
-			if err = file.Close(); err != nil {
-				return
-			}
-		}()
+	files, err := listUniverseFiles(fs, false)
+	if err != nil {
+		return nil, err
+	}
 
-		content, err := io.ReadAll(file)
-		if err != nil {
-			return fmt.Errorf("error reading file %q: %w", path, err)
-		}
+	universe := make(map[string]string, len(files))
+	if err = readUniverseFiles(fs, universe, files); err != nil {
+		return nil, err
+	}
 
-		universe[path] = string(content)
+	return universe, nil
+}
 
-		return nil
-	})
+// getFilteredUniverseMap builds the universe map only with the files that match the provided glob filters.
+// It also returns the list of all the files that the plugin may request on demand. As the plugin opted in
+// to the filtered universe, the files ignored by the project .gitignore files and the .git directory are
+// not included.
+func getFilteredUniverseMap(fs machinery.Filesystem, filters []string) (map[string]string, []string, error) {
+	files, err := listUniverseFiles(fs, true)
 	if err != nil {
-		return nil, fmt.Errorf("error walking the directory: %w", err)
+		return nil, nil, err
 	}
 
-	return universe, nil
+	universe := map[string]string{}
+	if err = readUniverseFiles(fs, universe, filterUniverseFiles(files, filters)); err != nil {
+		return nil, nil, err
+	}
+
+	return universe, files, nil
 }
 
-func handlePluginResponse(fs machinery.Filesystem, req external.PluginRequest, path string, cfg config.Config) error {
+func handlePluginResponse(
+	fs machinery.Filesystem,
+	req external.PluginRequest,
+	path string,
+	cfg config.Config,
+	universeFilters []string,
+) (*external.PluginResponse, error) {
 	var err error
 
-	req.Universe, err = getUniverseMap(fs)
+	if len(universeFilters) == 0 {
+		req.Universe, err = getUniverseMap(fs)
+	} else {
+		req.Universe, req.UniverseFiles, err = getFilteredUniverseMap(fs, universeFilters)
+	}
 	if err != nil {
-		return fmt.Errorf("error getting universe map: %w", err)
+		return nil, fmt.Errorf("error getting universe map: %w", err)
 	}
 
 	// Marshal config to include in the request if config is provided
@@ -165,25 +169,25 @@ func handlePluginResponse(fs machinery.Filesystem, req external.PluginRequest, p
 		var configData []byte
 		configData, err = cfg.MarshalYAML()
 		if err != nil {
-			return fmt.Errorf("error marshaling config: %w", err)
+			return nil, fmt.Errorf("error marshaling config: %w", err)
 		}
 
 		var configMap map[string]any
 		if err = yaml.Unmarshal(configData, &configMap); err != nil {
-			return fmt.Errorf("error unmarshaling config to map: %w", err)
+			return nil, fmt.Errorf("error unmarshaling config to map: %w", err)
 		}
 
 		req.Config = configMap
 	}
 
-	res, err := makePluginRequest(req, path)
+	res, err := makeUniversePluginRequest(fs, req, path)
 	if err != nil {
-		return fmt.Errorf("error making request to external plugin: %w", err)
+		return nil, err
 	}
 
 	currentDir, err := currentDirGetter.GetCurrentDir()
 	if err != nil {
-		return fmt.Errorf("error getting current directory: %w", err)
+		return nil, fmt.Errorf("error getting current directory: %w", err)
 	}
 
 	for filename, data := range res.Universe {
@@ -192,12 +196,12 @@ func handlePluginResponse(fs machinery.Filesystem, req external.PluginRequest, p
 
 		// create the directory if it does not exist
 		if err = os.MkdirAll(dir, 0o750); err != nil {
-			return fmt.Errorf("error creating the directory: %w", err)
+			return nil, fmt.Errorf("error creating the directory: %w", err)
 		}
 
 		f, createErr := fs.FS.Create(file)
 		if createErr != nil {
-			return fmt.Errorf("error creating file %q: %w", file, createErr)
+			return nil, fmt.Errorf("error creating file %q: %w", file, createErr)
 		}
 
 		defer func() {
@@ -207,11 +211,36 @@ func handlePluginResponse(fs machinery.Filesystem, req external.PluginRequest, p
 		}()
 
 		if _, err = f.Write([]byte(data)); err != nil {
-			return fmt.Errorf("error writing file %q: %w", file, err)
+			return nil, fmt.Errorf("error writing file %q: %w", file, err)
 		}
 	}
 
-	return nil
+	return res, nil
+}
+
+// makeUniversePluginRequest sends the request to the external plugin and, for as long as the plugin
+// asks for the contents of more files, adds them to the universe and sends the request again.
+func makeUniversePluginRequest(
+	fs machinery.Filesystem,
+	req external.PluginRequest,
+	path string,
+) (*external.PluginResponse, error) {
+	for range maxUniverseRequests {
+		res, err := makePluginRequest(req, path)
+		if err != nil {
+			return nil, fmt.Errorf("error making request to external plugin: %w", err)
+		}
+
+		if len(res.RequestedFiles) == 0 {
+			return res, nil
+		}
+
+		if err = addRequestedFiles(fs, req.Universe, req.UniverseFiles, res.RequestedFiles); err != nil {
+			return nil, fmt.Errorf("error providing the files requested by the external plugin: %w", err)
+		}
+	}
+
+	return nil, fmt.Errorf("external plugin requested additional files more than %d times", maxUniverseRequests)
 }
 
 // getExternalPluginFlags is a helper function that is used to get a list of flags from an external plugin.
@@ -369,27 +398,36 @@ func bindExternalPluginFlags(fs *pflag.FlagSet, subcommand string, path string,
 // metadata that is used when the help text is shown for a subcommand.
 // It will attempt to get the Metadata from the external plugin. If the
 // external plugin returns no Metadata or an error, a default will be used.
-func setExternalPluginMetadata(subcommand, path string, subcmdMeta *plugin.SubcommandMetadata) {
+// It returns the optional protocol features declared by the external plugin.
+func setExternalPluginMetadata(subcommand, path string, subcmdMeta *plugin.SubcommandMetadata) pluginMetadata {
 	fileName := filepath.Base(path)
 	subcmdMeta.Description = fmt.Sprintf(defaultMetadataTemplate, fileName[:len(fileName)-len(filepath.Ext(fileName))])
 
 	res, _ := getExternalPluginMetadata(subcommand, path)
 
-	if res != nil {
-		if res.Description != "" {
-			subcmdMeta.Description = res.Description
-		}
+	if res == nil {
+		return pluginMetadata{}
+	}
 
-		if res.Examples != "" {
-			subcmdMeta.Examples = res.Examples
-		}
+	if res.Metadata.Description != "" {
+		subcmdMeta.Description = res.Metadata.Description
+	}
+
+	if res.Metadata.Examples != "" {
+		subcmdMeta.Examples = res.Metadata.Examples
+	}
+
+	return pluginMetadata{
+		universeFilters: res.UniverseFilters,
+		phases:          res.Phases,
 	}
 }
 
-// fetchExternalPluginMetadata performs the actual request to the
-// external plugin to get the metadata. It returns the metadata
-// or an error if an error occurs during the fetch process.
-func getExternalPluginMetadata(subcommand, path string) (*plugin.SubcommandMetadata, error) {
+// getExternalPluginMetadata performs the actual request to the
+// external plugin to get the metadata. It returns the plugin response
+// holding the metadata and the universe filters, or an error if an
+// error occurs during the fetch process.
+func getExternalPluginMetadata(subcommand, path string) (*external.PluginResponse, error) {
 	req := external.PluginRequest{
 		APIVersion: defaultAPIVersion,
 		Command:    "metadata",
@@ -402,5 +440,5 @@ func getExternalPluginMetadata(subcommand, path string) (*plugin.SubcommandMetad
 		return nil, fmt.Errorf("error making request to external plugin: %w", err)
 	}
 
-	return &res.Metadata, nil
+	return res, nil
 }
@@ -26,19 +26,24 @@ import (
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/external"
 )
 
-var _ plugin.InitSubcommand = &initSubcommand{}
+var (
+	_ plugin.InitSubcommand  = &initSubcommand{}
+	_ plugin.HasPreScaffold  = &initSubcommand{}
+	_ plugin.HasPostScaffold = &initSubcommand{}
+)
 
 type initSubcommand struct {
 	Path        string
 	Args        []string
 	pluginChain []string
-	config      config.Config
+
+	runner phaseRunner
 }
 
 // InjectConfig injects the project configuration to access plugin chain information
 
 func (p *initSubcommand) InjectConfig(c config.Config) error {
-	p.config = c
+	p.runner.config = c
 
 	if c == nil {
 		return nil
@@ -61,25 +66,30 @@ func (p *initSubcommand) SetPluginChain(chain []string) {
 }
 
 func (p *initSubcommand) UpdateMetadata(_ plugin.CLIMetadata, subcmdMeta *plugin.SubcommandMetadata) {
-	setExternalPluginMetadata("init", p.Path, subcmdMeta)
+	p.runner.metadata = setExternalPluginMetadata("init", p.Path, subcmdMeta)
 }
 
 func (p *initSubcommand) BindFlags(fs *pflag.FlagSet) {
 	bindExternalPluginFlags(fs, "init", p.Path, p.Args)
 }
 
+func (p *initSubcommand) PreScaffold(fs machinery.Filesystem) error {
+	return p.runner.preScaffold(fs, p.Path, p.newRequest())
+}
+
 func (p *initSubcommand) Scaffold(fs machinery.Filesystem) error {
-	req := external.PluginRequest{
+	return p.runner.scaffold(fs, p.Path, p.newRequest())
+}
+
+func (p *initSubcommand) PostScaffold() error {
+	return p.runner.postScaffold(p.Path, p.newRequest())
+}
+
+func (p *initSubcommand) newRequest() external.PluginRequest {
+	return external.PluginRequest{
 		APIVersion:  defaultAPIVersion,
 		Command:     "init",
 		Args:        p.Args,
 		PluginChain: p.pluginChain,
 	}
-
-	err := handlePluginResponse(fs, req, p.Path, p.config)
-	if err != nil {
-		return err
-	}
-
-	return nil
 }
@@ -0,0 +1,183 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	iofs "io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/spf13/afero"
+	"sigs.k8s.io/yaml"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/external"
+)
+
+const (
+	// PublicKeyExtension is the extension of the PEM encoded ed25519 public key files
+	// used to verify the signature of external plugins.
+	PublicKeyExtension = ".pub"
+
+	// publicKeysDir is the default directory, relative to the plugins root, holding the public keys.
+	publicKeysDir = ".keys"
+)
+
+// supportedSubcommands are the subcommands an external plugin can declare in its manifest.
+var supportedSubcommands = []string{"init", "create api", "create webhook", "edit"}
+
+// LoadManifest reads the manifest found in the provided plugin version directory.
+// It returns nil if the directory has no manifest.
+func LoadManifest(fs afero.Fs, dir string) (*external.Manifest, error) {
+	content, err := afero.ReadFile(fs, filepath.Join(dir, external.ManifestFileName))
+	if err != nil {
+		if errors.Is(err, iofs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error reading plugin manifest: %w", err)
+	}
+
+	manifest := &external.Manifest{}
+	if err = yaml.UnmarshalStrict(content, manifest); err != nil {
+		return nil, fmt.Errorf("error parsing plugin manifest %q: %w", filepath.Join(dir, external.ManifestFileName), err)
+	}
+
+	return manifest, nil
+}
+
+// ValidateManifest checks that the manifest describes the plugin named name in version,
+// and that it only declares supported values.
+func ValidateManifest(manifest external.Manifest, name, version string) error {
+	if manifest.Name != name {
+		return fmt.Errorf("manifest name %q does not match the plugin directory %q", manifest.Name, name)
+	}
+	if manifest.Version != version {
+		return fmt.Errorf("manifest version %q does not match the plugin version directory %q", manifest.Version, version)
+	}
+	if manifest.APIVersion != "" && manifest.APIVersion != defaultAPIVersion {
+		return fmt.Errorf("unsupported plugin protocol apiVersion %q, supported: %q", manifest.APIVersion, defaultAPIVersion)
+	}
+	for _, subcommand := range manifest.Subcommands {
+		if !slices.Contains(supportedSubcommands, subcommand) {
+			return fmt.Errorf("unsupported subcommand %q, supported: %s",
+				subcommand, strings.Join(supportedSubcommands, ", "))
+		}
+	}
+	if manifest.SHA256 == "" {
+		return errors.New("manifest must declare the sha256 checksum of the plugin executable")
+	}
+
+	return nil
+}
+
+// VerifyExecutable checks that the plugin executable matches the checksum declared in the manifest and,
+// if the manifest declares a signature, that it was signed by one of the provided public keys.
+func VerifyExecutable(fs afero.Fs, manifest external.Manifest, path string, keys []ed25519.PublicKey) error {
+	content, err := afero.ReadFile(fs, path)
+	if err != nil {
+		return fmt.Errorf("error reading plugin executable %q: %w", path, err)
+	}
+
+	sum := sha256.Sum256(content)
+	if actual := hex.EncodeToString(sum[:]); !strings.EqualFold(actual, manifest.SHA256) {
+		return fmt.Errorf("checksum mismatch for plugin executable %q: manifest declares sha256 %q but found %q",
+			path, manifest.SHA256, actual)
+	}
+
+	if manifest.Signature == "" {
+		return nil
+	}
+
+	signature, err := base64.StdEncoding.DecodeString(manifest.Signature)
+	if err != nil {
+		return fmt.Errorf("error decoding the signature of plugin executable %q: %w", path, err)
+	}
+
+	if len(keys) == 0 {
+		return fmt.Errorf("plugin executable %q is signed but no public keys are configured to verify it", path)
+	}
+
+	for _, key := range keys {
+		if ed25519.Verify(key, content, signature) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("signature of plugin executable %q does not match any of the configured public keys", path)
+}
+
+// PublicKeysPath returns the directory holding the public keys used to verify the
+// signature of the external plugins found in pluginsRoot. It can be set with $EXTERNAL_PLUGINS_KEYS_PATH.
+func PublicKeysPath(pluginsRoot string) string {
+	if keysPath := os.Getenv("EXTERNAL_PLUGINS_KEYS_PATH"); keysPath != "" {
+		return keysPath
+	}
+	return filepath.Join(pluginsRoot, publicKeysDir)
+}
+
+// LoadPublicKeys reads the PEM encoded ed25519 public keys (`*.pub` files) found in dir.
+// It returns no keys if the directory does not exist.
+func LoadPublicKeys(fs afero.Fs, dir string) ([]ed25519.PublicKey, error) {
+	entries, err := afero.ReadDir(fs, dir)
+	if err != nil {
+		if errors.Is(err, iofs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error reading public keys directory %q: %w", dir, err)
+	}
+
+	var keys []ed25519.PublicKey
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != PublicKeyExtension {
+			continue
+		}
+
+		path := filepath.Join(dir, entry.Name())
+		content, err := afero.ReadFile(fs, path)
+		if err != nil {
+			return nil, fmt.Errorf("error reading public key %q: %w", path, err)
+		}
+
+		block, _ := pem.Decode(content)
+		if block == nil {
+			return nil, fmt.Errorf("public key %q is not PEM encoded", path)
+		}
+
+		parsed, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing public key %q: %w", path, err)
+		}
+
+		key, ok := parsed.(ed25519.PublicKey)
+		if !ok {
+			return nil, fmt.Errorf("public key %q is not an ed25519 key", path)
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
@@ -0,0 +1,189 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/external"
+)
+
+var _ = Describe("external plugin manifest", func() {
+	const (
+		dir        = "plugins/sample/v1"
+		executable = "plugins/sample/v1/sample"
+		content    = "#!/bin/bash\necho sample\n"
+	)
+
+	var (
+		fs       afero.Fs
+		manifest external.Manifest
+	)
+
+	BeforeEach(func() {
+		fs = afero.NewMemMapFs()
+		Expect(afero.WriteFile(fs, executable, []byte(content), 0o755)).To(Succeed())
+
+		sum := sha256.Sum256([]byte(content))
+		manifest = external.Manifest{
+			Name:       "sample",
+			Version:    "v1",
+			APIVersion: "v1alpha1",
+			SHA256:     hex.EncodeToString(sum[:]),
+		}
+	})
+
+	Context("LoadManifest", func() {
+		It("should return nil when there is no manifest", func() {
+			m, err := LoadManifest(fs, dir)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(m).To(BeNil())
+		})
+
+		It("should parse the manifest", func() {
+			Expect(afero.WriteFile(fs, dir+"/plugin.yaml", []byte(`name: sample
+version: v1
+description: A sample plugin
+supportedProjectVersions: ["3"]
+subcommands: ["edit"]
+sha256: abc
+`), 0o644)).To(Succeed())
+
+			m, err := LoadManifest(fs, dir)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(m.Description).To(Equal("A sample plugin"))
+			Expect(m.Subcommands).To(Equal([]string{"edit"}))
+			Expect(m.SHA256).To(Equal("abc"))
+		})
+
+		It("should fail for unknown fields", func() {
+			Expect(afero.WriteFile(fs, dir+"/plugin.yaml", []byte("name: sample\nchecksum: abc\n"), 0o644)).To(Succeed())
+
+			_, err := LoadManifest(fs, dir)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("ValidateManifest", func() {
+		It("should succeed for a valid manifest", func() {
+			Expect(ValidateManifest(manifest, "sample", "v1")).To(Succeed())
+		})
+
+		It("should fail if the name does not match the directory", func() {
+			Expect(ValidateManifest(manifest, "other", "v1")).To(MatchError(ContainSubstring("does not match")))
+		})
+
+		It("should fail if the version does not match the directory", func() {
+			Expect(ValidateManifest(manifest, "sample", "v2")).To(MatchError(ContainSubstring("does not match")))
+		})
+
+		It("should fail for an unsupported protocol version", func() {
+			manifest.APIVersion = "v2"
+			Expect(ValidateManifest(manifest, "sample", "v1")).To(MatchError(ContainSubstring("unsupported plugin protocol")))
+		})
+
+		It("should fail for an unsupported subcommand", func() {
+			manifest.Subcommands = []string{"create policy"}
+			Expect(ValidateManifest(manifest, "sample", "v1")).To(MatchError(ContainSubstring("unsupported subcommand")))
+		})
+
+		It("should fail without checksum", func() {
+			manifest.SHA256 = ""
+			Expect(ValidateManifest(manifest, "sample", "v1")).To(MatchError(ContainSubstring("sha256")))
+		})
+	})
+
+	Context("VerifyExecutable", func() {
+		It("should succeed when the checksum matches", func() {
+			Expect(VerifyExecutable(fs, manifest, executable, nil)).To(Succeed())
+		})
+
+		It("should refuse the executable when the checksum does not match", func() {
+			Expect(afero.WriteFile(fs, executable, []byte("tampered"), 0o755)).To(Succeed())
+			Expect(VerifyExecutable(fs, manifest, executable, nil)).To(MatchError(ContainSubstring("checksum mismatch")))
+		})
+
+		Context("with a signature", func() {
+			var (
+				publicKey  ed25519.PublicKey
+				privateKey ed25519.PrivateKey
+			)
+
+			BeforeEach(func() {
+				var err error
+				publicKey, privateKey, err = ed25519.GenerateKey(rand.Reader)
+				Expect(err).NotTo(HaveOccurred())
+				manifest.Signature = base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, []byte(content)))
+			})
+
+			It("should succeed when signed by a configured key", func() {
+				otherKey, _, err := ed25519.GenerateKey(rand.Reader)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(VerifyExecutable(fs, manifest, executable, []ed25519.PublicKey{otherKey, publicKey})).To(Succeed())
+			})
+
+			It("should fail when no key is configured", func() {
+				Expect(VerifyExecutable(fs, manifest, executable, nil)).
+					To(MatchError(ContainSubstring("no public keys are configured")))
+			})
+
+			It("should fail when not signed by any configured key", func() {
+				otherKey, _, err := ed25519.GenerateKey(rand.Reader)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(VerifyExecutable(fs, manifest, executable, []ed25519.PublicKey{otherKey})).
+					To(MatchError(ContainSubstring("does not match any of the configured public keys")))
+			})
+
+			It("should load the PEM encoded public keys", func() {
+				der, err := x509.MarshalPKIXPublicKey(publicKey)
+				Expect(err).NotTo(HaveOccurred())
+				pemKey := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+				Expect(afero.WriteFile(fs, "keys/acme.pub", pemKey, 0o644)).To(Succeed())
+				Expect(afero.WriteFile(fs, "keys/README", []byte("not a key"), 0o644)).To(Succeed())
+
+				keys, err := LoadPublicKeys(fs, "keys")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(keys).To(HaveLen(1))
+				Expect(VerifyExecutable(fs, manifest, executable, keys)).To(Succeed())
+			})
+		})
+	})
+
+	Context("subcommands declared in the manifest", func() {
+		It("should skip the subcommands that are not declared", func() {
+			p := Plugin{PName: "sample", PVersion: plugin.Version{Number: 1}, PSubcommands: []string{"edit"}}
+
+			Expect(p.GetEditSubcommand()).To(BeAssignableToTypeOf(&editSubcommand{}))
+			Expect(p.GetInitSubcommand()).To(BeAssignableToTypeOf(unsupportedSubcommand{}))
+			Expect(p.GetCreateAPISubcommand()).To(BeAssignableToTypeOf(unsupportedSubcommand{}))
+			Expect(p.GetCreateWebhookSubcommand()).To(BeAssignableToTypeOf(unsupportedSubcommand{}))
+			Expect(p.GetInitSubcommand().Scaffold(machinery.Filesystem{FS: fs})).To(Succeed())
+		})
+	})
+})
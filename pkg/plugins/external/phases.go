@@ -0,0 +1,159 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	"errors"
+	"fmt"
+	log "log/slog"
+	"slices"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/external"
+)
+
+// pluginMetadata holds the optional protocol features that an external plugin
+// declares in its response to the `metadata` request.
+type pluginMetadata struct {
+	// universeFilters are the glob patterns of the files the plugin needs.
+	universeFilters []string
+	// phases are the optional phases the plugin wants to be called for.
+	phases []string
+}
+
+// supportsPhase checks if the plugin declared the provided phase.
+func (m pluginMetadata) supportsPhase(phase string) bool {
+	return slices.Contains(m.phases, phase)
+}
+
+// phaseRunner sends the requests of the different phases of a subcommand to an external plugin
+// and applies the changes returned by the plugin to the resource model and the project configuration.
+type phaseRunner struct {
+	plugin   Plugin
+	metadata pluginMetadata
+	config   config.Config
+	resource *resource.Resource
+
+	// fs is the filesystem used by the previous phases, stored to be reused by the post-scaffold phase.
+	fs machinery.Filesystem
+	// resourceModified is set when the plugin returned a modified resource.
+	resourceModified bool
+}
+
+// preScaffold sends req for the pre-scaffold phase if the plugin declared it.
+func (r *phaseRunner) preScaffold(fs machinery.Filesystem, path string, req external.PluginRequest) error {
+	r.fs = fs
+	if !r.metadata.supportsPhase(external.PhasePreScaffold) {
+		return nil
+	}
+	return r.run(fs, path, req, external.PhasePreScaffold)
+}
+
+// scaffold sends req for the main scaffold phase.
+func (r *phaseRunner) scaffold(fs machinery.Filesystem, path string, req external.PluginRequest) error {
+	r.fs = fs
+	return r.run(fs, path, req, "")
+}
+
+// postScaffold sends req for the post-scaffold phase if the plugin declared it.
+func (r *phaseRunner) postScaffold(path string, req external.PluginRequest) error {
+	if !r.metadata.supportsPhase(external.PhasePostScaffold) {
+		return nil
+	}
+	return r.run(r.fs, path, req, external.PhasePostScaffold)
+}
+
+// run sends req for the provided phase and applies the returned changes.
+func (r *phaseRunner) run(fs machinery.Filesystem, path string, req external.PluginRequest, phase string) error {
+	req.Phase = phase
+	if r.resource != nil {
+		res := r.resource.Copy()
+		req.Resource = &res
+	}
+
+	res, err := handlePluginResponse(fs, req, path, r.config, r.metadata.universeFilters)
+	if err != nil {
+		return err
+	}
+
+	if phase == external.PhasePostScaffold {
+		if res.Resource != nil || res.PluginConfig != nil {
+			log.Warn("ignoring the resource and plugin config returned in the post-scaffold phase",
+				"plugin", plugin.KeyFor(r.plugin))
+		}
+		return nil
+	}
+
+	if err = r.applyResource(res.Resource); err != nil {
+		return err
+	}
+
+	// Resources are only stored once the plugin scaffolded its files.
+	if phase == "" && r.resourceModified && r.config != nil {
+		if err = r.config.UpdateResource(*r.resource); err != nil {
+			return fmt.Errorf("error updating resource %q in the configuration: %w", r.resource.GVK, err)
+		}
+	}
+
+	return r.applyPluginConfig(res.PluginConfig)
+}
+
+// applyResource replaces the injected resource model with the one returned by the plugin.
+func (r *phaseRunner) applyResource(res *resource.Resource) error {
+	if res == nil {
+		return nil
+	}
+
+	if r.resource == nil {
+		return errors.New("external plugin returned a resource for a subcommand that does not require one")
+	}
+
+	if !r.resource.IsEqualTo(res.GVK) {
+		return fmt.Errorf("external plugin cannot modify the group, version or kind of the resource %q", r.resource.GVK)
+	}
+
+	if err := res.Validate(); err != nil {
+		return fmt.Errorf("external plugin returned an invalid resource: %w", err)
+	}
+
+	// The resource is shared by all the plugins of the chain, so it is modified in place.
+	*r.resource = res.Copy()
+	r.resourceModified = true
+
+	return nil
+}
+
+// applyPluginConfig stores the plugin config returned by the plugin in the project configuration.
+func (r *phaseRunner) applyPluginConfig(pluginConfig map[string]any) error {
+	if pluginConfig == nil {
+		return nil
+	}
+
+	if r.config == nil {
+		return errors.New("external plugin returned a plugin config but no project configuration is available")
+	}
+
+	key := plugin.GetPluginKeyForConfig(r.config.GetPluginChain(), r.plugin)
+	if err := r.config.EncodePluginConfig(key, pluginConfig); err != nil {
+		return fmt.Errorf("error encoding the plugin config for %q: %w", key, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	"encoding/json"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	cfgv3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/external"
+)
+
+// mockPhaseOutputGetter records every request and answers with the response configured for its phase.
+type mockPhaseOutputGetter struct {
+	responses map[string]external.PluginResponse
+	requests  []external.PluginRequest
+}
+
+var _ ExecOutputGetter = &mockPhaseOutputGetter{}
+
+func (m *mockPhaseOutputGetter) GetExecOutput(request []byte, _ string) ([]byte, error) {
+	var req external.PluginRequest
+	if err := json.Unmarshal(request, &req); err != nil {
+		return nil, fmt.Errorf("error unmarshalling request: %w", err)
+	}
+	m.requests = append(m.requests, req)
+
+	out, err := json.Marshal(m.responses[req.Phase])
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling response: %w", err)
+	}
+	return out, nil
+}
+
+var _ = Describe("external plugin phases", func() {
+	var (
+		getter *mockPhaseOutputGetter
+		fs     machinery.Filesystem
+		cfg    config.Config
+		res    *resource.Resource
+		sub    *createAPISubcommand
+	)
+
+	BeforeEach(func() {
+		getter = &mockPhaseOutputGetter{responses: map[string]external.PluginResponse{}}
+		outputGetter = getter
+		currentDirGetter = &mockValidOsWdGetter{}
+
+		fs = machinery.Filesystem{FS: afero.NewMemMapFs()}
+		cfg = cfgv3.New()
+		Expect(cfg.SetPluginChain([]string{"go.kubebuilder.io/v4", "myexternalplugin/v1"})).To(Succeed())
+
+		res = &resource.Resource{
+			GVK:    resource.GVK{Group: "crew", Domain: "my.domain", Version: "v1", Kind: "Captain"},
+			Plural: "captains",
+		}
+
+		sub = &createAPISubcommand{
+			Path:   "myexternalplugin",
+			runner: phaseRunner{plugin: Plugin{PName: "myexternalplugin", PVersion: plugin.Version{Number: 1}}},
+		}
+		Expect(sub.InjectConfig(cfg)).To(Succeed())
+		Expect(sub.InjectResource(res)).To(Succeed())
+	})
+
+	It("should not send optional phases the plugin did not declare", func() {
+		Expect(sub.PreScaffold(fs)).To(Succeed())
+		Expect(sub.Scaffold(fs)).To(Succeed())
+		Expect(sub.PostScaffold()).To(Succeed())
+
+		Expect(getter.requests).To(HaveLen(1))
+		Expect(getter.requests[0].Phase).To(BeEmpty())
+		Expect(getter.requests[0].Resource).NotTo(BeNil())
+		Expect(getter.requests[0].Resource.Kind).To(Equal("Captain"))
+	})
+
+	It("should send the declared phases in order", func() {
+		sub.runner.metadata.phases = []string{external.PhasePreScaffold, external.PhasePostScaffold}
+
+		Expect(sub.PreScaffold(fs)).To(Succeed())
+		Expect(sub.Scaffold(fs)).To(Succeed())
+		Expect(sub.PostScaffold()).To(Succeed())
+
+		Expect(getter.requests).To(HaveLen(3))
+		Expect(getter.requests[0].Phase).To(Equal(external.PhasePreScaffold))
+		Expect(getter.requests[1].Phase).To(BeEmpty())
+		Expect(getter.requests[2].Phase).To(Equal(external.PhasePostScaffold))
+		Expect(getter.requests[2].Command).To(Equal("create api"))
+	})
+
+	It("should fail in the pre-scaffold phase when the plugin reports a validation error", func() {
+		sub.runner.metadata.phases = []string{external.PhasePreScaffold}
+		getter.responses[external.PhasePreScaffold] = external.PluginResponse{
+			Error:     true,
+			ErrorMsgs: []string{"kind Captain is reserved"},
+		}
+
+		Expect(sub.PreScaffold(fs)).To(MatchError(ContainSubstring("kind Captain is reserved")))
+	})
+
+	It("should apply the returned resource in place and store it in the configuration", func() {
+		sub.runner.metadata.phases = []string{external.PhasePreScaffold}
+		modified := res.Copy()
+		modified.API = &resource.API{CRDVersion: "v1", Namespaced: true}
+		getter.responses[external.PhasePreScaffold] = external.PluginResponse{Resource: &modified}
+
+		Expect(sub.PreScaffold(fs)).To(Succeed())
+		Expect(res.API).NotTo(BeNil())
+		Expect(res.API.Namespaced).To(BeTrue())
+		Expect(cfg.HasResource(res.GVK)).To(BeFalse())
+
+		Expect(sub.Scaffold(fs)).To(Succeed())
+		stored, err := cfg.GetResource(res.GVK)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stored.HasAPI()).To(BeTrue())
+	})
+
+	It("should refuse a resource with a different GVK", func() {
+		modified := res.Copy()
+		modified.Kind = "FirstMate"
+		getter.responses[""] = external.PluginResponse{Resource: &modified}
+
+		Expect(sub.Scaffold(fs)).To(MatchError(ContainSubstring("cannot modify the group, version or kind")))
+	})
+
+	It("should store the returned plugin config under the plugin key", func() {
+		getter.responses[""] = external.PluginResponse{PluginConfig: map[string]any{"monitoring": true}}
+
+		Expect(sub.Scaffold(fs)).To(Succeed())
+
+		var pluginConfig struct {
+			Monitoring bool `json:"monitoring"`
+		}
+		Expect(cfg.DecodePluginConfig("myexternalplugin/v1", &pluginConfig)).To(Succeed())
+		Expect(pluginConfig.Monitoring).To(BeTrue())
+	})
+})
@@ -17,17 +17,30 @@ limitations under the License.
 package external
 
 import (
+	log "log/slog"
+	"slices"
+
 	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
 )
 
-var _ plugin.Full = Plugin{}
+var (
+	_ plugin.Full        = Plugin{}
+	_ plugin.Describable = Plugin{}
+)
 
 // Plugin implements the plugin.Full interface
 type Plugin struct {
 	PName                     string
 	PVersion                  plugin.Version
 	PSupportedProjectVersions []config.Version
+	// PDescription is the description declared in the plugin manifest, if any.
+	PDescription string
+	// PSubcommands are the subcommands declared in the plugin manifest.
+	// All the subcommands are supported when empty.
+	PSubcommands []string
 
 	Path string
 	Args []string
@@ -42,35 +55,59 @@ func (p Plugin) Version() plugin.Version { return p.PVersion }
 // SupportedProjectVersions returns an array with all project versions supported by the plugin
 func (p Plugin) SupportedProjectVersions() []config.Version { return p.PSupportedProjectVersions }
 
+// Description returns the description declared in the plugin manifest
+func (p Plugin) Description() string { return p.PDescription }
+
+// supportsSubcommand checks if the plugin declared the provided subcommand in its manifest
+func (p Plugin) supportsSubcommand(subcommand string) bool {
+	return len(p.PSubcommands) == 0 || slices.Contains(p.PSubcommands, subcommand)
+}
+
 // GetInitSubcommand will return the subcommand which is responsible for initializing and common scaffolding
 func (p Plugin) GetInitSubcommand() plugin.InitSubcommand {
+	if !p.supportsSubcommand("init") {
+		return unsupportedSubcommand{plugin: p, subcommand: "init"}
+	}
 	return &initSubcommand{
-		Path: p.Path,
-		Args: p.Args,
+		Path:   p.Path,
+		Args:   p.Args,
+		runner: phaseRunner{plugin: p},
 	}
 }
 
 // GetCreateAPISubcommand will return the subcommand which is responsible for scaffolding apis
 func (p Plugin) GetCreateAPISubcommand() plugin.CreateAPISubcommand {
+	if !p.supportsSubcommand("create api") {
+		return unsupportedSubcommand{plugin: p, subcommand: "create api"}
+	}
 	return &createAPISubcommand{
-		Path: p.Path,
-		Args: p.Args,
+		Path:   p.Path,
+		Args:   p.Args,
+		runner: phaseRunner{plugin: p},
 	}
 }
 
 // GetCreateWebhookSubcommand will return the subcommand which is responsible for scaffolding webhooks
 func (p Plugin) GetCreateWebhookSubcommand() plugin.CreateWebhookSubcommand {
+	if !p.supportsSubcommand("create webhook") {
+		return unsupportedSubcommand{plugin: p, subcommand: "create webhook"}
+	}
 	return &createWebhookSubcommand{
-		Path: p.Path,
-		Args: p.Args,
+		Path:   p.Path,
+		Args:   p.Args,
+		runner: phaseRunner{plugin: p},
 	}
 }
 
 // GetEditSubcommand will return the subcommand which is responsible for editing the scaffold of the project
 func (p Plugin) GetEditSubcommand() plugin.EditSubcommand {
+	if !p.supportsSubcommand("edit") {
+		return unsupportedSubcommand{plugin: p, subcommand: "edit"}
+	}
 	return &editSubcommand{
-		Path: p.Path,
-		Args: p.Args,
+		Path:   p.Path,
+		Args:   p.Args,
+		runner: phaseRunner{plugin: p},
 	}
 }
 
@@ -78,3 +115,20 @@ func (p Plugin) GetEditSubcommand() plugin.EditSubcommand {
 func (p Plugin) DeprecationWarning() string {
 	return ""
 }
+
+// unsupportedSubcommand is returned for the subcommands that the plugin manifest does not declare,
+// so that the plugin is skipped instead of being executed for them.
+type unsupportedSubcommand struct {
+	plugin     Plugin
+	subcommand string
+}
+
+// InjectResource implements plugin.RequiresResource.
+func (unsupportedSubcommand) InjectResource(*resource.Resource) error { return nil }
+
+// Scaffold implements plugin.Subcommand.
+func (s unsupportedSubcommand) Scaffold(machinery.Filesystem) error {
+	log.Warn("skipping external plugin, its manifest does not declare the subcommand",
+		"plugin", plugin.KeyFor(s.plugin), "subcommand", s.subcommand)
+	return nil
+}
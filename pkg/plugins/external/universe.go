@@ -0,0 +1,218 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	"errors"
+	"fmt"
+	iofs "io/fs"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/external"
+)
+
+const (
+	gitDir        = ".git"
+	gitignoreFile = ".gitignore"
+
+	// maxUniverseRequests limits how many times a plugin can ask for more files in a single subcommand run.
+	maxUniverseRequests = 10
+)
+
+// listUniverseFiles returns the relative paths of all the files of the project that can be sent
+// to an external plugin. When excludeIgnored is set, the `.git` directory and the paths ignored by
+// the `.gitignore` files found in the project are excluded.
+func listUniverseFiles(fs machinery.Filesystem, excludeIgnored bool) ([]string, error) {
+	var files []string
+	var rules []gitignoreRule
+
+	err := afero.Walk(fs.FS, ".", func(p string, info iofs.FileInfo, err error) error {
+		if err != nil {
+			return fmt.Errorf("error walking path %q: %w", p, err)
+		}
+
+		p = filepath.ToSlash(p)
+		if !excludeIgnored {
+			if !info.IsDir() {
+				files = append(files, p)
+			}
+			return nil
+		}
+
+		if p == "." {
+			rules, err = appendGitignoreRules(fs, rules, "")
+			return err
+		}
+
+		if info.IsDir() {
+			if info.Name() == gitDir || isGitignored(rules, p, true) {
+				return filepath.SkipDir
+			}
+			rules, err = appendGitignoreRules(fs, rules, p)
+			return err
+		}
+
+		if !isGitignored(rules, p, false) {
+			files = append(files, p)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error walking the directory: %w", err)
+	}
+
+	return files, nil
+}
+
+// readUniverseFiles reads the contents of the provided files into the universe map.
+func readUniverseFiles(fs machinery.Filesystem, universe map[string]string, files []string) error {
+	for _, file := range files {
+		content, err := afero.ReadFile(fs.FS, file)
+		if err != nil {
+			return fmt.Errorf("error reading file %q: %w", file, err)
+		}
+
+		universe[file] = string(content)
+	}
+
+	return nil
+}
+
+// filterUniverseFiles returns the files that match any of the provided glob filters.
+func filterUniverseFiles(files []string, filters []string) []string {
+	var filtered []string
+	for _, file := range files {
+		if external.MatchUniverseFilters(filters, file) {
+			filtered = append(filtered, file)
+		}
+	}
+	return filtered
+}
+
+// addRequestedFiles adds the contents of the files requested by an external plugin to the request universe.
+// Only files listed in the request UniverseFiles can be requested.
+func addRequestedFiles(fs machinery.Filesystem, universe map[string]string, available, requested []string) error {
+	var toRead []string
+	for _, file := range requested {
+		file = strings.TrimPrefix(path.Clean(filepath.ToSlash(file)), "./")
+		if !slices.Contains(available, file) {
+			return fmt.Errorf("requested file %q is not available in the universe", file)
+		}
+		if _, found := universe[file]; !found {
+			toRead = append(toRead, file)
+		}
+	}
+
+	if len(toRead) == 0 {
+		return errors.New("requested files were already provided")
+	}
+
+	return readUniverseFiles(fs, universe, toRead)
+}
+
+// gitignoreRule is a single pattern parsed from a .gitignore file.
+type gitignoreRule struct {
+	// base is the directory, relative to the project root, that contains the .gitignore file.
+	base string
+	// pattern is the glob pattern without the negation prefix and the directory suffix.
+	pattern string
+	// negate is set for patterns starting with "!", which re-include previously ignored paths.
+	negate bool
+	// dirOnly is set for patterns ending with "/", which only match directories.
+	dirOnly bool
+	// anchored is set for patterns containing a "/", which are matched relative to base
+	// instead of against the name of the file at any depth.
+	anchored bool
+}
+
+// appendGitignoreRules parses the .gitignore file found in dir, if any, and appends its rules.
+func appendGitignoreRules(fs machinery.Filesystem, rules []gitignoreRule, dir string) ([]gitignoreRule, error) {
+	content, err := afero.ReadFile(fs.FS, path.Join(dir, gitignoreFile))
+	if err != nil {
+		if errors.Is(err, iofs.ErrNotExist) {
+			return rules, nil
+		}
+		return nil, fmt.Errorf("error reading %s: %w", path.Join(dir, gitignoreFile), err)
+	}
+
+	for line := range strings.SplitSeq(string(content), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		rule := gitignoreRule{base: dir}
+		if strings.HasPrefix(line, "!") {
+			rule.negate = true
+			line = line[1:]
+		}
+		if strings.HasSuffix(line, "/") {
+			rule.dirOnly = true
+			line = strings.TrimSuffix(line, "/")
+		}
+		if strings.Contains(line, "/") {
+			rule.anchored = true
+			line = strings.TrimPrefix(line, "/")
+		}
+		if line == "" {
+			continue
+		}
+		rule.pattern = line
+
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+// isGitignored reports whether the provided path is ignored by the rules.
+// As in git, the last matching rule wins.
+func isGitignored(rules []gitignoreRule, p string, isDir bool) bool {
+	ignored := false
+	for _, rule := range rules {
+		if rule.dirOnly && !isDir {
+			continue
+		}
+
+		rel := p
+		if rule.base != "" {
+			if !strings.HasPrefix(p, rule.base+"/") {
+				continue
+			}
+			rel = strings.TrimPrefix(p, rule.base+"/")
+		}
+
+		var matched bool
+		if rule.anchored {
+			matched = external.MatchGlob(rule.pattern, rel)
+		} else {
+			matched = external.MatchGlob(rule.pattern, path.Base(rel))
+		}
+
+		if matched {
+			ignored = !rule.negate
+		}
+	}
+	return ignored
+}
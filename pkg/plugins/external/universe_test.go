@@ -0,0 +1,176 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	"encoding/json"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/external"
+)
+
+// mockUniverseOutputGetter requests the files in toRequest on the first call and records every request.
+type mockUniverseOutputGetter struct {
+	toRequest []string
+	requests  []external.PluginRequest
+}
+
+var _ ExecOutputGetter = &mockUniverseOutputGetter{}
+
+func (m *mockUniverseOutputGetter) GetExecOutput(request []byte, _ string) ([]byte, error) {
+	var req external.PluginRequest
+	if err := json.Unmarshal(request, &req); err != nil {
+		return nil, fmt.Errorf("error unmarshalling request: %w", err)
+	}
+	m.requests = append(m.requests, req)
+
+	res := external.PluginResponse{Command: req.Command}
+	if len(m.requests) == 1 {
+		res.RequestedFiles = m.toRequest
+	}
+
+	out, err := json.Marshal(res)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling response: %w", err)
+	}
+	return out, nil
+}
+
+var _ = Describe("universe", func() {
+	var fs machinery.Filesystem
+
+	writeFile := func(path, content string) {
+		Expect(afero.WriteFile(fs.FS, path, []byte(content), 0o600)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		fs = machinery.Filesystem{FS: afero.NewMemMapFs()}
+
+		writeFile(".gitignore", "# binaries\nbin/\n*.log\n/cover.out\n!keep.log\n")
+		writeFile("PROJECT", "domain: my.domain\n")
+		writeFile("cover.out", "coverage")
+		writeFile("debug.log", "log")
+		writeFile("keep.log", "kept")
+		writeFile("bin/manager", "binary")
+		writeFile(".git/HEAD", "ref: refs/heads/main")
+		writeFile("api/v1/captain_types.go", "package v1")
+		writeFile("internal/controller/cover.out", "nested coverage")
+		writeFile("vendor/.gitignore", "*\n")
+		writeFile("vendor/modules.txt", "modules")
+	})
+
+	Context("listUniverseFiles", func() {
+		It("should skip the .git directory and the paths ignored by .gitignore files", func() {
+			files, err := listUniverseFiles(fs, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(files).To(ConsistOf(
+				".gitignore",
+				"PROJECT",
+				"keep.log",
+				"api/v1/captain_types.go",
+				"internal/controller/cover.out",
+			))
+		})
+
+		It("should list all the files when the ignored paths are not excluded", func() {
+			files, err := listUniverseFiles(fs, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(files).To(HaveLen(11))
+			Expect(files).To(ContainElements("bin/manager", ".git/HEAD", "vendor/modules.txt", "debug.log"))
+		})
+	})
+
+	Context("getFilteredUniverseMap", func() {
+		It("should only read the files matching the filters", func() {
+			universe, files, err := getFilteredUniverseMap(fs, []string{"PROJECT", "api/**/*_types.go"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(universe).To(Equal(map[string]string{
+				"PROJECT":                 "domain: my.domain\n",
+				"api/v1/captain_types.go": "package v1",
+			}))
+			Expect(files).To(ContainElement("keep.log"))
+		})
+	})
+
+	Context("MatchGlob", func() {
+		DescribeTable("should match paths",
+			func(pattern, name string, expected bool) {
+				Expect(external.MatchGlob(pattern, name)).To(Equal(expected))
+			},
+			Entry("exact path", "PROJECT", "PROJECT", true),
+			Entry("single segment wildcard", "api/*/types.go", "api/v1/types.go", true),
+			Entry("single segment wildcard does not cross directories", "api/*.go", "api/v1/types.go", false),
+			Entry("double star matches zero directories", "config/**/*.yaml", "config/kustomization.yaml", true),
+			Entry("double star matches many directories", "config/**/*.yaml", "config/crd/bases/a.yaml", true),
+			Entry("trailing double star", "config/**", "config/rbac/role.yaml", true),
+			Entry("no match", "api/**", "internal/controller/c.go", false),
+		)
+	})
+
+	Context("handlePluginResponse with universe filters", func() {
+		var getter *mockUniverseOutputGetter
+
+		BeforeEach(func() {
+			getter = &mockUniverseOutputGetter{}
+			outputGetter = getter
+			currentDirGetter = &mockValidOsWdGetter{}
+		})
+
+		It("should send the filtered universe and the list of available files", func() {
+			req := external.PluginRequest{APIVersion: defaultAPIVersion, Command: "edit"}
+			_, err := handlePluginResponse(fs, req, "plugin", nil, []string{"PROJECT"})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(getter.requests).To(HaveLen(1))
+			Expect(getter.requests[0].Universe).To(HaveLen(1))
+			Expect(getter.requests[0].Universe).To(HaveKey("PROJECT"))
+			Expect(getter.requests[0].UniverseFiles).To(ContainElement("api/v1/captain_types.go"))
+		})
+
+		It("should send the requested files in a new request", func() {
+			getter.toRequest = []string{"api/v1/captain_types.go"}
+			req := external.PluginRequest{APIVersion: defaultAPIVersion, Command: "edit"}
+			_, err := handlePluginResponse(fs, req, "plugin", nil, []string{"PROJECT"})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(getter.requests).To(HaveLen(2))
+			Expect(getter.requests[1].Universe).To(HaveKeyWithValue("api/v1/captain_types.go", "package v1"))
+		})
+
+		It("should fail when a requested file is not available", func() {
+			getter.toRequest = []string{"bin/manager"}
+			req := external.PluginRequest{APIVersion: defaultAPIVersion, Command: "edit"}
+			_, err := handlePluginResponse(fs, req, "plugin", nil, []string{"PROJECT"})
+			Expect(err).To(MatchError(ContainSubstring(`requested file "bin/manager" is not available`)))
+		})
+
+		It("should send the whole universe, ignored files included, when no filters are declared", func() {
+			req := external.PluginRequest{APIVersion: defaultAPIVersion, Command: "edit"}
+			_, err := handlePluginResponse(fs, req, "plugin", nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(getter.requests[0].Universe).To(HaveLen(11))
+			Expect(getter.requests[0].Universe).To(HaveKey("bin/manager"))
+			Expect(getter.requests[0].UniverseFiles).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,98 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/imports/wasi_snapshot_preview1"
+	"github.com/tetratelabs/wazero/sys"
+)
+
+const (
+	// WasmExtension is the file extension of the external plugins that are WebAssembly modules.
+	WasmExtension = ".wasm"
+
+	// wasmPluginTimeout bounds the execution time of a single request to a WebAssembly plugin.
+	wasmPluginTimeout = 5 * time.Minute
+)
+
+// IsWasmPlugin checks if the external plugin found in path is a WebAssembly module.
+func IsWasmPlugin(path string) bool {
+	return strings.EqualFold(filepath.Ext(path), WasmExtension)
+}
+
+// getWasmOutput runs the WebAssembly plugin found in path with the request as stdin and returns its stdout.
+//
+// The module is run in-process by a pure-Go WASI runtime. It has no access to the filesystem, the network
+// or the environment variables: its only input is the request and its only output is the response.
+func getWasmOutput(request []byte, path string) ([]byte, error) {
+	wasm, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading WebAssembly plugin %q: %w", path, err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), wasmPluginTimeout)
+	defer cancel()
+
+	runtime := wazero.NewRuntimeWithConfig(ctx, wazero.NewRuntimeConfig().WithCloseOnContextDone(true))
+	defer func() {
+		_ = runtime.Close(ctx)
+	}()
+
+	if _, err = wasi_snapshot_preview1.Instantiate(ctx, runtime); err != nil {
+		return nil, fmt.Errorf("error instantiating WASI: %w", err)
+	}
+
+	compiled, err := runtime.CompileModule(ctx, wasm)
+	if err != nil {
+		return nil, fmt.Errorf("error compiling WebAssembly plugin %q: %w", path, err)
+	}
+
+	var stdout bytes.Buffer
+	moduleConfig := wazero.NewModuleConfig().
+		WithName(filepath.Base(path)).
+		WithArgs(filepath.Base(path)).
+		WithStdin(bytes.NewReader(request)).
+		WithStdout(&stdout).
+		WithStderr(os.Stderr).
+		WithSysWalltime().
+		WithSysNanotime().
+		WithSysNanosleep().
+		WithRandSource(rand.Reader)
+
+	mod, err := runtime.InstantiateModule(ctx, compiled, moduleConfig)
+	if err != nil {
+		var exitErr *sys.ExitError
+		if errors.As(err, &exitErr) {
+			return nil, fmt.Errorf("WebAssembly plugin %q exited with code %d", path, exitErr.ExitCode())
+		}
+		return nil, fmt.Errorf("error running WebAssembly plugin %q: %w", path, err)
+	}
+	_ = mod.Close(ctx)
+
+	return stdout.Bytes(), nil
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/external"
+)
+
+// wasmPluginSource is a plugin that echoes the request command and reports whether it could
+// read a file from the host filesystem, which the sandbox must prevent.
+const wasmPluginSource = `package main
+
+import (
+	"encoding/json"
+	"os"
+)
+
+func main() {
+	var req map[string]any
+	if err := json.NewDecoder(os.Stdin).Decode(&req); err != nil {
+		os.Exit(2)
+	}
+
+	hostAccess := "denied"
+	if _, err := os.ReadFile(os.Args[0]); err == nil {
+		hostAccess = "allowed"
+	}
+
+	_ = json.NewEncoder(os.Stdout).Encode(map[string]any{
+		"apiVersion": "v1alpha1",
+		"command":    req["command"],
+		"universe":   map[string]string{"host-access": hostAccess},
+	})
+}
+`
+
+var _ = Describe("WebAssembly external plugins", func() {
+	It("should detect WebAssembly plugins by their extension", func() {
+		Expect(IsWasmPlugin("plugins/sample/v1/sample.wasm")).To(BeTrue())
+		Expect(IsWasmPlugin("plugins/sample/v1/sample.WASM")).To(BeTrue())
+		Expect(IsWasmPlugin("plugins/sample/v1/sample")).To(BeFalse())
+		Expect(IsWasmPlugin("plugins/sample/v1/sample.sh")).To(BeFalse())
+	})
+
+	It("should run the module in a sandbox using the stdin/stdout contract", func() {
+		dir := GinkgoT().TempDir()
+		Expect(os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module sample\n\ngo 1.21\n"), 0o600)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(dir, "main.go"), []byte(wasmPluginSource), 0o600)).To(Succeed())
+
+		wasmPath := filepath.Join(dir, "sample.wasm")
+		cmd := exec.Command("go", "build", "-o", wasmPath, ".")
+		cmd.Dir = dir
+		cmd.Env = append(os.Environ(), "GOOS=wasip1", "GOARCH=wasm", "GOTOOLCHAIN=local")
+		if out, err := cmd.CombinedOutput(); err != nil {
+			Skip("unable to build the WebAssembly test plugin: " + string(out))
+		}
+
+		req, err := json.Marshal(external.PluginRequest{APIVersion: defaultAPIVersion, Command: "edit"})
+		Expect(err).NotTo(HaveOccurred())
+
+		out, err := (&execOutputGetter{}).GetExecOutput(req, wasmPath)
+		Expect(err).NotTo(HaveOccurred())
+
+		var res external.PluginResponse
+		Expect(json.Unmarshal(out, &res)).To(Succeed())
+		Expect(res.Command).To(Equal("edit"))
+		Expect(res.Universe).To(HaveKeyWithValue("host-access", "denied"))
+	})
+
+	It("should fail for invalid modules", func() {
+		wasmPath := filepath.Join(GinkgoT().TempDir(), "invalid.wasm")
+		Expect(os.WriteFile(wasmPath, []byte("not a module"), 0o600)).To(Succeed())
+
+		_, err := getWasmOutput([]byte("{}"), wasmPath)
+		Expect(err).To(MatchError(ContainSubstring("error compiling WebAssembly plugin")))
+	})
+})
@@ -26,18 +26,23 @@ import (
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/external"
 )
 
-var _ plugin.CreateWebhookSubcommand = &createWebhookSubcommand{}
+var (
+	_ plugin.CreateWebhookSubcommand = &createWebhookSubcommand{}
+	_ plugin.HasPreScaffold          = &createWebhookSubcommand{}
+	_ plugin.HasPostScaffold         = &createWebhookSubcommand{}
+)
 
 type createWebhookSubcommand struct {
 	Path        string
 	Args        []string
 	pluginChain []string
-	config      config.Config
+
+	runner phaseRunner
 }
 
 // InjectConfig injects the project configuration so external plugins can read the PROJECT file.
 func (p *createWebhookSubcommand) InjectConfig(c config.Config) error {
-	p.config = c
+	p.runner.config = c
 
 	if c == nil {
 		return nil
@@ -59,31 +64,38 @@ func (p *createWebhookSubcommand) SetPluginChain(chain []string) {
 	p.pluginChain = append([]string(nil), chain...)
 }
 
-func (p *createWebhookSubcommand) InjectResource(*resource.Resource) error {
-	// Do nothing since resource flags are passed to the external plugin directly.
+func (p *createWebhookSubcommand) InjectResource(res *resource.Resource) error {
+	// Resource flags are passed to the external plugin directly, the resource model is
+	// only kept to send it to the plugin and to apply the changes the plugin returns.
+	p.runner.resource = res
 	return nil
 }
 
 func (p *createWebhookSubcommand) UpdateMetadata(_ plugin.CLIMetadata, subcmdMeta *plugin.SubcommandMetadata) {
-	setExternalPluginMetadata("webhook", p.Path, subcmdMeta)
+	p.runner.metadata = setExternalPluginMetadata("webhook", p.Path, subcmdMeta)
 }
 
 func (p *createWebhookSubcommand) BindFlags(fs *pflag.FlagSet) {
 	bindExternalPluginFlags(fs, "webhook", p.Path, p.Args)
 }
 
+func (p *createWebhookSubcommand) PreScaffold(fs machinery.Filesystem) error {
+	return p.runner.preScaffold(fs, p.Path, p.newRequest())
+}
+
 func (p *createWebhookSubcommand) Scaffold(fs machinery.Filesystem) error {
-	req := external.PluginRequest{
+	return p.runner.scaffold(fs, p.Path, p.newRequest())
+}
+
+func (p *createWebhookSubcommand) PostScaffold() error {
+	return p.runner.postScaffold(p.Path, p.newRequest())
+}
+
+func (p *createWebhookSubcommand) newRequest() external.PluginRequest {
+	return external.PluginRequest{
 		APIVersion:  defaultAPIVersion,
 		Command:     "create webhook",
 		Args:        p.Args,
 		PluginChain: p.pluginChain,
 	}
-
-	err := handlePluginResponse(fs, req, p.Path, p.config)
-	if err != nil {
-		return err
-	}
-
-	return nil
 }
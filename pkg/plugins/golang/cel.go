@@ -0,0 +1,102 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package golang
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/google/cel-go/common"
+	"github.com/google/cel-go/parser"
+)
+
+// celPathRegex matches the paths of the fields validated by the CEL rules, e.g. spec.replicas
+var celPathRegex = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_-]*(\.[a-zA-Z][a-zA-Z0-9_-]*)*$`)
+
+// CELRule is a CEL validation rule of an API declared with `path:rule[:message]`.
+type CELRule struct {
+	// Path is the JSON path of the field validated by the rule, e.g. spec.replicas,
+	// or empty for the rules validating the whole object.
+	Path string
+
+	// Rule is the CEL expression, where self is the value of the field.
+	Rule string
+
+	// Message is the message returned when the rule is not satisfied, if any.
+	Message string
+}
+
+// ParseCELRule parses a CEL rule in the `path:rule[:message]` format and checks its syntax. The path is empty
+// to validate the whole object. As CEL expressions may contain colons, the rule ends at the first colon after
+// which the rest is a message and before which the rule is a valid expression, so the message may contain
+// colons too.
+func ParseCELRule(value string) (CELRule, error) {
+	path, expression, found := strings.Cut(value, ":")
+	if !found || strings.TrimSpace(expression) == "" {
+		return CELRule{}, fmt.Errorf("CEL rule %q must follow the format path:rule[:message]", value)
+	}
+
+	rule := CELRule{Path: strings.TrimSpace(path)}
+	if rule.Path != "" && !celPathRegex.MatchString(rule.Path) {
+		return CELRule{}, fmt.Errorf("path %q must be a dot-separated list of field names, e.g. spec.replicas",
+			rule.Path)
+	}
+
+	p, err := parser.NewParser(parser.Macros(parser.AllMacros...), parser.EnableOptionalSyntax(true))
+	if err != nil {
+		return CELRule{}, fmt.Errorf("error creating the CEL parser: %w", err)
+	}
+	check := func(expression string) error {
+		if _, issues := p.Parse(common.NewTextSource(expression)); len(issues.GetErrors()) != 0 {
+			return errors.New(issues.ToDisplayString())
+		}
+		return nil
+	}
+
+	for i := range len(expression) {
+		if expression[i] != ':' {
+			continue
+		}
+		if check(expression[:i]) == nil {
+			rule.Rule = strings.TrimSpace(expression[:i])
+			rule.Message = strings.TrimSpace(expression[i+1:])
+			return rule, nil
+		}
+	}
+	if err := check(expression); err != nil {
+		return CELRule{}, fmt.Errorf("invalid CEL rule %q:\n%w", strings.TrimSpace(expression), err)
+	}
+	rule.Rule = strings.TrimSpace(expression)
+
+	return rule, nil
+}
+
+// Fields returns the JSON names of the fields along the path of the rule.
+func (r CELRule) Fields() []string {
+	if r.Path == "" {
+		return nil
+	}
+	return strings.Split(r.Path, ".")
+}
+
+// Marker returns the XValidation marker of the rule, without the comment prefix. The rule and the message are
+// quoted with the Go syntax, which the markers parse.
+func (r CELRule) Marker() string {
+	return "+kubebuilder:validation:XValidation:" + ValidationRule{Rule: r.Rule, Message: r.Message}.markerArgs()
+}
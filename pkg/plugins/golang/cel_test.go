@@ -0,0 +1,62 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package golang
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CELRule", func() {
+	Context("ParseCELRule", func() {
+		DescribeTable("should split the path, the rule and the message",
+			func(value string, expected CELRule) {
+				rule, err := ParseCELRule(value)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(rule).To(Equal(expected))
+			},
+			Entry("field rule", "spec.replicas:self >= 0",
+				CELRule{Path: "spec.replicas", Rule: "self >= 0"}),
+			Entry("field rule with message", "spec.replicas:self >= 0:replicas must be non-negative",
+				CELRule{Path: "spec.replicas", Rule: "self >= 0", Message: "replicas must be non-negative"}),
+			Entry("root rule", ":self.metadata.name.size() < 64",
+				CELRule{Rule: "self.metadata.name.size() < 64"}),
+			Entry("rule with colons", "spec:self.mode == 'a:b' ? has(self.x) : true",
+				CELRule{Path: "spec", Rule: "self.mode == 'a:b' ? has(self.x) : true"}),
+			Entry("message with colons", "spec:{'a': 1}.a == 1:note: always true",
+				CELRule{Path: "spec", Rule: "{'a': 1}.a == 1", Message: "note: always true"}),
+		)
+
+		DescribeTable("should fail for invalid rules",
+			func(value, message string) {
+				_, err := ParseCELRule(value)
+				Expect(err).To(MatchError(ContainSubstring(message)))
+			},
+			Entry("missing rule", "spec.replicas", "must follow the format path:rule[:message]"),
+			Entry("empty rule", "spec.replicas: ", "must follow the format path:rule[:message]"),
+			Entry("invalid path", "spec..replicas:self > 0", `path "spec..replicas" must be a dot-separated list`),
+			Entry("syntax error", "spec:self.replicas >", `invalid CEL rule "self.replicas >"`),
+			Entry("invalid macro", "spec:self.all(1, true)", `argument must be a simple name`),
+		)
+	})
+
+	It("should quote the rule and the message in the marker", func() {
+		rule := CELRule{Rule: `self.name != ""`, Message: `name must be set`}
+		Expect(rule.Marker()).To(Equal(
+			`+kubebuilder:validation:XValidation:rule="self.name != \"\"",message="name must be set"`))
+	})
+})
@@ -0,0 +1,574 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package golang
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/types"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// ConversionFileName is the name of the file generated in the spoke packages with the conversion functions
+const ConversionFileName = "zz_generated.conversion.go"
+
+// ConversionKind is a kind of a spoke package that is converted to and from its hub version
+type ConversionKind struct {
+	// Kind is the name of the type in both versions
+	Kind string
+	// HubPath is the import path of the hub version and HubAlias its import alias in the spoke package
+	HubPath  string
+	HubAlias string
+}
+
+// ConversionPackages are the packages that the conversion functions of a spoke version are generated from
+type ConversionPackages struct {
+	// Spoke is the package of the spoke version
+	Spoke *types.Package
+	// Hubs are the packages of the hub versions, by import path
+	Hubs map[string]*types.Package
+	// ManualFunctions are the Convert_ functions implemented by hand in the spoke package,
+	// which are not generated
+	ManualFunctions map[string]bool
+}
+
+// LoadConversionPackages type-checks the spoke package found in spokeDir and its hub packages.
+// The conversion functions generated previously are ignored, so that stale ones neither fail the
+// type-checking nor hide the ones implemented by hand.
+func LoadConversionPackages(spokeDir, spokePath string, hubPaths []string) (*ConversionPackages, error) {
+	generated, err := filepath.Abs(filepath.Join(spokeDir, ConversionFileName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve the path of %s: %w", ConversionFileName, err)
+	}
+
+	cfg := &packages.Config{
+		Mode:    packages.NeedName | packages.NeedFiles | packages.NeedSyntax | packages.NeedTypes,
+		Overlay: map[string][]byte{generated: []byte("package " + filepath.Base(spokePath) + "\n")},
+	}
+	pkgs, err := packages.Load(cfg, append([]string{spokePath}, hubPaths...)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the API packages: %w", err)
+	}
+
+	loaded := &ConversionPackages{
+		Hubs:            make(map[string]*types.Package, len(hubPaths)),
+		ManualFunctions: make(map[string]bool),
+	}
+	for _, pkg := range pkgs {
+		// Type errors are expected, e.g. the spoke calls the conversion functions that are being generated
+		// or the deepcopy functions are not generated yet, but the API types are still resolved from the syntax.
+		for _, pkgErr := range pkg.Errors {
+			if pkgErr.Kind == packages.ParseError || len(pkg.Syntax) == 0 {
+				return nil, fmt.Errorf("failed to load package %q: %w", pkg.PkgPath, errors.New(pkgErr.Error()))
+			}
+		}
+
+		if pkg.PkgPath != spokePath {
+			loaded.Hubs[pkg.PkgPath] = pkg.Types
+			continue
+		}
+		loaded.Spoke = pkg.Types
+		for _, file := range pkg.Syntax {
+			for _, decl := range file.Decls {
+				if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv == nil && strings.HasPrefix(fn.Name.Name, "Convert_") {
+					loaded.ManualFunctions[fn.Name.Name] = true
+				}
+			}
+		}
+	}
+	if loaded.Spoke == nil {
+		return nil, fmt.Errorf("package %q not found", spokePath)
+	}
+
+	return loaded, nil
+}
+
+// Conversions are the conversion functions generated for a spoke package
+type Conversions struct {
+	// Functions is the code of the functions
+	Functions string
+	// Stashes is true when some hub versions are stashed, which requires importing
+	// encoding/json, fmt and k8s.io/apimachinery/pkg/apis/meta/v1
+	Stashes bool
+}
+
+// GenerateConversions returns the functions that convert the kinds of the spoke package to and from their hub
+// versions, in the style of conversion-gen:
+//
+//   - autoConvert_<in>_To_<out> functions copy the fields with the same name and type, and recurse into the
+//     nested types of the API packages with the same name, including through pointers, slices and maps.
+//   - Convert_<in>_To_<out> functions call them, unless some fields require a manual conversion, in which case
+//     they must be implemented by hand and the package does not compile until then.
+//   - stash<Kind> and restore<Kind> functions keep the fields of the hub version that the spoke version cannot
+//     represent in the dataAnnotation annotation, so that they survive a round trip through the spoke version.
+func (p ConversionPackages) GenerateConversions(kinds []ConversionKind, dataAnnotation string) (Conversions, error) {
+	g := &conversionGenerator{
+		ConversionPackages: p,
+		aliases:            make(map[*types.Package]string, len(kinds)),
+		queued:             make(map[string]bool),
+	}
+
+	type kindPair struct {
+		kind  ConversionKind
+		spoke *types.Named
+		hub   *types.Named
+	}
+	pairs := make([]kindPair, 0, len(kinds))
+	for _, kind := range kinds {
+		hub, found := p.Hubs[kind.HubPath]
+		if !found {
+			return Conversions{}, fmt.Errorf("hub package %q of %s not loaded", kind.HubPath, kind.Kind)
+		}
+		g.aliases[hub] = kind.HubAlias
+
+		spokeType, err := lookupStruct(p.Spoke, kind.Kind)
+		if err != nil {
+			return Conversions{}, err
+		}
+		hubType, err := lookupStruct(hub, kind.Kind)
+		if err != nil {
+			return Conversions{}, err
+		}
+		pairs = append(pairs, kindPair{kind: kind, spoke: spokeType, hub: hubType})
+	}
+
+	fmt.Fprintf(&g.out, "// conversionDataAnnotation is the annotation in which the hub versions are stashed\n"+
+		"const conversionDataAnnotation = %q\n", dataAnnotation)
+
+	for _, pair := range pairs {
+		g.convertFunc(conversionPair{in: pair.spoke, out: pair.hub})
+		g.convertFunc(conversionPair{in: pair.hub, out: pair.spoke})
+	}
+	for len(g.queue) > 0 {
+		pair := g.queue[0]
+		g.queue = g.queue[1:]
+		g.writeConversion(pair)
+	}
+
+	stashes := false
+	for _, pair := range pairs {
+		if g.writeStash(pair.kind.Kind, pair.hub, pair.spoke) {
+			stashes = true
+		}
+	}
+
+	return Conversions{Functions: g.out.String(), Stashes: stashes}, nil
+}
+
+// lookupStruct returns the struct type named name in pkg
+func lookupStruct(pkg *types.Package, name string) (*types.Named, error) {
+	obj := pkg.Scope().Lookup(name)
+	if obj == nil {
+		return nil, fmt.Errorf("type %s not found in package %q", name, pkg.Path())
+	}
+	named, ok := obj.Type().(*types.Named)
+	if !ok {
+		return nil, fmt.Errorf("%s of package %q is not a type", name, pkg.Path())
+	}
+	if _, ok = named.Underlying().(*types.Struct); !ok {
+		return nil, fmt.Errorf("type %s of package %q is not a struct", name, pkg.Path())
+	}
+	return named, nil
+}
+
+// conversionPair is a pair of struct types that are converted from in to out
+type conversionPair struct {
+	in, out *types.Named
+}
+
+type conversionGenerator struct {
+	ConversionPackages
+
+	// aliases are the import aliases of the hub packages
+	aliases map[*types.Package]string
+
+	// queue holds the pairs whose conversion functions are not written yet, and queued all the pairs queued so far
+	queue  []conversionPair
+	queued map[string]bool
+
+	out strings.Builder
+}
+
+// funcName returns the name of the conversion function of pair with the given prefix
+func funcName(prefix string, pair conversionPair) string {
+	return fmt.Sprintf("%s_%s_%s_To_%s_%s", prefix,
+		pair.in.Obj().Pkg().Name(), pair.in.Obj().Name(), pair.out.Obj().Pkg().Name(), pair.out.Obj().Name())
+}
+
+// convertFunc queues the conversion of pair and returns the name of its Convert_ function
+func (g *conversionGenerator) convertFunc(pair conversionPair) string {
+	name := funcName("Convert", pair)
+	if !g.queued[name] {
+		g.queued[name] = true
+		g.queue = append(g.queue, pair)
+	}
+	return name
+}
+
+// typeName returns the Go expression of t in the spoke package
+func (g *conversionGenerator) typeName(t types.Type) string {
+	return types.TypeString(t, func(pkg *types.Package) string {
+		if pkg == g.Spoke {
+			return ""
+		}
+		if alias, found := g.aliases[pkg]; found {
+			return alias
+		}
+		return pkg.Name()
+	})
+}
+
+// apiPair returns the pair of in and out when they are types with the same name of the spoke and a hub package
+func (g *conversionGenerator) apiPair(in, out types.Type) (conversionPair, bool) {
+	inNamed, inOK := types.Unalias(in).(*types.Named)
+	outNamed, outOK := types.Unalias(out).(*types.Named)
+	if !inOK || !outOK || inNamed.Obj().Name() != outNamed.Obj().Name() ||
+		inNamed.TypeArgs().Len() > 0 || outNamed.TypeArgs().Len() > 0 {
+		return conversionPair{}, false
+	}
+
+	inPkg, outPkg := inNamed.Obj().Pkg(), outNamed.Obj().Pkg()
+	if inPkg == outPkg || !g.isAPIPackage(inPkg) || !g.isAPIPackage(outPkg) {
+		return conversionPair{}, false
+	}
+	return conversionPair{in: inNamed, out: outNamed}, true
+}
+
+func (g *conversionGenerator) isAPIPackage(pkg *types.Package) bool {
+	_, isHub := g.aliases[pkg]
+	return pkg == g.Spoke || isHub
+}
+
+// value is a value of a field being converted and an expression of its address
+type value struct {
+	expr, addr string
+}
+
+// convertValue returns the statements converting the value in of type inType to out of type outType,
+// when they are identical, convertible types of the API packages, or structs of the API packages.
+func (g *conversionGenerator) convertValue(in, out value, inType, outType types.Type) ([]string, bool) {
+	if types.Identical(inType, outType) {
+		return []string{fmt.Sprintf("%s = %s", out.expr, in.expr)}, true
+	}
+
+	pair, ok := g.apiPair(inType, outType)
+	if !ok {
+		return nil, false
+	}
+	_, inStruct := pair.in.Underlying().(*types.Struct)
+	_, outStruct := pair.out.Underlying().(*types.Struct)
+	switch {
+	case inStruct && outStruct:
+		return []string{
+			fmt.Sprintf("if err := %s(%s, %s); err != nil {", g.convertFunc(pair), in.addr, out.addr),
+			"return err",
+			"}",
+		}, true
+	case !inStruct && !outStruct && types.Identical(pair.in.Underlying(), pair.out.Underlying()):
+		return []string{fmt.Sprintf("%s = %s(%s)", out.expr, g.typeName(outType), in.expr)}, true
+	default:
+		return nil, false
+	}
+}
+
+// convertField returns the statements converting the field name of in to the one of out,
+// or false when it requires a manual conversion
+func (g *conversionGenerator) convertField(name string, inType, outType types.Type) ([]string, bool) {
+	inField, outField := "in."+name, "out."+name
+	if stmts, ok := g.convertValue(value{inField, "&" + inField}, value{outField, "&" + outField},
+		inType, outType); ok {
+		return stmts, true
+	}
+
+	var allocate string
+	var elem []string
+	switch in := inType.(type) {
+	case *types.Pointer:
+		out, ok := outType.(*types.Pointer)
+		if !ok {
+			return nil, false
+		}
+		stmts, ok := g.convertValue(value{"*" + inField, inField}, value{"*" + outField, outField},
+			in.Elem(), out.Elem())
+		if !ok {
+			return nil, false
+		}
+		allocate = fmt.Sprintf("%s = new(%s)", outField, g.typeName(out.Elem()))
+		elem = stmts
+	case *types.Slice:
+		out, ok := outType.(*types.Slice)
+		if !ok {
+			return nil, false
+		}
+		stmts, ok := g.convertValue(value{inField + "[i]", "&" + inField + "[i]"},
+			value{outField + "[i]", "&" + outField + "[i]"}, in.Elem(), out.Elem())
+		if !ok {
+			return nil, false
+		}
+		allocate = fmt.Sprintf("%s = make(%s, len(%s))", outField, g.typeName(out), inField)
+		elem = append([]string{fmt.Sprintf("for i := range %s {", inField)}, stmts...)
+		elem = append(elem, "}")
+	case *types.Map:
+		out, ok := outType.(*types.Map)
+		if !ok {
+			return nil, false
+		}
+		if _, basic := in.Key().(*types.Basic); !basic || !types.Identical(in.Key(), out.Key()) {
+			return nil, false
+		}
+		stmts, ok := g.convertValue(value{"val", "&val"}, value{"converted", "&converted"}, in.Elem(), out.Elem())
+		if !ok {
+			return nil, false
+		}
+		allocate = fmt.Sprintf("%s = make(%s, len(%s))", outField, g.typeName(out), inField)
+		elem = append([]string{
+			fmt.Sprintf("for key, val := range %s {", inField),
+			fmt.Sprintf("var converted %s", g.typeName(out.Elem())),
+		}, stmts...)
+		elem = append(elem, fmt.Sprintf("%s[key] = converted", outField), "}")
+	default:
+		return nil, false
+	}
+
+	stmts := append([]string{fmt.Sprintf("if %s != nil {", inField), allocate}, elem...)
+	return append(stmts, "} else {", outField+" = nil", "}"), true
+}
+
+// isTypeMeta returns true for the embedded TypeMeta, which is set by the conversion webhook
+func isTypeMeta(field *types.Var) bool {
+	return field.Embedded() && field.Name() == "TypeMeta"
+}
+
+// structFields returns the fields of the struct t by name, except TypeMeta
+func structFields(t *types.Named) ([]*types.Var, map[string]*types.Var) {
+	st := t.Underlying().(*types.Struct)
+	fields := make([]*types.Var, 0, st.NumFields())
+	byName := make(map[string]*types.Var, st.NumFields())
+	for field := range st.Fields() {
+		if isTypeMeta(field) {
+			continue
+		}
+		fields = append(fields, field)
+		byName[field.Name()] = field
+	}
+	return fields, byName
+}
+
+// writeConversion writes the autoConvert_ function of pair, and its Convert_ function when all the fields
+// could be converted and it is not implemented by hand
+func (g *conversionGenerator) writeConversion(pair conversionPair) {
+	inFields, inByName := structFields(pair.in)
+	outFields, outByName := structFields(pair.out)
+	inName, outName := g.typeName(pair.in), g.typeName(pair.out)
+
+	var stmts []string
+	manual := false
+	for _, field := range inFields {
+		outField, found := outByName[field.Name()]
+		var conversion []string
+		var ok bool
+		switch {
+		case !found:
+			stmts = append(stmts, fmt.Sprintf("// WARNING: in.%s requires manual conversion: it does not exist in %s",
+				field.Name(), outName))
+		case !field.Exported():
+			stmts = append(stmts, fmt.Sprintf("// WARNING: in.%s requires manual conversion: it is not exported",
+				field.Name()))
+		default:
+			if conversion, ok = g.convertField(field.Name(), field.Type(), outField.Type()); ok {
+				stmts = append(stmts, conversion...)
+				continue
+			}
+			stmts = append(stmts, fmt.Sprintf(
+				"// WARNING: in.%s requires manual conversion: %s cannot be converted to %s",
+				field.Name(), g.typeName(field.Type()), g.typeName(outField.Type())))
+		}
+		manual = true
+	}
+	for _, field := range outFields {
+		if _, found := inByName[field.Name()]; !found {
+			stmts = append(stmts, fmt.Sprintf("// WARNING: out.%s requires manual conversion: it does not exist in %s",
+				field.Name(), inName))
+			manual = true
+		}
+	}
+
+	auto, convert := funcName("autoConvert", pair), funcName("Convert", pair)
+	signature := fmt.Sprintf("(in *%s, out *%s) error", inName, outName)
+
+	fmt.Fprintf(&g.out, "\n// %s copies the fields of %s that %s has as well.\n", auto, inName, outName)
+	fmt.Fprintf(&g.out, "func %s%s {\n", auto, signature)
+	for _, stmt := range stmts {
+		fmt.Fprintf(&g.out, "\t%s\n", stmt)
+	}
+	g.out.WriteString("\treturn nil\n}\n")
+
+	switch {
+	case g.ManualFunctions[convert]:
+		// Implemented by hand
+	case manual:
+		fmt.Fprintf(&g.out, "\n// TODO(user): Implement %s in a file of this package, converting the fields\n"+
+			"// flagged by %s and calling it for the others. The package does not compile until then.\n",
+			convert, auto)
+	default:
+		fmt.Fprintf(&g.out, "\n// %s converts %s to %s.\n", convert, inName, outName)
+		fmt.Fprintf(&g.out, "func %s%s {\n\treturn %s(in, out)\n}\n", convert, signature, auto)
+	}
+}
+
+// restoreFields returns the statements that restore the fields of the hub type that the spoke type does not have,
+// recursing into the nested structs of the API packages, directly or through pointers. The fields missing in the
+// elements of slices and maps are flagged to be restored by hand.
+func (g *conversionGenerator) restoreFields(hub, spoke *types.Named, path string) []string {
+	hubFields, _ := structFields(hub)
+	_, spokeByName := structFields(spoke)
+
+	var stmts []string
+	for _, field := range hubFields {
+		fieldPath := path + "." + field.Name()
+		spokeField, found := spokeByName[field.Name()]
+		if !found {
+			stmts = append(stmts, fmt.Sprintf("dst%s = stashed%s", fieldPath, fieldPath))
+			continue
+		}
+
+		switch hubType := field.Type().(type) {
+		case *types.Pointer:
+			spokeType, ok := spokeField.Type().(*types.Pointer)
+			if !ok {
+				continue
+			}
+			if nested := g.restoreNested(hubType.Elem(), spokeType.Elem(), fieldPath); len(nested) > 0 {
+				stmts = append(stmts, fmt.Sprintf("if dst%s != nil && stashed%s != nil {", fieldPath, fieldPath))
+				stmts = append(stmts, nested...)
+				stmts = append(stmts, "}")
+			}
+		case *types.Slice, *types.Map:
+			hubElem, spokeElem := containerElem(hubType), containerElem(spokeField.Type())
+			if spokeElem == nil {
+				continue
+			}
+			if len(g.restoreNested(hubElem, spokeElem, fieldPath+"[i]")) > 0 {
+				stmts = append(stmts, fmt.Sprintf(
+					"// WARNING: dst%s requires manual restoration: the fields of its elements that %s does not "+
+						"have are only in stashed%s", fieldPath, g.typeName(spokeElem), fieldPath))
+			}
+		default:
+			stmts = append(stmts, g.restoreNested(field.Type(), spokeField.Type(), fieldPath)...)
+		}
+	}
+	return stmts
+}
+
+// restoreNested returns the statements that restore the fields of the hub type that the spoke type does not have,
+// when both are structs of the API packages
+func (g *conversionGenerator) restoreNested(hub, spoke types.Type, path string) []string {
+	pair, ok := g.apiPair(hub, spoke)
+	if !ok {
+		return nil
+	}
+	_, hubStruct := pair.in.Underlying().(*types.Struct)
+	_, spokeStruct := pair.out.Underlying().(*types.Struct)
+	if !hubStruct || !spokeStruct {
+		return nil
+	}
+	return g.restoreFields(pair.in, pair.out, path)
+}
+
+// containerElem returns the type of the elements of the slice or map t, through a pointer,
+// or nil when t is neither
+func containerElem(t types.Type) types.Type {
+	var elem types.Type
+	switch container := t.(type) {
+	case *types.Slice:
+		elem = container.Elem()
+	case *types.Map:
+		elem = container.Elem()
+	default:
+		return nil
+	}
+	if pointer, ok := elem.(*types.Pointer); ok {
+		return pointer.Elem()
+	}
+	return elem
+}
+
+// writeStash writes the stash<Kind> and restore<Kind> functions of kind, and returns true
+// when the hub version has fields to stash
+func (g *conversionGenerator) writeStash(kind string, hub, spoke *types.Named) bool {
+	hubName := g.typeName(hub)
+	restore := g.restoreFields(hub, spoke, "")
+
+	fmt.Fprintf(&g.out, "\n// stash%[1]s stashes the hub version in an annotation of dst, so that restore%[1]s can\n"+
+		"// restore the fields that %[1]s cannot represent when it is converted back.\n", kind)
+	if len(restore) == 0 {
+		fmt.Fprintf(&g.out, "// %s has all the fields of the hub version, so there is nothing to stash.\n", kind)
+		fmt.Fprintf(&g.out, "func stash%s(*%s, *%s) error {\n\treturn nil\n}\n", kind, hubName, kind)
+	} else {
+		fmt.Fprintf(&g.out, `func stash%[1]s(src *%[2]s, dst *%[1]s) error {
+	stashed := *src
+	stashed.ObjectMeta = metav1.ObjectMeta{}
+	data, err := json.Marshal(&stashed)
+	if err != nil {
+		return fmt.Errorf("failed to stash the hub version of %%s/%%s: %%w", src.Namespace, src.Name, err)
+	}
+
+	dst.Annotations = maps.Clone(dst.Annotations)
+	if dst.Annotations == nil {
+		dst.Annotations = map[string]string{}
+	}
+	dst.Annotations[conversionDataAnnotation] = string(data)
+	return nil
+}
+`, kind, hubName)
+	}
+
+	fmt.Fprintf(&g.out, "\n// restore%[1]s restores the fields of the hub version that %[1]s cannot represent "+
+		"from the data\n// stashed by stash%[1]s, and removes the annotation it was stashed in.\n", kind)
+	fmt.Fprintf(&g.out, "func restore%s(src *%s, dst *%s) error {\n", kind, kind, hubName)
+	if len(restore) == 0 {
+		g.out.WriteString("\tif _, found := src.Annotations[conversionDataAnnotation]; !found {\n")
+	} else {
+		g.out.WriteString("\tdata, found := src.Annotations[conversionDataAnnotation]\n\tif !found {\n")
+	}
+	g.out.WriteString(`		return nil
+	}
+	dst.Annotations = maps.Clone(dst.Annotations)
+	delete(dst.Annotations, conversionDataAnnotation)
+	if len(dst.Annotations) == 0 {
+		dst.Annotations = nil
+	}
+`)
+	if len(restore) > 0 {
+		fmt.Fprintf(&g.out, `
+	stashed := &%s{}
+	if err := json.Unmarshal([]byte(data), stashed); err != nil {
+		return fmt.Errorf("failed to restore the hub version of %%s/%%s: %%w", src.Namespace, src.Name, err)
+	}
+`, hubName)
+		for _, stmt := range restore {
+			fmt.Fprintf(&g.out, "\t%s\n", stmt)
+		}
+	}
+	g.out.WriteString("\treturn nil\n}\n")
+
+	return len(restore) > 0
+}
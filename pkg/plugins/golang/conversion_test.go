@@ -0,0 +1,357 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package golang
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const (
+	metaSource = `package v1
+
+type TypeMeta struct{ Kind string }
+
+type ObjectMeta struct {
+	Name, Namespace string
+	Annotations     map[string]string
+}
+`
+
+	spokeSource = `package v1
+
+import metav1 "test.io/meta/v1"
+
+type Phase string
+
+type Member struct {
+	Name string
+}
+
+type CaptainSpec struct {
+	Size    int32
+	Crew    []Member
+	Deputy  *Member
+	Ranks   map[string]Member
+	Phase   Phase
+	Phases  []Phase
+	Tags    []string
+}
+
+type Captain struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+	Spec CaptainSpec
+}
+`
+)
+
+// checkPackage type-checks the sources as the package path, resolving the imports with packages
+func checkPackage(fset *token.FileSet, path string, packages map[string]*types.Package,
+	sources ...string,
+) (*types.Package, error) {
+	files := make([]*ast.File, 0, len(sources))
+	for _, source := range sources {
+		file, err := parser.ParseFile(fset, "", source, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+
+	std := importer.ForCompiler(fset, "source", nil)
+	cfg := types.Config{Importer: importerFunc(func(path string) (*types.Package, error) {
+		if pkg, found := packages[path]; found {
+			return pkg, nil
+		}
+		return std.Import(path)
+	})}
+	return cfg.Check(path, fset, files, nil)
+}
+
+type importerFunc func(path string) (*types.Package, error)
+
+func (f importerFunc) Import(path string) (*types.Package, error) { return f(path) }
+
+var _ = Describe("Conversion generation", func() {
+	const annotation = "crew.test.io/conversion-data"
+
+	var (
+		fset     *token.FileSet
+		packages map[string]*types.Package
+		kinds    []ConversionKind
+	)
+
+	// generate type-checks the hub and spoke sources and generates the conversion functions of Captain
+	generate := func(hubSource, spokeSource string, manual ...string) Conversions {
+		hub, err := checkPackage(fset, "test.io/crew/api/v2", packages,
+			strings.Replace(hubSource, "package v1", "package v2", 1))
+		Expect(err).NotTo(HaveOccurred())
+		packages[hub.Path()] = hub
+		spoke, err := checkPackage(fset, "test.io/crew/api/v1", packages, spokeSource)
+		Expect(err).NotTo(HaveOccurred())
+
+		pkgs := ConversionPackages{
+			Spoke:           spoke,
+			Hubs:            map[string]*types.Package{hub.Path(): hub},
+			ManualFunctions: make(map[string]bool),
+		}
+		for _, name := range manual {
+			pkgs.ManualFunctions[name] = true
+		}
+		conversions, err := pkgs.GenerateConversions(kinds, annotation)
+		Expect(err).NotTo(HaveOccurred())
+		return conversions
+	}
+
+	// compile type-checks the spoke package with the generated code
+	compile := func(code string, sources ...string) error {
+		generated := `package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+
+	metav1 "test.io/meta/v1"
+	crewv2 "test.io/crew/api/v2"
+)
+
+var _, _, _, _ = json.Marshal, fmt.Errorf, maps.Clone[map[string]string], metav1.ObjectMeta{}
+` + code
+		_, err := checkPackage(fset, "test.io/crew/api/v1", packages, append(sources, generated)...)
+		return err
+	}
+
+	BeforeEach(func() {
+		fset = token.NewFileSet()
+		meta, err := checkPackage(fset, "test.io/meta/v1", nil, metaSource)
+		Expect(err).NotTo(HaveOccurred())
+		packages = map[string]*types.Package{meta.Path(): meta}
+		kinds = []ConversionKind{{Kind: "Captain", HubPath: "test.io/crew/api/v2", HubAlias: "crewv2"}}
+	})
+
+	It("should convert the identical fields and recurse into the nested types", func() {
+		conversions := generate(spokeSource, spokeSource)
+		Expect(conversions.Stashes).To(BeFalse())
+		code := conversions.Functions
+
+		Expect(code).To(ContainSubstring(`const conversionDataAnnotation = "crew.test.io/conversion-data"`))
+		Expect(code).To(ContainSubstring(
+			"func Convert_v1_Captain_To_v2_Captain(in *Captain, out *crewv2.Captain) error {"))
+		Expect(code).To(ContainSubstring(
+			"func Convert_v2_Captain_To_v1_Captain(in *crewv2.Captain, out *Captain) error {"))
+		Expect(code).To(ContainSubstring("out.ObjectMeta = in.ObjectMeta"))
+		Expect(code).NotTo(ContainSubstring("TypeMeta"))
+		Expect(code).To(ContainSubstring("if err := Convert_v1_CaptainSpec_To_v2_CaptainSpec(&in.Spec, &out.Spec)"))
+		Expect(code).To(ContainSubstring("func Convert_v1_Member_To_v2_Member(in *Member, out *crewv2.Member) error {"))
+		Expect(code).To(ContainSubstring("out.Crew = make([]crewv2.Member, len(in.Crew))"))
+		Expect(code).To(ContainSubstring("out.Deputy = new(crewv2.Member)"))
+		Expect(code).To(ContainSubstring("out.Ranks = make(map[string]crewv2.Member, len(in.Ranks))"))
+		Expect(code).To(ContainSubstring("out.Phase = crewv2.Phase(in.Phase)"))
+		Expect(code).To(ContainSubstring("out.Phases[i] = crewv2.Phase(in.Phases[i])"))
+		Expect(code).To(ContainSubstring("out.Tags = in.Tags"))
+		Expect(code).NotTo(ContainSubstring("WARNING"))
+		Expect(code).NotTo(ContainSubstring("TODO"))
+		Expect(code).To(ContainSubstring("func stashCaptain(*crewv2.Captain, *Captain) error {"))
+
+		Expect(compile(code, spokeSource)).To(Succeed())
+	})
+
+	It("should flag the fields that do not map and stash the ones of the hub", func() {
+		hubSource := `package v1
+
+import metav1 "test.io/meta/v1"
+
+type CaptainSpec struct {
+	Size  int64
+	Rank  string
+}
+
+type Captain struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+	Spec CaptainSpec
+}
+`
+		spokeSource := `package v1
+
+import metav1 "test.io/meta/v1"
+
+type CaptainSpec struct {
+	Size   int32
+	Legacy bool
+}
+
+type Captain struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+	Spec CaptainSpec
+}
+`
+		conversions := generate(hubSource, spokeSource)
+		Expect(conversions.Stashes).To(BeTrue())
+		code := conversions.Functions
+
+		Expect(code).To(ContainSubstring(
+			"// WARNING: in.Size requires manual conversion: int32 cannot be converted to int64"))
+		Expect(code).To(ContainSubstring(
+			"// WARNING: in.Legacy requires manual conversion: it does not exist in crewv2.CaptainSpec"))
+		Expect(code).To(ContainSubstring(
+			"// WARNING: out.Rank requires manual conversion: it does not exist in CaptainSpec"))
+		Expect(code).To(ContainSubstring("// TODO(user): Implement Convert_v1_CaptainSpec_To_v2_CaptainSpec"))
+		Expect(code).NotTo(ContainSubstring("func Convert_v1_CaptainSpec_To_v2_CaptainSpec("))
+		Expect(code).To(ContainSubstring("func Convert_v1_Captain_To_v2_Captain("))
+		Expect(code).To(ContainSubstring("func stashCaptain(src *crewv2.Captain, dst *Captain) error {"))
+		Expect(code).To(ContainSubstring("dst.Spec.Rank = stashed.Spec.Rank"))
+
+		By("failing to compile until the conversions are implemented by hand")
+		err := compile(code, spokeSource)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("undefined: Convert_v1_CaptainSpec_To_v2_CaptainSpec"))
+
+		manual := `package v1
+
+import crewv2 "test.io/crew/api/v2"
+
+func Convert_v1_CaptainSpec_To_v2_CaptainSpec(in *CaptainSpec, out *crewv2.CaptainSpec) error {
+	out.Size = int64(in.Size)
+	return autoConvert_v1_CaptainSpec_To_v2_CaptainSpec(in, out)
+}
+
+func Convert_v2_CaptainSpec_To_v1_CaptainSpec(in *crewv2.CaptainSpec, out *CaptainSpec) error {
+	out.Size = int32(in.Size)
+	return autoConvert_v2_CaptainSpec_To_v1_CaptainSpec(in, out)
+}
+`
+		code = generate(hubSource, spokeSource,
+			"Convert_v1_CaptainSpec_To_v2_CaptainSpec", "Convert_v2_CaptainSpec_To_v1_CaptainSpec").Functions
+		Expect(code).NotTo(ContainSubstring("TODO"))
+		Expect(compile(code, spokeSource, manual)).To(Succeed())
+	})
+
+	It("should restore the hub fields nested under pointers and flag the ones under slices and maps", func() {
+		hubSource := `package v1
+
+import metav1 "test.io/meta/v1"
+
+type Member struct {
+	Name string
+	Rank string
+}
+
+type CaptainTemplate struct {
+	Image    string
+	Replicas int32
+}
+
+type CaptainSpec struct {
+	Template *CaptainTemplate
+	Crew     []Member
+	Ranks    map[string]Member
+}
+
+type Captain struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+	Spec CaptainSpec
+}
+`
+		spokeSource := `package v1
+
+import metav1 "test.io/meta/v1"
+
+type Member struct {
+	Name string
+}
+
+type CaptainTemplate struct {
+	Image string
+}
+
+type CaptainSpec struct {
+	Template *CaptainTemplate
+	Crew     []Member
+	Ranks    map[string]Member
+}
+
+type Captain struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+	Spec CaptainSpec
+}
+`
+		manual := `package v1
+
+import crewv2 "test.io/crew/api/v2"
+
+func Convert_v1_Member_To_v2_Member(in *Member, out *crewv2.Member) error {
+	return autoConvert_v1_Member_To_v2_Member(in, out)
+}
+
+func Convert_v2_Member_To_v1_Member(in *crewv2.Member, out *Member) error {
+	return autoConvert_v2_Member_To_v1_Member(in, out)
+}
+
+func Convert_v1_CaptainTemplate_To_v2_CaptainTemplate(in *CaptainTemplate, out *crewv2.CaptainTemplate) error {
+	return autoConvert_v1_CaptainTemplate_To_v2_CaptainTemplate(in, out)
+}
+
+func Convert_v2_CaptainTemplate_To_v1_CaptainTemplate(in *crewv2.CaptainTemplate, out *CaptainTemplate) error {
+	return autoConvert_v2_CaptainTemplate_To_v1_CaptainTemplate(in, out)
+}
+`
+		conversions := generate(hubSource, spokeSource,
+			"Convert_v1_Member_To_v2_Member", "Convert_v2_Member_To_v1_Member",
+			"Convert_v1_CaptainTemplate_To_v2_CaptainTemplate", "Convert_v2_CaptainTemplate_To_v1_CaptainTemplate")
+		Expect(conversions.Stashes).To(BeTrue())
+		code := conversions.Functions
+
+		Expect(code).To(ContainSubstring("\tif dst.Spec.Template != nil && stashed.Spec.Template != nil {\n" +
+			"\tdst.Spec.Template.Replicas = stashed.Spec.Template.Replicas\n\t}\n"))
+		Expect(code).To(ContainSubstring("// WARNING: dst.Spec.Crew requires manual restoration: " +
+			"the fields of its elements that Member does not have are only in stashed.Spec.Crew"))
+		Expect(code).To(ContainSubstring("// WARNING: dst.Spec.Ranks requires manual restoration"))
+		Expect(compile(code, spokeSource, manual)).To(Succeed())
+	})
+
+	It("should not generate the conversions implemented by hand", func() {
+		code := generate(spokeSource, spokeSource, "Convert_v1_Member_To_v2_Member").Functions
+
+		Expect(code).To(ContainSubstring("func autoConvert_v1_Member_To_v2_Member("))
+		Expect(code).NotTo(ContainSubstring("func Convert_v1_Member_To_v2_Member("))
+		Expect(code).To(ContainSubstring("func Convert_v2_Member_To_v1_Member("))
+	})
+
+	It("should fail when the kind does not exist in a version", func() {
+		hub, err := checkPackage(fset, "test.io/crew/api/v2", packages, "package v2\n")
+		Expect(err).NotTo(HaveOccurred())
+		spoke, err := checkPackage(fset, "test.io/crew/api/v1", packages, spokeSource)
+		Expect(err).NotTo(HaveOccurred())
+
+		pkgs := ConversionPackages{Spoke: spoke, Hubs: map[string]*types.Package{hub.Path(): hub}}
+		_, err = pkgs.GenerateConversions(kinds, annotation)
+		Expect(err).To(MatchError(ContainSubstring(`type Captain not found in package "test.io/crew/api/v2"`)))
+	})
+})
@@ -0,0 +1,346 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package golang
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+)
+
+const (
+	crdAPIVersion = "apiextensions.k8s.io/v1"
+	crdKind       = "CustomResourceDefinition"
+)
+
+// yamlDocumentSeparator splits the documents of a YAML stream
+var yamlDocumentSeparator = regexp.MustCompile(`(?m)^---[ \t]*$`)
+
+// CRD is the subset of a CustomResourceDefinition that APIs are created from.
+type CRD struct {
+	APIVersion string  `json:"apiVersion"`
+	Kind       string  `json:"kind"`
+	Spec       CRDSpec `json:"spec"`
+}
+
+// CRDSpec is the specification of a CRD.
+type CRDSpec struct {
+	Group      string         `json:"group"`
+	Names      CRDNames       `json:"names"`
+	Scope      string         `json:"scope"`
+	Versions   []CRDVersion   `json:"versions"`
+	Conversion *CRDConversion `json:"conversion,omitempty"`
+}
+
+// CRDNames are the names of the resource defined by a CRD.
+type CRDNames struct {
+	Kind       string   `json:"kind"`
+	Plural     string   `json:"plural"`
+	ShortNames []string `json:"shortNames,omitempty"`
+	Categories []string `json:"categories,omitempty"`
+}
+
+// CRDConversion describes how the versions of a CRD are converted.
+type CRDConversion struct {
+	Strategy string `json:"strategy"`
+}
+
+// CRDVersion is a version of the resource defined by a CRD.
+type CRDVersion struct {
+	Name                     string               `json:"name"`
+	Served                   bool                 `json:"served"`
+	Storage                  bool                 `json:"storage"`
+	Deprecated               bool                 `json:"deprecated,omitempty"`
+	DeprecationWarning       *string              `json:"deprecationWarning,omitempty"`
+	Schema                   *CRDValidation       `json:"schema,omitempty"`
+	Subresources             *CRDSubresources     `json:"subresources,omitempty"`
+	AdditionalPrinterColumns []CRDPrinterColumn   `json:"additionalPrinterColumns,omitempty"`
+	SelectableFields         []CRDSelectableField `json:"selectableFields,omitempty"`
+}
+
+// CRDValidation holds the schema of a version of a CRD.
+type CRDValidation struct {
+	OpenAPIV3Schema *JSONSchemaProps `json:"openAPIV3Schema,omitempty"`
+}
+
+// CRDSubresources are the subresources of a version of a CRD.
+type CRDSubresources struct {
+	Status *struct{}            `json:"status,omitempty"`
+	Scale  *CRDScaleSubresource `json:"scale,omitempty"`
+}
+
+// CRDScaleSubresource describes the scale subresource of a version of a CRD.
+type CRDScaleSubresource struct {
+	SpecReplicasPath   string `json:"specReplicasPath"`
+	StatusReplicasPath string `json:"statusReplicasPath"`
+	LabelSelectorPath  string `json:"labelSelectorPath,omitempty"`
+}
+
+// CRDPrinterColumn is an additional printer column of a version of a CRD.
+type CRDPrinterColumn struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	JSONPath string `json:"jsonPath"`
+}
+
+// CRDSelectableField is a field of a version of a CRD that can be used in field selectors.
+type CRDSelectableField struct {
+	JSONPath string `json:"jsonPath"`
+}
+
+// JSONSchemaProps is the subset of an OpenAPI v3 schema that the Go types are generated from.
+type JSONSchemaProps struct {
+	Description          string                     `json:"description,omitempty"`
+	Type                 string                     `json:"type,omitempty"`
+	Format               string                     `json:"format,omitempty"`
+	Default              any                        `json:"default,omitempty"`
+	Enum                 []any                      `json:"enum,omitempty"`
+	Pattern              string                     `json:"pattern,omitempty"`
+	Minimum              *float64                   `json:"minimum,omitempty"`
+	Maximum              *float64                   `json:"maximum,omitempty"`
+	ExclusiveMinimum     bool                       `json:"exclusiveMinimum,omitempty"`
+	ExclusiveMaximum     bool                       `json:"exclusiveMaximum,omitempty"`
+	MultipleOf           *float64                   `json:"multipleOf,omitempty"`
+	MinLength            *int64                     `json:"minLength,omitempty"`
+	MaxLength            *int64                     `json:"maxLength,omitempty"`
+	MinItems             *int64                     `json:"minItems,omitempty"`
+	MaxItems             *int64                     `json:"maxItems,omitempty"`
+	UniqueItems          bool                       `json:"uniqueItems,omitempty"`
+	MinProperties        *int64                     `json:"minProperties,omitempty"`
+	MaxProperties        *int64                     `json:"maxProperties,omitempty"`
+	Required             []string                   `json:"required,omitempty"`
+	Nullable             bool                       `json:"nullable,omitempty"`
+	Items                *JSONSchemaProps           `json:"items,omitempty"`
+	Properties           map[string]JSONSchemaProps `json:"properties,omitempty"`
+	AdditionalProperties *AdditionalProperties      `json:"additionalProperties,omitempty"`
+	AllOf                []JSONSchemaProps          `json:"allOf,omitempty"`
+	OneOf                []JSONSchemaProps          `json:"oneOf,omitempty"`
+	AnyOf                []JSONSchemaProps          `json:"anyOf,omitempty"`
+	Not                  *JSONSchemaProps           `json:"not,omitempty"`
+
+	XPreserveUnknownFields bool             `json:"x-kubernetes-preserve-unknown-fields,omitempty"`
+	XEmbeddedResource      bool             `json:"x-kubernetes-embedded-resource,omitempty"`
+	XIntOrString           bool             `json:"x-kubernetes-int-or-string,omitempty"`
+	XListType              string           `json:"x-kubernetes-list-type,omitempty"`
+	XListMapKeys           []string         `json:"x-kubernetes-list-map-keys,omitempty"`
+	XMapType               string           `json:"x-kubernetes-map-type,omitempty"`
+	XValidations           []ValidationRule `json:"x-kubernetes-validations,omitempty"`
+}
+
+// AdditionalProperties is the schema of the values of a map, which may also be a boolean.
+type AdditionalProperties struct {
+	Schema *JSONSchemaProps
+}
+
+// UnmarshalJSON implements json.Unmarshaler, ignoring the boolean values.
+func (a *AdditionalProperties) UnmarshalJSON(data []byte) error {
+	if string(data) == "true" || string(data) == "false" {
+		return nil
+	}
+	a.Schema = &JSONSchemaProps{}
+	if err := json.Unmarshal(data, a.Schema); err != nil {
+		return fmt.Errorf("invalid additionalProperties: %w", err)
+	}
+	return nil
+}
+
+// ValidationRule is a CEL validation rule of a schema.
+type ValidationRule struct {
+	Rule              string `json:"rule"`
+	Message           string `json:"message,omitempty"`
+	MessageExpression string `json:"messageExpression,omitempty"`
+	Reason            string `json:"reason,omitempty"`
+	FieldPath         string `json:"fieldPath,omitempty"`
+	OptionalOldSelf   bool   `json:"optionalOldSelf,omitempty"`
+}
+
+// LoadCRD reads the CRD from the manifest found at path, which may hold other objects.
+func LoadCRD(path string) (*CRD, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the CRD: %w", err)
+	}
+
+	crd, err := ParseCRD(content)
+	if err != nil {
+		return nil, fmt.Errorf("invalid CRD %q: %w", path, err)
+	}
+	return crd, nil
+}
+
+// ParseCRD parses the CRD found in the YAML manifest, which must hold exactly one.
+func ParseCRD(content []byte) (*CRD, error) {
+	var crds []*CRD
+	for _, document := range yamlDocumentSeparator.Split(string(content), -1) {
+		if strings.TrimSpace(document) == "" {
+			continue
+		}
+
+		var object struct {
+			APIVersion string `json:"apiVersion"`
+			Kind       string `json:"kind"`
+		}
+		if err := yaml.Unmarshal([]byte(document), &object); err != nil {
+			return nil, fmt.Errorf("failed to parse the manifest: %w", err)
+		}
+		if object.Kind != crdKind {
+			continue
+		}
+		if object.APIVersion != crdAPIVersion {
+			return nil, fmt.Errorf("unsupported CRD version %q, must be %s", object.APIVersion, crdAPIVersion)
+		}
+
+		crd := &CRD{}
+		if err := yaml.Unmarshal([]byte(document), crd); err != nil {
+			return nil, fmt.Errorf("failed to parse the CRD: %w", err)
+		}
+		crds = append(crds, crd)
+	}
+
+	switch len(crds) {
+	case 0:
+		return nil, errors.New("no CustomResourceDefinition found")
+	case 1:
+	default:
+		return nil, fmt.Errorf("found %d CustomResourceDefinitions, the manifest must hold only one", len(crds))
+	}
+
+	crd := crds[0]
+	if err := crd.validate(); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
+// validate checks that the CRD defines the fields that the APIs are created from.
+func (crd CRD) validate() error {
+	if crd.Spec.Group == "" || crd.Spec.Names.Kind == "" || crd.Spec.Names.Plural == "" {
+		return errors.New("spec.group, spec.names.kind and spec.names.plural are required")
+	}
+
+	served := crd.ServedVersions()
+	if len(served) == 0 {
+		return errors.New("the CRD has no served version")
+	}
+	for _, version := range served {
+		if version.Schema == nil || version.Schema.OpenAPIV3Schema == nil {
+			return fmt.Errorf("version %q has no OpenAPI v3 schema", version.Name)
+		}
+	}
+	return nil
+}
+
+// ServedVersions returns the versions of the CRD that are served.
+func (crd CRD) ServedVersions() []CRDVersion {
+	var versions []CRDVersion
+	for _, version := range crd.Spec.Versions {
+		if version.Served {
+			versions = append(versions, version)
+		}
+	}
+	return versions
+}
+
+// ServedVersion returns the served version of the CRD with the provided name.
+func (crd CRD) ServedVersion(name string) (CRDVersion, bool) {
+	for _, version := range crd.ServedVersions() {
+		if version.Name == name {
+			return version, true
+		}
+	}
+	return CRDVersion{}, false
+}
+
+// DefaultVersion returns the version that the controller is scaffolded for:
+// the storage version when it is served, otherwise the first served version.
+func (crd CRD) DefaultVersion() string {
+	served := crd.ServedVersions()
+	for _, version := range served {
+		if version.Storage {
+			return version.Name
+		}
+	}
+	return served[0].Name
+}
+
+// UsesConversionWebhook returns true if the versions of the CRD are converted by a webhook.
+func (crd CRD) UsesConversionWebhook() bool {
+	return crd.Spec.Conversion != nil && crd.Spec.Conversion.Strategy == "Webhook"
+}
+
+// SplitGroup splits the group of the CRD into the group and the domain of the resources, using the domain of
+// the project when the group belongs to it and the part after the first dot otherwise.
+func (crd CRD) SplitGroup(domain string) (group, resourceDomain string) {
+	qualifiedGroup := crd.Spec.Group
+	switch {
+	case domain != "" && qualifiedGroup == domain:
+		return "", domain
+	case domain != "" && strings.HasSuffix(qualifiedGroup, "."+domain):
+		return strings.TrimSuffix(qualifiedGroup, "."+domain), domain
+	}
+	group, resourceDomain, _ = strings.Cut(qualifiedGroup, ".")
+	return group, resourceDomain
+}
+
+// Resources returns the resources of the served versions of the CRD in the project. The storage version is
+// only flagged when several versions are served, as single version APIs are not marked either.
+func (crd CRD) Resources(c config.Config) []resource.Resource {
+	group, domain := crd.SplitGroup(c.GetDomain())
+	served := crd.ServedVersions()
+
+	resources := make([]resource.Resource, 0, len(served))
+	for _, version := range served {
+		res := resource.Resource{
+			GVK: resource.GVK{
+				Group:   group,
+				Domain:  domain,
+				Version: version.Name,
+				Kind:    crd.Spec.Names.Kind,
+			},
+			Plural: crd.Spec.Names.Plural,
+			Path:   resource.APIPackagePath(c.GetRepository(), group, version.Name, c.IsMultiGroup()),
+			API: &resource.API{
+				CRDVersion:     "v1",
+				Namespaced:     crd.Spec.Scope != "Cluster",
+				ShortNames:     crd.Spec.Names.ShortNames,
+				Categories:     crd.Spec.Names.Categories,
+				PrintColumns:   version.printColumns(),
+				StorageVersion: version.Storage && len(served) > 1,
+			},
+			Webhooks: &resource.Webhooks{},
+		}
+		resources = append(resources, res)
+	}
+	return resources
+}
+
+// printColumns returns the additional printer columns of the version.
+func (v CRDVersion) printColumns() []resource.PrintColumn {
+	var columns []resource.PrintColumn
+	for _, column := range v.AdditionalPrinterColumns {
+		columns = append(columns, resource.PrintColumn{Name: column.Name, Type: column.Type, JSONPath: column.JSONPath})
+	}
+	return columns
+}
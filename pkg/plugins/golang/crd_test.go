@@ -0,0 +1,128 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package golang
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	cfgv3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+)
+
+const frigateCRD = `apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: frigates.ship.example.com
+spec:
+  group: ship.example.com
+  names:
+    kind: Frigate
+    plural: frigates
+    shortNames: [fg]
+  scope: Cluster
+  versions:
+  - name: v1beta1
+    served: true
+    storage: false
+    schema:
+      openAPIV3Schema:
+        type: object
+  - name: v1
+    served: true
+    storage: true
+    additionalPrinterColumns:
+    - name: Ready
+      type: boolean
+      jsonPath: .status.ready
+    schema:
+      openAPIV3Schema:
+        type: object
+  - name: v1alpha1
+    served: false
+    storage: false
+`
+
+var _ = Describe("CRD", func() {
+	Context("ParseCRD", func() {
+		It("should parse the CRD among the other objects of the manifest", func() {
+			crd, err := ParseCRD([]byte("apiVersion: v1\nkind: Namespace\nmetadata:\n  name: ship\n---\n" + frigateCRD))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(crd.Spec.Names.Kind).To(Equal("Frigate"))
+			Expect(crd.ServedVersions()).To(HaveLen(2))
+			Expect(crd.DefaultVersion()).To(Equal("v1"))
+		})
+
+		DescribeTable("should fail for invalid manifests",
+			func(manifest, message string) {
+				_, err := ParseCRD([]byte(manifest))
+				Expect(err).To(MatchError(ContainSubstring(message)))
+			},
+			Entry("no CRD", "apiVersion: v1\nkind: Namespace\n", "no CustomResourceDefinition found"),
+			Entry("several CRDs", frigateCRD+"---\n"+frigateCRD, "found 2 CustomResourceDefinitions"),
+			Entry("unsupported version", "apiVersion: apiextensions.k8s.io/v1beta1\nkind: CustomResourceDefinition\n",
+				"unsupported CRD version"),
+			Entry("missing names", "apiVersion: apiextensions.k8s.io/v1\nkind: CustomResourceDefinition\n"+
+				"spec:\n  group: ship.example.com\n", "spec.names.kind and spec.names.plural are required"),
+			Entry("version without schema", "apiVersion: apiextensions.k8s.io/v1\nkind: CustomResourceDefinition\n"+
+				"spec:\n  group: ship.example.com\n  names: {kind: Frigate, plural: frigates}\n"+
+				"  versions:\n  - {name: v1, served: true, storage: true}\n", `version "v1" has no OpenAPI v3 schema`),
+		)
+	})
+
+	Context("SplitGroup", func() {
+		DescribeTable("should split the group of the CRD",
+			func(qualifiedGroup, projectDomain, group, domain string) {
+				crd := CRD{Spec: CRDSpec{Group: qualifiedGroup}}
+				actualGroup, actualDomain := crd.SplitGroup(projectDomain)
+				Expect(actualGroup).To(Equal(group))
+				Expect(actualDomain).To(Equal(domain))
+			},
+			Entry("in the project domain", "ship.example.com", "example.com", "ship", "example.com"),
+			Entry("equal to the project domain", "example.com", "example.com", "", "example.com"),
+			Entry("in another domain", "ship.other.io", "example.com", "ship", "other.io"),
+			Entry("without domain", "ship", "example.com", "ship", ""),
+		)
+	})
+
+	Context("Resources", func() {
+		It("should return the resources of the served versions", func() {
+			crd, err := ParseCRD([]byte(frigateCRD))
+			Expect(err).NotTo(HaveOccurred())
+
+			cfg := cfgv3.New()
+			Expect(cfg.SetDomain("example.com")).To(Succeed())
+			Expect(cfg.SetRepository("github.com/example/ship")).To(Succeed())
+
+			resources := crd.Resources(cfg)
+			Expect(resources).To(HaveLen(2))
+			Expect(resources[1].GVK).To(Equal(resource.GVK{
+				Group: "ship", Domain: "example.com", Version: "v1", Kind: "Frigate",
+			}))
+			Expect(resources[1].Plural).To(Equal("frigates"))
+			Expect(resources[1].Path).To(Equal("github.com/example/ship/api/v1"))
+			Expect(resources[1].API.Namespaced).To(BeFalse())
+			Expect(resources[1].API.ShortNames).To(Equal([]string{"fg"}))
+			Expect(resources[1].API.StorageVersion).To(BeTrue())
+			Expect(resources[1].API.PrintColumns).To(Equal([]resource.PrintColumn{
+				{Name: "Ready", JSONPath: ".status.ready", Type: "boolean"},
+			}))
+			Expect(resources[0].API.StorageVersion).To(BeFalse())
+			Expect(resources[0].API.PrintColumns).To(BeEmpty())
+		})
+	})
+})
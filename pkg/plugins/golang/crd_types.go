@@ -0,0 +1,531 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package golang
+
+import (
+	"fmt"
+	log "log/slog"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gobuffalo/flect"
+)
+
+const (
+	intstrImport          = `"k8s.io/apimachinery/pkg/util/intstr"`
+	runtimeImport         = `"k8s.io/apimachinery/pkg/runtime"`
+	apiextensionsv1Import = `apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"`
+)
+
+var (
+	// nonIdentifierRegex matches the characters of the property names that cannot be used in Go identifiers
+	nonIdentifierRegex = regexp.MustCompile(`[^a-zA-Z0-9]+`)
+	// bareMarkerValueRegex matches the strings that can be written without quotes in the marker values
+	bareMarkerValueRegex = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_-]*$`)
+)
+
+// conditionProperties are the properties of the metav1.Condition schema
+var conditionProperties = []string{"lastTransitionTime", "message", "observedGeneration", "reason", "status", "type"}
+
+// SchemaTypes are the Go types generated from the OpenAPI v3 schema of a version of a CRD.
+type SchemaTypes struct {
+	// Description documents the root type
+	Description []string
+	// Markers are the markers of the root type besides the ones of the resource, without the comment prefix
+	Markers []string
+	// Fields are the fields of the root type besides its type and object metadata
+	Fields []SchemaField
+	// Types are the types of the objects and of the validated elements of the schema
+	Types []SchemaType
+	// Imports are the imports required by the types besides metav1
+	Imports []string
+}
+
+// SchemaType is a Go type generated from a schema.
+type SchemaType struct {
+	// Name is the name of the type
+	Name string
+	// Description documents the type with the description of its schema, as the fields using it
+	Description []string
+	// Markers are the markers of the type, without the comment prefix
+	Markers []string
+	// Underlying is the underlying type of the scalar types, empty for the structs
+	Underlying string
+	// Fields are the fields of the structs
+	Fields []SchemaField
+}
+
+// SchemaField is a field of a Go struct generated from a schema.
+type SchemaField struct {
+	// Description documents the field
+	Description []string
+	// Markers are the markers of the field, without the comment prefix
+	Markers []string
+	// GoName, GoType and JSONTag are the name, type and value of the json tag of the field
+	GoName  string
+	GoType  string
+	JSONTag string
+}
+
+// GenerateTypes generates the Go types of the kind from the schema of the version of its CRD,
+// with the kubebuilder markers that produce the same schema.
+func GenerateTypes(kind string, version CRDVersion) (*SchemaTypes, error) {
+	g := &typesGenerator{names: map[string]bool{kind: true, kind + "List": true}}
+	schema := *version.Schema.OpenAPIV3Schema
+
+	types := &SchemaTypes{Description: descriptionLines(schema.Description)}
+
+	for _, name := range slices.Sorted(maps.Keys(schema.Properties)) {
+		switch name {
+		case "apiVersion", "kind":
+			continue
+		case "metadata":
+			if len(schema.Properties[name].Properties) != 0 {
+				log.Warn("the schema of the metadata is not generated, validate the object metadata with "+
+					"the validation rules of the kind instead", "kind", kind, "version", version.Name)
+			}
+			continue
+		}
+
+		field, err := g.field(kind, name, schema.Properties[name], slices.Contains(schema.Required, name))
+		if err != nil {
+			return nil, fmt.Errorf("invalid schema of version %q: %w", version.Name, err)
+		}
+		types.Fields = append(types.Fields, field)
+	}
+
+	types.Markers = append(types.Markers, version.markers()...)
+	for _, rule := range schema.XValidations {
+		types.Markers = append(types.Markers, "+kubebuilder:validation:XValidation:"+rule.markerArgs())
+	}
+
+	types.Types = g.types
+	types.Imports = g.imports
+	if g.floats {
+		log.Warn("the schema has floating-point numbers, allow them in the CRDs with the "+
+			"crd:allowDangerousTypes=true option of controller-gen in the manifests target of the Makefile",
+			"kind", kind, "version", version.Name)
+	}
+	return types, nil
+}
+
+// markers returns the markers of the root type that are defined by the version rather than by its schema.
+func (v CRDVersion) markers() []string {
+	var markers []string
+	if v.Subresources != nil && v.Subresources.Status != nil {
+		markers = append(markers, "+kubebuilder:subresource:status")
+	}
+	if v.Subresources != nil && v.Subresources.Scale != nil {
+		scale := v.Subresources.Scale
+		args := fmt.Sprintf("specpath=%s,statuspath=%s", scale.SpecReplicasPath, scale.StatusReplicasPath)
+		if scale.LabelSelectorPath != "" {
+			args += ",selectorpath=" + scale.LabelSelectorPath
+		}
+		markers = append(markers, "+kubebuilder:subresource:scale:"+args)
+	}
+	if v.Deprecated {
+		marker := "+kubebuilder:deprecatedversion"
+		if v.DeprecationWarning != nil {
+			marker += ":warning=" + strconv.Quote(*v.DeprecationWarning)
+		}
+		markers = append(markers, marker)
+	}
+	for _, field := range v.SelectableFields {
+		markers = append(markers, "+kubebuilder:selectablefield:JSONPath="+strconv.Quote(field.JSONPath))
+	}
+	return markers
+}
+
+// typesGenerator generates the types of a schema, keeping track of their names and imports.
+type typesGenerator struct {
+	types   []SchemaType
+	names   map[string]bool
+	imports []string
+	floats  bool
+}
+
+// field generates the field of the parent type for the property, and the types of its value.
+func (g *typesGenerator) field(parent, name string, schema JSONSchemaProps, required bool) (SchemaField, error) {
+	goName := goIdentifier(name)
+	goType, err := g.goType(parent+goName, schema)
+	if err != nil {
+		return SchemaField{}, fmt.Errorf("property %q: %w", name, err)
+	}
+
+	// The int-or-string values are described as any of an integer or a string
+	if len(schema.AllOf) != 0 || len(schema.OneOf) != 0 || (len(schema.AnyOf) != 0 && !schema.XIntOrString) ||
+		schema.Not != nil {
+		log.Warn("allOf, oneOf, anyOf and not are not generated, express them with validation rules instead",
+			"type", parent, "property", name)
+	}
+
+	field := SchemaField{
+		Description: descriptionLines(schema.Description),
+		GoName:      goName,
+		GoType:      goType,
+		JSONTag:     name,
+	}
+
+	field.Markers = append(field.Markers, validationMarkers(schema, "+kubebuilder:validation:")...)
+	field.Markers = append(field.Markers, collectionMarkers(schema)...)
+	// The unknown fields of the other types are preserved by controller-gen
+	if schema.XPreserveUnknownFields && len(schema.Properties) != 0 {
+		field.Markers = append(field.Markers, "+kubebuilder:pruning:PreserveUnknownFields")
+	}
+	if schema.XEmbeddedResource {
+		field.Markers = append(field.Markers, "+kubebuilder:validation:EmbeddedResource")
+	}
+	if schema.Nullable {
+		field.Markers = append(field.Markers, "+nullable")
+	}
+	if schema.Default != nil {
+		field.Markers = append(field.Markers, "+kubebuilder:default="+markerValue(schema.Default, true))
+	}
+
+	// Optional scalars are pointers, as Field does, and optional structs are omitted when they are zero
+	switch {
+	case required:
+		field.Markers = append(field.Markers, "+required")
+	case strings.HasPrefix(goType, "[]") || strings.HasPrefix(goType, "map["):
+		field.Markers = append(field.Markers, "+optional")
+		field.JSONTag += ",omitempty"
+	case g.isStruct(goType) && !schema.Nullable:
+		field.Markers = append(field.Markers, "+optional")
+		field.JSONTag += ",omitzero"
+	default:
+		field.Markers = append(field.Markers, "+optional")
+		field.GoType = "*" + goType
+		field.JSONTag += ",omitempty"
+	}
+
+	return field, nil
+}
+
+// goType returns the Go type of the schema, generating the named types that it requires.
+func (g *typesGenerator) goType(name string, schema JSONSchemaProps) (string, error) {
+	switch {
+	case schema.XIntOrString:
+		g.addImport(intstrImport)
+		return "intstr.IntOrString", nil
+	case schema.XEmbeddedResource:
+		g.addImport(runtimeImport)
+		return "runtime.RawExtension", nil
+	}
+
+	switch schema.Type {
+	case "string":
+		switch schema.Format {
+		case "date-time":
+			return "metav1.Time", nil
+		case "byte":
+			return "[]byte", nil
+		}
+		return "string", nil
+	case "integer":
+		if schema.Format == "int32" {
+			return "int32", nil
+		}
+		return "int64", nil
+	case "number":
+		g.floats = true
+		return "float64", nil
+	case "boolean":
+		return "bool", nil
+	case "array":
+		if schema.Items == nil {
+			return "", fmt.Errorf("the items of the array have no schema")
+		}
+		if isConditionSchema(*schema.Items) {
+			return "[]metav1.Condition", nil
+		}
+		elem, err := g.elemType(flect.Singularize(name), *schema.Items)
+		if err != nil {
+			return "", err
+		}
+		return "[]" + elem, nil
+	case "object":
+		if len(schema.Properties) != 0 {
+			return g.structType(name, schema)
+		}
+		if schema.AdditionalProperties != nil && schema.AdditionalProperties.Schema != nil {
+			elem, err := g.elemType(flect.Singularize(name), *schema.AdditionalProperties.Schema)
+			if err != nil {
+				return "", err
+			}
+			return "map[string]" + elem, nil
+		}
+		if schema.XPreserveUnknownFields {
+			g.addImport(runtimeImport)
+			return "runtime.RawExtension", nil
+		}
+		return g.structType(name, schema)
+	case "":
+		if schema.XPreserveUnknownFields {
+			g.addImport(apiextensionsv1Import)
+			return "apiextensionsv1.JSON", nil
+		}
+	}
+	return "", fmt.Errorf("unsupported type %q", schema.Type)
+}
+
+// elemType returns the Go type of the elements of an array or a map. As the markers of the fields only
+// validate their own value, the elements that are validated are given a named type holding their markers.
+func (g *typesGenerator) elemType(name string, schema JSONSchemaProps) (string, error) {
+	goType, err := g.goType(name, schema)
+	if err != nil {
+		return "", err
+	}
+
+	markers := validationMarkers(schema, "+kubebuilder:validation:")
+	if schema.Nullable {
+		markers = append(markers, "+nullable")
+	}
+	if len(markers) == 0 || g.isStruct(goType) {
+		if g.isStruct(goType) {
+			g.typeNamed(goType).Markers = append(g.typeNamed(goType).Markers, markers...)
+		}
+		return goType, nil
+	}
+
+	if strings.HasPrefix(goType, "[]") || strings.HasPrefix(goType, "map[") || strings.Contains(goType, ".") {
+		log.Warn("the validation of the nested elements is not generated, add it to the types by hand",
+			"type", name)
+		return goType, nil
+	}
+
+	name = g.uniqueName(name)
+	g.types = append(g.types, SchemaType{
+		Name:        name,
+		Description: descriptionLines(schema.Description),
+		Markers:     markers,
+		Underlying:  goType,
+	})
+	return name, nil
+}
+
+// structType generates the struct type of an object and the types of its properties.
+func (g *typesGenerator) structType(name string, schema JSONSchemaProps) (string, error) {
+	name = g.uniqueName(name)
+	// The type is added before its properties, so that the types are declared from the outermost one
+	index := len(g.types)
+	g.types = append(g.types, SchemaType{Name: name, Description: descriptionLines(schema.Description)})
+
+	fields := make([]SchemaField, 0, len(schema.Properties))
+	for _, property := range slices.Sorted(maps.Keys(schema.Properties)) {
+		field, err := g.field(name, property, schema.Properties[property], slices.Contains(schema.Required, property))
+		if err != nil {
+			return "", err
+		}
+		fields = append(fields, field)
+	}
+
+	g.types[index].Fields = fields
+	return name, nil
+}
+
+// isStruct returns true if the Go type is a struct generated from the schema.
+func (g *typesGenerator) isStruct(goType string) bool {
+	typ := g.typeNamed(goType)
+	return typ != nil && typ.Underlying == ""
+}
+
+// typeNamed returns the generated type with the provided name, or nil if there is none.
+func (g *typesGenerator) typeNamed(name string) *SchemaType {
+	for i := range g.types {
+		if g.types[i].Name == name {
+			return &g.types[i]
+		}
+	}
+	return nil
+}
+
+// uniqueName returns the name, suffixed with a number when it is already used.
+func (g *typesGenerator) uniqueName(name string) string {
+	unique := name
+	for i := 2; g.names[unique]; i++ {
+		unique = name + strconv.Itoa(i)
+	}
+	g.names[unique] = true
+	return unique
+}
+
+func (g *typesGenerator) addImport(imp string) {
+	g.imports = appendImport(g.imports, imp)
+}
+
+// isConditionSchema returns true if the schema is the one of metav1.Condition.
+func isConditionSchema(schema JSONSchemaProps) bool {
+	return schema.Type == "object" && slices.Equal(slices.Sorted(maps.Keys(schema.Properties)), conditionProperties)
+}
+
+// validationMarkers returns the validation markers of the schema with the provided prefix.
+func validationMarkers(schema JSONSchemaProps, prefix string) []string {
+	var markers []string
+	add := func(format string, args ...any) {
+		markers = append(markers, prefix+fmt.Sprintf(format, args...))
+	}
+
+	if len(schema.Enum) != 0 {
+		values := make([]string, 0, len(schema.Enum))
+		for _, value := range schema.Enum {
+			values = append(values, markerValue(value, false))
+		}
+		add("Enum=%s", strings.Join(values, ";"))
+	}
+	if schema.Pattern != "" {
+		if strings.Contains(schema.Pattern, "`") {
+			add("Pattern=%s", strconv.Quote(schema.Pattern))
+		} else {
+			add("Pattern=`%s`", schema.Pattern)
+		}
+	}
+	if schema.Format != "" && schema.Type == "string" && schema.Format != "date-time" && schema.Format != "byte" {
+		add("Format=%s", schema.Format)
+	}
+	if schema.Minimum != nil {
+		add("Minimum=%s", formatNumber(*schema.Minimum))
+	}
+	if schema.ExclusiveMinimum {
+		add("ExclusiveMinimum=true")
+	}
+	if schema.Maximum != nil {
+		add("Maximum=%s", formatNumber(*schema.Maximum))
+	}
+	if schema.ExclusiveMaximum {
+		add("ExclusiveMaximum=true")
+	}
+	if schema.MultipleOf != nil {
+		add("MultipleOf=%s", formatNumber(*schema.MultipleOf))
+	}
+	for _, bound := range []struct {
+		name  string
+		value *int64
+	}{
+		{"MinLength", schema.MinLength}, {"MaxLength", schema.MaxLength},
+		{"MinItems", schema.MinItems}, {"MaxItems", schema.MaxItems},
+		{"MinProperties", schema.MinProperties}, {"MaxProperties", schema.MaxProperties},
+	} {
+		if bound.value != nil {
+			add("%s=%d", bound.name, *bound.value)
+		}
+	}
+	if schema.UniqueItems {
+		add("UniqueItems=true")
+	}
+	for _, rule := range schema.XValidations {
+		add("XValidation:%s", rule.markerArgs())
+	}
+
+	return markers
+}
+
+// collectionMarkers returns the markers of the list and map types of the schema.
+func collectionMarkers(schema JSONSchemaProps) []string {
+	var markers []string
+	if schema.XListType != "" {
+		markers = append(markers, "+listType="+schema.XListType)
+	}
+	for _, key := range schema.XListMapKeys {
+		markers = append(markers, "+listMapKey="+key)
+	}
+	if schema.XMapType != "" {
+		markers = append(markers, "+mapType="+schema.XMapType)
+	}
+	return markers
+}
+
+// markerArgs returns the arguments of the XValidation marker of the rule.
+func (r ValidationRule) markerArgs() string {
+	args := []string{"rule=" + strconv.Quote(r.Rule)}
+	if r.Message != "" {
+		args = append(args, "message="+strconv.Quote(r.Message))
+	}
+	if r.MessageExpression != "" {
+		args = append(args, "messageExpression="+strconv.Quote(r.MessageExpression))
+	}
+	if r.Reason != "" {
+		args = append(args, "reason="+r.Reason)
+	}
+	if r.FieldPath != "" {
+		args = append(args, "fieldPath="+strconv.Quote(r.FieldPath))
+	}
+	if r.OptionalOldSelf {
+		args = append(args, "optionalOldSelf=true")
+	}
+	return strings.Join(args, ",")
+}
+
+// markerValue formats a JSON value with the syntax of the marker arguments. The strings are quoted when
+// quote is true or when they could be read as another type.
+func markerValue(value any, quote bool) string {
+	switch value := value.(type) {
+	case string:
+		if quote || !bareMarkerValueRegex.MatchString(value) || value == "true" || value == "false" {
+			return strconv.Quote(value)
+		}
+		return value
+	case float64:
+		return formatNumber(value)
+	case bool:
+		return strconv.FormatBool(value)
+	case []any:
+		items := make([]string, 0, len(value))
+		for _, item := range value {
+			items = append(items, markerValue(item, true))
+		}
+		return "{" + strings.Join(items, ", ") + "}"
+	case map[string]any:
+		entries := make([]string, 0, len(value))
+		for _, key := range slices.Sorted(maps.Keys(value)) {
+			entries = append(entries, markerValue(key, false)+": "+markerValue(value[key], true))
+		}
+		return "{" + strings.Join(entries, ", ") + "}"
+	default:
+		return "null"
+	}
+}
+
+func formatNumber(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+// goIdentifier returns the exported Go identifier of a property name, e.g. ImagePullPolicy for imagePullPolicy.
+func goIdentifier(name string) string {
+	identifier := flect.Pascalize(nonIdentifierRegex.ReplaceAllString(name, "_"))
+	identifier = nonIdentifierRegex.ReplaceAllString(identifier, "")
+	if identifier == "" || (identifier[0] >= '0' && identifier[0] <= '9') {
+		identifier = "X" + identifier
+	}
+	return identifier
+}
+
+// descriptionLines returns the lines of the comment documenting a schema.
+func descriptionLines(description string) []string {
+	description = strings.TrimSpace(description)
+	if description == "" {
+		return nil
+	}
+
+	lines := strings.Split(description, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t")
+	}
+	return lines
+}
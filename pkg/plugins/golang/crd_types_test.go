@@ -0,0 +1,211 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package golang
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/yaml"
+)
+
+// generateTypes generates the types of the Frigate kind from the schema in YAML.
+func generateTypes(schema string) (*SchemaTypes, error) {
+	props := &JSONSchemaProps{}
+	Expect(yaml.Unmarshal([]byte(schema), props)).To(Succeed())
+	return GenerateTypes("Frigate", CRDVersion{Name: "v1", Schema: &CRDValidation{OpenAPIV3Schema: props}})
+}
+
+// specField returns the field of the FrigateSpec type with the provided name.
+func specField(types *SchemaTypes, name string) SchemaField {
+	for _, typ := range types.Types {
+		if typ.Name != "FrigateSpec" {
+			continue
+		}
+		for _, field := range typ.Fields {
+			if field.GoName == name {
+				return field
+			}
+		}
+	}
+	Fail("field " + name + " not found")
+	return SchemaField{}
+}
+
+var _ = Describe("GenerateTypes", func() {
+	It("should generate the fields of the root type besides the type and object metadata", func() {
+		types, err := generateTypes(`
+type: object
+description: Frigate is a ship.
+x-kubernetes-validations:
+- rule: self.metadata.name.size() < 20
+properties:
+  apiVersion: {type: string}
+  kind: {type: string}
+  metadata: {type: object}
+  spec:
+    type: object
+    properties:
+      crew: {type: integer, format: int32}
+  status:
+    type: object
+    properties:
+      ready: {type: boolean}
+`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(types.Description).To(Equal([]string{"Frigate is a ship."}))
+		Expect(types.Markers).To(Equal([]string{
+			`+kubebuilder:validation:XValidation:rule="self.metadata.name.size() < 20"`,
+		}))
+		Expect(types.Fields).To(HaveLen(2))
+		Expect(types.Fields[0]).To(Equal(SchemaField{
+			GoName: "Spec", GoType: "FrigateSpec", JSONTag: "spec,omitzero", Markers: []string{"+optional"},
+		}))
+		Expect(types.Types).To(HaveLen(2))
+		Expect(types.Types[0].Name).To(Equal("FrigateSpec"))
+		Expect(types.Types[0].Fields[0].GoType).To(Equal("*int32"))
+		Expect(types.Types[1].Name).To(Equal("FrigateStatus"))
+	})
+
+	DescribeTable("should map the schemas to Go types",
+		func(schema, goType string, imports []string) {
+			types, err := generateTypes("type: object\nproperties:\n  spec:\n    type: object\n    required: [value]\n" +
+				"    properties:\n      value: " + schema + "\n")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(specField(types, "Value").GoType).To(Equal(goType))
+			Expect(types.Imports).To(Equal(imports))
+		},
+		Entry("string", "{type: string}", "string", nil),
+		Entry("date-time", "{type: string, format: date-time}", "metav1.Time", nil),
+		Entry("bytes", "{type: string, format: byte}", "[]byte", nil),
+		Entry("int32", "{type: integer, format: int32}", "int32", nil),
+		Entry("integer", "{type: integer}", "int64", nil),
+		Entry("number", "{type: number}", "float64", nil),
+		Entry("boolean", "{type: boolean}", "bool", nil),
+		Entry("array", "{type: array, items: {type: string}}", "[]string", nil),
+		Entry("map", "{type: object, additionalProperties: {type: integer}}", "map[string]int64", nil),
+		Entry("int or string", "{x-kubernetes-int-or-string: true}", "intstr.IntOrString",
+			[]string{intstrImport}),
+		Entry("unknown object", "{type: object, x-kubernetes-preserve-unknown-fields: true}",
+			"runtime.RawExtension", []string{runtimeImport}),
+		Entry("unknown value", "{x-kubernetes-preserve-unknown-fields: true}", "apiextensionsv1.JSON",
+			[]string{apiextensionsv1Import}),
+		Entry("conditions", `{type: array, items: {type: object, properties: {lastTransitionTime: {type: string},
+          message: {type: string}, observedGeneration: {type: integer}, reason: {type: string},
+          status: {type: string}, type: {type: string}}}}`, "[]metav1.Condition", nil),
+	)
+
+	It("should render the validation markers", func() {
+		types, err := generateTypes(`
+type: object
+properties:
+  spec:
+    type: object
+    required: [image]
+    x-kubernetes-validations:
+    - rule: self.min <= self.max
+      message: min must not exceed max
+      reason: FieldValueInvalid
+      fieldPath: .min
+    properties:
+      image: {type: string, minLength: 1, pattern: '^[a-z]+$'}
+      replicas: {type: integer, format: int32, minimum: 0, maximum: 10, default: 1}
+      protocol: {type: string, enum: [TCP, "1.0"], default: TCP}
+      ports:
+        type: array
+        maxItems: 4
+        x-kubernetes-list-type: map
+        x-kubernetes-list-map-keys: [name]
+        items: {type: object, required: [name], properties: {name: {type: string}}}
+      settings: {type: object, default: {mode: fast, levels: [1, 2]}, properties: {mode: {type: string}}}
+`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(types.Fields[0].Markers).To(Equal([]string{
+			`+kubebuilder:validation:XValidation:rule="self.min <= self.max",message="min must not exceed max",` +
+				`reason=FieldValueInvalid,fieldPath=".min"`,
+			"+optional",
+		}))
+		Expect(specField(types, "Image").Markers).To(Equal([]string{
+			"+kubebuilder:validation:Pattern=`^[a-z]+$`",
+			"+kubebuilder:validation:MinLength=1",
+			"+required",
+		}))
+		Expect(specField(types, "Image").JSONTag).To(Equal("image"))
+		Expect(specField(types, "Replicas").Markers).To(Equal([]string{
+			"+kubebuilder:validation:Minimum=0",
+			"+kubebuilder:validation:Maximum=10",
+			"+kubebuilder:default=1",
+			"+optional",
+		}))
+		Expect(specField(types, "Protocol").Markers).To(Equal([]string{
+			`+kubebuilder:validation:Enum=TCP;"1.0"`,
+			`+kubebuilder:default="TCP"`,
+			"+optional",
+		}))
+		Expect(specField(types, "Ports").GoType).To(Equal("[]FrigateSpecPort"))
+		Expect(specField(types, "Ports").Markers).To(Equal([]string{
+			"+kubebuilder:validation:MaxItems=4",
+			"+listType=map",
+			"+listMapKey=name",
+			"+optional",
+		}))
+		Expect(specField(types, "Settings").Markers).To(Equal([]string{
+			`+kubebuilder:default={levels: {1, 2}, mode: "fast"}`,
+			"+optional",
+		}))
+	})
+
+	It("should give a named type to the validated elements", func() {
+		types, err := generateTypes(`
+type: object
+properties:
+  spec:
+    type: object
+    properties:
+      tags: {type: array, items: {type: string, maxLength: 10}}
+`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(specField(types, "Tags").GoType).To(Equal("[]FrigateSpecTag"))
+		Expect(types.Types[1]).To(Equal(SchemaType{
+			Name:       "FrigateSpecTag",
+			Markers:    []string{"+kubebuilder:validation:MaxLength=10"},
+			Underlying: "string",
+		}))
+	})
+
+	It("should not reuse the names of the types", func() {
+		types, err := generateTypes(`
+type: object
+properties:
+  spec:
+    type: object
+    properties:
+      engine: {type: object, properties: {power: {type: integer}}}
+  specEngine: {type: object, properties: {fuel: {type: string}}}
+`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(types.Fields[1].GoType).To(Equal("FrigateSpecEngine2"))
+	})
+
+	It("should fail for the unsupported schemas", func() {
+		_, err := generateTypes("type: object\nproperties:\n  spec: {type: array}\n")
+		Expect(err).To(MatchError(ContainSubstring(`property "spec": the items of the array have no schema`)))
+
+		_, err = generateTypes("type: object\nproperties:\n  spec: {}\n")
+		Expect(err).To(MatchError(ContainSubstring(`property "spec": unsupported type ""`)))
+	})
+})
@@ -31,6 +31,7 @@ import (
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/util"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugins"
 	kustomizev2scaffolds "sigs.k8s.io/kubebuilder/v4/pkg/plugins/common/kustomize/v2/scaffolds"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/deploy-image/v1alpha1/scaffolds/internal/templates/api"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/deploy-image/v1alpha1/scaffolds/internal/templates/config/samples"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/deploy-image/v1alpha1/scaffolds/internal/templates/controllers"
@@ -292,7 +293,7 @@ func (s *apiScaffolder) scaffoldCreateAPIFromKustomize() error {
 
 func (s *apiScaffolder) scaffoldCreateAPIFromGolang() error {
 	golangV4Scaffolder := golangv4scaffolds.NewAPIScaffolder(s.config,
-		s.resource, true)
+		s.resource, true, golang.Options{})
 	golangV4Scaffolder.InjectFS(s.fs)
 	if err := golangV4Scaffolder.Scaffold(); err != nil {
 		return fmt.Errorf("error scaffolding golang files for the APIs: %v", err)
@@ -0,0 +1,590 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package golang
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gobuffalo/flect"
+)
+
+const (
+	corev1Import   = `corev1 "k8s.io/api/core/v1"`
+	resourceImport = `"k8s.io/apimachinery/pkg/api/resource"`
+	// apiResourceImport avoids shadowing the package with the variables named resource in the scaffolded tests
+	apiResourceImport = `apiresource "k8s.io/apimachinery/pkg/api/resource"`
+	timeImport        = `"time"`
+)
+
+var (
+	// fieldNameRegex matches the JSON names accepted for the fields, e.g. replicas or imagePullPolicy
+	fieldNameRegex = regexp.MustCompile(`^[a-z][a-zA-Z0-9]*$`)
+	// kindRegex matches the Kind names that can be referenced by the fields
+	kindRegex = regexp.MustCompile(`^[A-Z][a-zA-Z0-9]*$`)
+)
+
+// scalarTypes are the Go scalar types supported by the fields
+var scalarTypes = []string{"string", "bool", "int32", "int64"}
+
+type fieldKind int
+
+const (
+	scalarField fieldKind = iota
+	durationField
+	quantityField
+	referenceField
+	sliceField
+	mapField
+)
+
+// fieldType is the parsed type of a field
+type fieldType struct {
+	kind fieldKind
+	// name is the Go type of the scalars or the Kind of the references
+	name string
+	// elem is the type of the elements of the slices and maps
+	elem *fieldType
+}
+
+// parseFieldType parses the types supported by the fields:
+// scalars, duration, quantity, []<type>, map[string]<type> and references to other Kinds.
+func parseFieldType(value string) (*fieldType, error) {
+	switch {
+	case strings.HasPrefix(value, "[]"):
+		elem, err := parseFieldType(strings.TrimPrefix(value, "[]"))
+		if err != nil {
+			return nil, err
+		}
+		return &fieldType{kind: sliceField, elem: elem}, nil
+	case strings.HasPrefix(value, "map[string]"):
+		elem, err := parseFieldType(strings.TrimPrefix(value, "map[string]"))
+		if err != nil {
+			return nil, err
+		}
+		return &fieldType{kind: mapField, elem: elem}, nil
+	case value == "duration":
+		return &fieldType{kind: durationField}, nil
+	case value == "quantity":
+		return &fieldType{kind: quantityField}, nil
+	case slices.Contains(scalarTypes, value):
+		return &fieldType{kind: scalarField, name: value}, nil
+	case kindRegex.MatchString(value):
+		return &fieldType{kind: referenceField, name: value}, nil
+	default:
+		return nil, fmt.Errorf("unsupported type %q, must be one of %s, duration, quantity, "+
+			"[]<type>, map[string]<type> or a Kind to reference", value, strings.Join(scalarTypes, ", "))
+	}
+}
+
+// goType returns the Go type, qualifying the quantities with the provided package name.
+func (t fieldType) goType(resourcePkg string) string {
+	switch t.kind {
+	case durationField:
+		return "metav1.Duration"
+	case quantityField:
+		return resourcePkg + ".Quantity"
+	case referenceField:
+		return "corev1.LocalObjectReference"
+	case sliceField:
+		return "[]" + t.elem.goType(resourcePkg)
+	case mapField:
+		return "map[string]" + t.elem.goType(resourcePkg)
+	default:
+		return t.name
+	}
+}
+
+// uses returns true if the type or any of its element types is of the provided kind.
+func (t fieldType) uses(kind fieldKind) bool {
+	if t.kind == kind {
+		return true
+	}
+	return t.elem != nil && t.elem.uses(kind)
+}
+
+// isInteger returns true for the int32 and int64 scalars.
+func (t fieldType) isInteger() bool {
+	return t.kind == scalarField && (t.name == "int32" || t.name == "int64")
+}
+
+// isString returns true for the string scalars.
+func (t fieldType) isString() bool {
+	return t.kind == scalarField && t.name == "string"
+}
+
+// Field is a spec or status field of an API declared with `name:type[:validation]`.
+type Field struct {
+	// Name is the JSON name of the field.
+	Name string
+
+	// Required is true if the field is required, otherwise it is optional.
+	Required bool
+
+	typ *fieldType
+
+	minimum      *int64
+	maximum      *int64
+	enum         []string
+	pattern      string
+	defaultValue *string
+}
+
+// ParseField parses a field in the `name:type[:validation]` format, where validation is a comma-separated
+// list of the rules required, optional, min=<n>, max=<n>, enum=<a;b>, default=<value> and pattern=<regex>.
+// As regular expressions may contain commas, pattern must be the last rule.
+func ParseField(value string) (Field, error) {
+	parts := strings.SplitN(value, ":", 3)
+	if len(parts) < 2 {
+		return Field{}, fmt.Errorf("field %q must follow the format name:type[:validation]", value)
+	}
+
+	field := Field{Name: parts[0]}
+	if !fieldNameRegex.MatchString(field.Name) {
+		return Field{}, fmt.Errorf("field name %q must start with a lowercase letter and only contain "+
+			"alphanumeric characters", field.Name)
+	}
+
+	typ, err := parseFieldType(parts[1])
+	if err != nil {
+		return Field{}, fmt.Errorf("invalid field %q: %w", field.Name, err)
+	}
+	field.typ = typ
+
+	if len(parts) == 3 {
+		if err := field.parseValidation(parts[2]); err != nil {
+			return Field{}, fmt.Errorf("invalid field %q: %w", field.Name, err)
+		}
+	}
+
+	return field, nil
+}
+
+// parseValidation parses the validation rules of the field and checks that they apply to its type.
+func (f *Field) parseValidation(rules string) error {
+	for rules != "" {
+		if pattern, found := strings.CutPrefix(rules, "pattern="); found {
+			f.pattern = pattern
+			break
+		}
+
+		var rule string
+		rule, rules, _ = strings.Cut(rules, ",")
+
+		key, value, _ := strings.Cut(rule, "=")
+		switch key {
+		case "required":
+			f.Required = true
+		case "optional":
+			f.Required = false
+		case "min", "max":
+			bound, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return fmt.Errorf("%s must be an integer: %w", key, err)
+			}
+			if key == "min" {
+				f.minimum = &bound
+			} else {
+				f.maximum = &bound
+			}
+		case "enum":
+			f.enum = strings.Split(value, ";")
+		case "default":
+			f.defaultValue = &value
+		default:
+			return fmt.Errorf("unknown validation rule %q, must be one of required, optional, "+
+				"min, max, enum, default or pattern", rule)
+		}
+	}
+
+	return f.validateRules()
+}
+
+// validateRules checks that the validation rules can be applied to the type of the field.
+func (f Field) validateRules() error {
+	if f.minimum != nil || f.maximum != nil {
+		switch {
+		case f.typ.isInteger():
+		case f.typ.isString(), f.typ.kind == sliceField, f.typ.kind == mapField:
+			if (f.minimum != nil && *f.minimum < 0) || (f.maximum != nil && *f.maximum < 0) {
+				return errors.New("min and max cannot be negative for strings, slices and maps")
+			}
+		default:
+			return errors.New("min and max are only supported for integers, strings, slices and maps")
+		}
+		if f.minimum != nil && f.maximum != nil && *f.minimum > *f.maximum {
+			return errors.New("min cannot be greater than max")
+		}
+	}
+
+	if len(f.enum) != 0 {
+		if !f.typ.isString() && !f.typ.isInteger() {
+			return errors.New("enum is only supported for strings and integers")
+		}
+		for _, value := range f.enum {
+			if err := f.validateScalar(value); err != nil {
+				return fmt.Errorf("invalid enum value: %w", err)
+			}
+		}
+	}
+
+	if f.pattern != "" && !f.typ.isString() {
+		return errors.New("pattern is only supported for strings")
+	}
+
+	if f.defaultValue != nil {
+		if f.typ.kind != scalarField {
+			return errors.New("default is only supported for strings, integers and booleans")
+		}
+		if err := f.validateScalar(*f.defaultValue); err != nil {
+			return fmt.Errorf("invalid default value: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// validateScalar checks that the value can be assigned to the scalar field.
+func (f Field) validateScalar(value string) error {
+	switch f.typ.name {
+	case "bool":
+		if _, err := strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("%q is not a boolean", value)
+		}
+	case "int32", "int64":
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			return fmt.Errorf("%q is not an integer", value)
+		}
+	case "string":
+		if strings.ContainsAny(value, "`\n") {
+			return fmt.Errorf("%q cannot contain backquotes or new lines", value)
+		}
+	}
+	return nil
+}
+
+// GoName returns the name of the Go struct field.
+func (f Field) GoName() string {
+	return flect.Pascalize(f.Name)
+}
+
+// GoType returns the Go type of the field, optional scalars and structs are pointers.
+func (f Field) GoType() string {
+	goType := f.typ.goType("resource")
+	if !f.Required && f.typ.kind != sliceField && f.typ.kind != mapField {
+		return "*" + goType
+	}
+	return goType
+}
+
+// JSONTag returns the value of the json tag of the field.
+func (f Field) JSONTag() string {
+	if f.Required {
+		return f.Name
+	}
+	return f.Name + ",omitempty"
+}
+
+// Description returns the sentence that documents the field, after its name.
+func (f Field) Description(kind string) string {
+	if f.typ.kind == referenceField {
+		return fmt.Sprintf("references a %s in the same namespace.", f.typ.name)
+	}
+	return fmt.Sprintf("is a field of %s. Edit %s_types.go to document it.", kind, strings.ToLower(kind))
+}
+
+// Markers returns the validation markers of the field, without the comment prefix.
+func (f Field) Markers() []string {
+	var markers []string
+
+	if f.minimum != nil || f.maximum != nil {
+		minName, maxName := "Minimum", "Maximum"
+		switch {
+		case f.typ.isString():
+			minName, maxName = "MinLength", "MaxLength"
+		case f.typ.kind == sliceField:
+			minName, maxName = "MinItems", "MaxItems"
+		case f.typ.kind == mapField:
+			minName, maxName = "MinProperties", "MaxProperties"
+		}
+		if f.minimum != nil {
+			markers = append(markers, fmt.Sprintf("+kubebuilder:validation:%s=%d", minName, *f.minimum))
+		}
+		if f.maximum != nil {
+			markers = append(markers, fmt.Sprintf("+kubebuilder:validation:%s=%d", maxName, *f.maximum))
+		}
+	}
+
+	if len(f.enum) != 0 {
+		markers = append(markers, "+kubebuilder:validation:Enum="+strings.Join(f.enum, ";"))
+	}
+
+	if f.pattern != "" {
+		markers = append(markers, "+kubebuilder:validation:Pattern=`"+f.pattern+"`")
+	}
+
+	if f.defaultValue != nil {
+		value := *f.defaultValue
+		if f.typ.isString() {
+			value = strconv.Quote(value)
+		}
+		markers = append(markers, "+kubebuilder:default="+value)
+	}
+
+	if f.Required {
+		markers = append(markers, "+required")
+	} else {
+		markers = append(markers, "+optional")
+	}
+
+	return markers
+}
+
+// HasPattern returns true if the field must match a pattern, which the sample values may not satisfy.
+func (f Field) HasPattern() bool {
+	return f.pattern != ""
+}
+
+// SampleYAML returns the field with a sample value as YAML, indented with the provided prefix,
+// without the trailing new line.
+func (f Field) SampleYAML(indent string) string {
+	var b strings.Builder
+	writeYAML(&b, indent, f.Name, f.sample(*f.typ, true))
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// GoValue returns a Go expression with a sample value of the field.
+// Quantities are qualified with the apiresource package name.
+func (f Field) GoValue() string {
+	return f.goValue(*f.typ, true)
+}
+
+// TypeImports returns the imports required by the Go types of the fields.
+func TypeImports(fields []Field) []string {
+	var imports []string
+	for _, f := range fields {
+		if f.typ.uses(quantityField) {
+			imports = appendImport(imports, resourceImport)
+		}
+		if f.typ.uses(referenceField) {
+			imports = appendImport(imports, corev1Import)
+		}
+	}
+	return imports
+}
+
+// ValueImports returns the imports required by the sample Go values of the fields,
+// besides metav1 which is always imported by the scaffolded tests.
+func ValueImports(fields []Field) []string {
+	var imports []string
+	for _, f := range fields {
+		if f.typ.uses(durationField) {
+			imports = appendImport(imports, timeImport)
+		}
+		if f.typ.uses(quantityField) {
+			imports = appendImport(imports, apiResourceImport)
+		}
+		if f.typ.uses(referenceField) {
+			imports = appendImport(imports, corev1Import)
+		}
+	}
+	return imports
+}
+
+func appendImport(imports []string, imp string) []string {
+	if slices.Contains(imports, imp) {
+		return imports
+	}
+	return append(imports, imp)
+}
+
+// yamlEntry is an entry of an ordered YAML mapping
+type yamlEntry struct {
+	key   string
+	value any
+}
+
+// sampleScalar returns a sample value of a scalar.
+func (f Field) sampleScalar(t fieldType, top bool) string {
+	if top && len(f.enum) != 0 {
+		return f.enum[0]
+	}
+	if top && f.defaultValue != nil {
+		return *f.defaultValue
+	}
+
+	switch t.name {
+	case "bool":
+		return "true"
+	case "int32", "int64":
+		value := int64(1)
+		if top && f.minimum != nil && *f.minimum > value {
+			value = *f.minimum
+		}
+		if top && f.maximum != nil && *f.maximum < value {
+			value = *f.maximum
+		}
+		return strconv.FormatInt(value, 10)
+	default:
+		value := "example"
+		if top && f.minimum != nil && int64(len(value)) < *f.minimum {
+			value += strings.Repeat("x", int(*f.minimum)-len(value))
+		}
+		if top && f.maximum != nil && int64(len(value)) > *f.maximum {
+			value = value[:*f.maximum]
+		}
+		return value
+	}
+}
+
+// sample returns a sample value as a YAML scalar, []any or []yamlEntry.
+func (f Field) sample(t fieldType, top bool) any {
+	switch t.kind {
+	case durationField:
+		return `"1m"`
+	case quantityField:
+		return `"1"`
+	case referenceField:
+		return []yamlEntry{{key: "name", value: strconv.Quote(strings.ToLower(t.name) + "-sample")}}
+	case sliceField:
+		items := []any{}
+		for range f.sampleLength(top) {
+			items = append(items, f.sample(*t.elem, false))
+		}
+		return items
+	case mapField:
+		entries := []yamlEntry{}
+		for i := range f.sampleLength(top) {
+			entries = append(entries, yamlEntry{key: fmt.Sprintf("key%d", i+1), value: f.sample(*t.elem, false)})
+		}
+		return entries
+	default:
+		value := f.sampleScalar(t, top)
+		if t.isString() {
+			return strconv.Quote(value)
+		}
+		return value
+	}
+}
+
+// goValue returns a sample value as a Go expression. The type of the composite literals is elided for
+// the elements, as gofmt -s does.
+func (f Field) goValue(t fieldType, top bool) string {
+	literalType := ""
+	if top {
+		literalType = t.goType("apiresource")
+	}
+
+	switch t.kind {
+	case durationField:
+		return literalType + "{Duration: time.Minute}"
+	case quantityField:
+		return `apiresource.MustParse("1")`
+	case referenceField:
+		return fmt.Sprintf("%s{Name: %q}", literalType, strings.ToLower(t.name)+"-sample")
+	case sliceField:
+		items := make([]string, 0, f.sampleLength(top))
+		for range f.sampleLength(top) {
+			items = append(items, f.goValue(*t.elem, false))
+		}
+		return fmt.Sprintf("%s{%s}", literalType, strings.Join(items, ", "))
+	case mapField:
+		entries := make([]string, 0, f.sampleLength(top))
+		for i := range f.sampleLength(top) {
+			entries = append(entries, fmt.Sprintf("%q: %s", fmt.Sprintf("key%d", i+1), f.goValue(*t.elem, false)))
+		}
+		return fmt.Sprintf("%s{%s}", literalType, strings.Join(entries, ", "))
+	default:
+		value := f.sampleScalar(t, top)
+		if t.isString() {
+			return strconv.Quote(value)
+		}
+		return value
+	}
+}
+
+// sampleLength returns the number of elements of the sample slices and maps.
+// The sample helpers receive top as true for the value of the field itself, whose validation rules apply,
+// and as false for its elements.
+func (f Field) sampleLength(top bool) int {
+	length := int64(1)
+	if top && f.minimum != nil && *f.minimum > length {
+		length = *f.minimum
+	}
+	if top && f.maximum != nil && *f.maximum < length {
+		length = *f.maximum
+	}
+	return int(length)
+}
+
+// writeYAML writes the key and value as YAML with the provided indentation.
+func writeYAML(b *strings.Builder, indent, key string, value any) {
+	switch value := value.(type) {
+	case []any:
+		if len(value) == 0 {
+			fmt.Fprintf(b, "%s%s: []\n", indent, key)
+			return
+		}
+		fmt.Fprintf(b, "%s%s:\n", indent, key)
+		for _, item := range value {
+			writeYAMLItem(b, indent, item)
+		}
+	case []yamlEntry:
+		if len(value) == 0 {
+			fmt.Fprintf(b, "%s%s: {}\n", indent, key)
+			return
+		}
+		fmt.Fprintf(b, "%s%s:\n", indent, key)
+		for _, entry := range value {
+			writeYAML(b, indent+"  ", entry.key, entry.value)
+		}
+	default:
+		fmt.Fprintf(b, "%s%s: %v\n", indent, key, value)
+	}
+}
+
+// writeYAMLItem writes a sequence item with the provided indentation.
+func writeYAMLItem(b *strings.Builder, indent string, item any) {
+	var nested strings.Builder
+	switch item := item.(type) {
+	case []any:
+		if len(item) == 0 {
+			fmt.Fprintf(b, "%s- []\n", indent)
+			return
+		}
+		for _, i := range item {
+			writeYAMLItem(&nested, indent+"  ", i)
+		}
+	case []yamlEntry:
+		if len(item) == 0 {
+			fmt.Fprintf(b, "%s- {}\n", indent)
+			return
+		}
+		for _, entry := range item {
+			writeYAML(&nested, indent+"  ", entry.key, entry.value)
+		}
+	default:
+		fmt.Fprintf(b, "%s- %v\n", indent, item)
+		return
+	}
+	// Replace the indentation of the first line by the sequence indicator
+	b.WriteString(indent + "- " + strings.TrimPrefix(nested.String(), indent+"  "))
+}
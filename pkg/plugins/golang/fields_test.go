@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package golang
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Field", func() {
+	Context("ParseField", func() {
+		DescribeTable("should parse the Go type",
+			func(value, goType string) {
+				field, err := ParseField(value)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(field.GoType()).To(Equal(goType))
+			},
+			Entry("optional string", "image:string", "*string"),
+			Entry("required integer", "replicas:int32:required", "int32"),
+			Entry("slice", "args:[]string", "[]string"),
+			Entry("map", "labels:map[string]string", "map[string]string"),
+			Entry("nested map", "ports:[]map[string]int32", "[]map[string]int32"),
+			Entry("duration", "interval:duration", "*metav1.Duration"),
+			Entry("quantity", "memory:quantity:required", "resource.Quantity"),
+			Entry("reference", "secretRef:Secret", "*corev1.LocalObjectReference"),
+			Entry("slice of references", "mates:[]FirstMate", "[]corev1.LocalObjectReference"),
+		)
+
+		DescribeTable("should fail for invalid fields",
+			func(value string) {
+				_, err := ParseField(value)
+				Expect(err).To(HaveOccurred())
+			},
+			Entry("missing type", "image"),
+			Entry("uppercase name", "Image:string"),
+			Entry("unsupported type", "ratio:float64"),
+			Entry("unknown rule", "image:string:unique"),
+			Entry("non-integer bound", "replicas:int32:min=one"),
+			Entry("min greater than max", "replicas:int32:min=5,max=1"),
+			Entry("negative length", "image:string:min=-1"),
+			Entry("bounds on a boolean", "enabled:bool:max=1"),
+			Entry("enum on a slice", "args:[]string:enum=a;b"),
+			Entry("non-integer enum", "replicas:int32:enum=a;b"),
+			Entry("pattern on an integer", "replicas:int32:pattern=^1$"),
+			Entry("invalid default", "enabled:bool:default=yes"),
+		)
+
+		It("should keep the commas of the pattern", func() {
+			field, err := ParseField("name:string:required,min=1,pattern=^[a-z]{1,3}$")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(field.Required).To(BeTrue())
+			Expect(field.Markers()).To(Equal([]string{
+				"+kubebuilder:validation:MinLength=1",
+				"+kubebuilder:validation:Pattern=`^[a-z]{1,3}$`",
+				"+required",
+			}))
+		})
+	})
+
+	Context("Markers", func() {
+		DescribeTable("should render the validation markers",
+			func(value string, markers []string) {
+				field, err := ParseField(value)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(field.Markers()).To(Equal(markers))
+			},
+			Entry("integer bounds", "replicas:int32:min=1,max=3", []string{
+				"+kubebuilder:validation:Minimum=1", "+kubebuilder:validation:Maximum=3", "+optional",
+			}),
+			Entry("slice bounds", "args:[]string:max=2", []string{
+				"+kubebuilder:validation:MaxItems=2", "+optional",
+			}),
+			Entry("map bounds", "labels:map[string]string:min=1,required", []string{
+				"+kubebuilder:validation:MinProperties=1", "+required",
+			}),
+			Entry("enum and default", "mode:string:enum=Fast;Slow,default=Fast", []string{
+				"+kubebuilder:validation:Enum=Fast;Slow", `+kubebuilder:default="Fast"`, "+optional",
+			}),
+		)
+	})
+
+	Context("sample values", func() {
+		It("should honor the validation rules", func() {
+			field, err := ParseField("replicas:int32:required,min=2")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(field.SampleYAML("  ")).To(Equal("  replicas: 2"))
+			Expect(field.GoValue()).To(Equal("2"))
+
+			field, err = ParseField("mode:string:enum=Fast;Slow")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(field.SampleYAML("")).To(Equal(`mode: "Fast"`))
+		})
+
+		It("should render nested slices and maps", func() {
+			field, err := ParseField("ports:[]map[string]int32:min=2")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(field.SampleYAML("  ")).To(Equal("  ports:\n  - key1: 1\n  - key1: 1"))
+			Expect(field.GoValue()).To(Equal(`[]map[string]int32{{"key1": 1}, {"key1": 1}}`))
+		})
+
+		It("should render references and quantities", func() {
+			field, err := ParseField("secretRef:Secret")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(field.SampleYAML("")).To(Equal("secretRef:\n  name: \"secret-sample\""))
+			Expect(field.GoValue()).To(Equal(`corev1.LocalObjectReference{Name: "secret-sample"}`))
+
+			field, err = ParseField("limits:map[string]quantity")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(field.GoValue()).To(Equal(`map[string]apiresource.Quantity{"key1": apiresource.MustParse("1")}`))
+			Expect(TypeImports([]Field{field})).To(Equal([]string{`"k8s.io/apimachinery/pkg/api/resource"`}))
+			Expect(ValueImports([]Field{field})).To(Equal([]string{
+				`apiresource "k8s.io/apimachinery/pkg/api/resource"`,
+			}))
+		})
+	})
+})
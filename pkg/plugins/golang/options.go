@@ -70,6 +70,39 @@ type Options struct {
 	// Namespaced is true if the resource should be namespaced.
 	Namespaced bool
 
+	// ShortNames are the short names of the resource.
+	ShortNames []string
+
+	// Categories are the categories the resource belongs to.
+	Categories []string
+
+	// PrintColumns are the additional printer columns of the resource.
+	PrintColumns []resource.PrintColumn
+
+	// ScaleSubresource is true if the scale subresource should be enabled.
+	ScaleSubresource bool
+
+	// StorageVersion is true if the version should be marked as the storage version.
+	StorageVersion bool
+
+	// Conditions is true if the controllers should manage the status conditions of the API.
+	Conditions bool
+
+	// Clients is true if typed clients, listers, informers and apply configurations should be generated for the API.
+	Clients bool
+
+	// SpecFields and StatusFields are the fields scaffolded in the spec and status of the API types.
+	SpecFields   []Field
+	StatusFields []Field
+
+	// CRD is the CRD that the API is created from, whose served versions are all scaffolded,
+	// and CRDTypes are the types generated from the schema of each of them, by version.
+	CRD      *CRD
+	CRDTypes map[string]*SchemaTypes
+
+	// CELRules are the CEL validation rules added to the API types.
+	CELRules []CELRule
+
 	// Flags that define which parts should be scaffolded
 	DoAPI        bool
 	DoController bool
@@ -77,6 +110,16 @@ type Options struct {
 	DoValidation bool
 	DoConversion bool
 
+	// Finalizer is true if the scaffolded controller should manage a finalizer.
+	Finalizer bool
+
+	// ControllerStyle is the name of the template of the scaffolded controller.
+	ControllerStyle string
+
+	// Owns and Watches are the resources owned and watched by the scaffolded controller.
+	Owns    []resource.GVK
+	Watches []resource.Watch
+
 	// ControllerName is the name of the controller to scaffold.
 	// This is used when creating multiple controllers for the same resource (GVK).
 	// If not provided, a default name based on the resource kind will be used.
@@ -90,6 +133,11 @@ type Options struct {
 
 	// ValidationPath is the custom path for the validation webhook
 	ValidationPath string
+
+	// WebhookName is the name of the webhook to scaffold.
+	// This is used when creating multiple defaulting or validating webhooks for the same resource (GVK).
+	// If not provided, the default webhooks of the resource will be used.
+	WebhookName string
 }
 
 // UpdateResource updates the provided resource with the options
@@ -102,8 +150,15 @@ func (opts Options) UpdateResource(res *resource.Resource, c config.Config) {
 		res.Path = resource.APIPackagePath(c.GetRepository(), res.Group, res.Version, c.IsMultiGroup())
 
 		res.API = &resource.API{
-			CRDVersion: "v1",
-			Namespaced: opts.Namespaced,
+			CRDVersion:       "v1",
+			Namespaced:       opts.Namespaced,
+			ShortNames:       opts.ShortNames,
+			Categories:       opts.Categories,
+			PrintColumns:     opts.PrintColumns,
+			ScaleSubresource: opts.ScaleSubresource,
+			StorageVersion:   opts.StorageVersion,
+			Conditions:       opts.Conditions,
+			Clients:          opts.Clients,
 		}
 	}
 
@@ -115,16 +170,20 @@ func (opts Options) UpdateResource(res *resource.Resource, c config.Config) {
 		res.Path = resource.APIPackagePath(c.GetRepository(), res.Group, res.Version, c.IsMultiGroup())
 
 		res.Webhooks.WebhookVersion = "v1"
-		if opts.DoDefaulting {
-			res.Webhooks.Defaulting = true
-			if opts.DefaultingPath != "" {
-				res.Webhooks.DefaultingPath = opts.DefaultingPath
+		if opts.WebhookName != "" {
+			opts.updateNamedWebhooks(res)
+		} else {
+			if opts.DoDefaulting {
+				res.Webhooks.Defaulting = true
+				if opts.DefaultingPath != "" {
+					res.Webhooks.DefaultingPath = opts.DefaultingPath
+				}
 			}
-		}
-		if opts.DoValidation {
-			res.Webhooks.Validation = true
-			if opts.ValidationPath != "" {
-				res.Webhooks.ValidationPath = opts.ValidationPath
+			if opts.DoValidation {
+				res.Webhooks.Validation = true
+				if opts.ValidationPath != "" {
+					res.Webhooks.ValidationPath = opts.ValidationPath
+				}
 			}
 		}
 		if opts.DoConversion {
@@ -172,16 +231,36 @@ func (opts Options) UpdateResource(res *resource.Resource, c config.Config) {
 
 // updateControllers applies controller-related options to the resource.
 // It handles both legacy (--controller) and new (--controller-name) controller creation.
+func (opts Options) updateNamedWebhooks(res *resource.Resource) {
+	webhook := resource.NamedWebhook{Name: opts.WebhookName}
+	if opts.DoDefaulting {
+		webhook.Type = resource.DefaultingWebhook
+		webhook.Path = opts.DefaultingPath
+	} else {
+		webhook.Type = resource.ValidationWebhook
+		webhook.Path = opts.ValidationPath
+	}
+
+	// AddWebhook validates and checks for duplicates
+	_ = res.Webhooks.Named.AddWebhook(webhook)
+}
+
 func (opts Options) updateControllers(res *resource.Resource) {
-	if opts.ControllerName == "" {
-		// No controller name specified: use legacy mode
-		if res.Controllers == nil || res.Controllers.IsEmpty() {
-			res.Controller = true
-		} else {
-			// Warn when trying to use legacy mode on a resource with named controllers
-			log.Warn("resource already has named controllers; use --controller-name to add another controller")
+	controllerName := opts.ControllerName
+	if controllerName == "" {
+		// The owned and watched resources and the finalizer are recorded on the controller entry, so the
+		// default controller is named after the kind, which scaffolds the same code as the legacy mode
+		if len(opts.Owns) == 0 && len(opts.Watches) == 0 && !opts.Finalizer {
+			// No controller name specified: use legacy mode
+			if res.Controllers == nil || res.Controllers.IsEmpty() {
+				res.Controller = true
+			} else {
+				// Warn when trying to use legacy mode on a resource with named controllers
+				log.Warn("resource already has named controllers; use --controller-name to add another controller")
+			}
+			return
 		}
-		return
+		controllerName = strings.ToLower(res.Kind)
 	}
 
 	// Controller name specified: migrate from legacy format if needed
@@ -201,5 +280,14 @@ func (opts Options) updateControllers(res *resource.Resource) {
 	}
 
 	// Add the new named controller (AddController validates and checks for duplicates)
-	_ = res.Controllers.AddController(opts.ControllerName)
+	_ = res.Controllers.AddController(controllerName)
+
+	// Record the owned and watched resources and the finalizer, the controller may already exist when it is
+	// scaffolded again
+	_ = res.Controllers.Update(&resource.Controllers{{
+		Name:      controllerName,
+		Owns:      opts.Owns,
+		Watches:   opts.Watches,
+		Finalizer: opts.Finalizer,
+	}})
 }
@@ -91,6 +91,13 @@ var _ = Describe("Options", func() {
 					Expect(res.API).NotTo(BeNil())
 					if options.DoAPI {
 						Expect(res.API.Namespaced).To(Equal(options.Namespaced))
+						Expect(res.API.ShortNames).To(Equal(options.ShortNames))
+						Expect(res.API.Categories).To(Equal(options.Categories))
+						Expect(res.API.PrintColumns).To(Equal(options.PrintColumns))
+						Expect(res.API.ScaleSubresource).To(Equal(options.ScaleSubresource))
+						Expect(res.API.StorageVersion).To(Equal(options.StorageVersion))
+						Expect(res.API.Conditions).To(Equal(options.Conditions))
+						Expect(res.API.Clients).To(Equal(options.Clients))
 						Expect(res.API.IsEmpty()).To(BeFalse())
 					} else {
 						Expect(res.API.IsEmpty()).To(BeTrue())
@@ -123,6 +130,16 @@ var _ = Describe("Options", func() {
 			Entry("when updating with External API Path", Options{ExternalAPIPath: "testPath", ExternalAPIDomain: "test.io"}),
 			Entry("when updating the API with setting webhooks params",
 				Options{DoAPI: true, DoDefaulting: true, DoValidation: true, DoConversion: true}),
+			Entry("when updating the API with resource modelling settings", Options{
+				DoAPI:            true,
+				ShortNames:       []string{"fm"},
+				Categories:       []string{"crew"},
+				PrintColumns:     []resource.PrintColumn{{Name: "Ready", JSONPath: ".status.ready", Type: "boolean"}},
+				ScaleSubresource: true,
+				StorageVersion:   true,
+				Conditions:       true,
+				Clients:          true,
+			}),
 		)
 
 		DescribeTable("should use core apis",
@@ -198,5 +215,46 @@ var _ = Describe("Options", func() {
 			Entry("for `apps`", "apps", "apps"),
 			Entry("for `authentication`", "authentication", "authentication.k8s.io"),
 		)
+
+		It("should add a named webhook instead of the default ones", func() {
+			res := resource.Resource{
+				GVK:      gvk,
+				Plural:   "firstmates",
+				Webhooks: &resource.Webhooks{Validation: true},
+			}
+
+			options := Options{DoValidation: true, WebhookName: "cross-object", ValidationPath: "/validate-cross"}
+			options.UpdateResource(&res, cfg)
+			Expect(res.Validate()).To(Succeed())
+
+			Expect(res.Webhooks.Named).To(Equal(resource.NamedWebhooks{
+				{Name: "cross-object", Type: resource.ValidationWebhook, Path: "/validate-cross"},
+			}))
+			Expect(res.Webhooks.ValidationPath).To(BeEmpty())
+			Expect(res.HasValidatingWebhook()).To(BeTrue())
+			Expect(res.HasMutatingWebhook()).To(BeFalse())
+		})
+
+		It("should record the owned and watched resources on the default controller entry", func() {
+			res := resource.Resource{
+				GVK:      gvk,
+				Plural:   "firstmates",
+				Webhooks: &resource.Webhooks{},
+			}
+
+			deployment := resource.GVK{Group: "apps", Version: "v1", Kind: "Deployment"}
+			secret := resource.Watch{
+				GVK:    resource.GVK{Group: "core", Version: "v1", Kind: "Secret"},
+				Mapper: "mapSecretToFirstMate",
+			}
+			options := Options{DoController: true, Owns: []resource.GVK{deployment}, Watches: []resource.Watch{secret}}
+			options.UpdateResource(&res, cfg)
+			Expect(res.Validate()).To(Succeed())
+
+			Expect(res.Controller).To(BeFalse())
+			Expect(*res.Controllers).To(Equal(resource.Controllers{
+				{Name: "firstmate", Owns: []resource.GVK{deployment}, Watches: []resource.Watch{secret}},
+			}))
+		})
 	})
 })
@@ -0,0 +1,215 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v4
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/spf13/pflag"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+	pluginutil "sigs.k8s.io/kubebuilder/v4/pkg/plugin/util"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds"
+)
+
+var (
+	_ plugin.Subcommand       = &addVersionSubcommand{}
+	_ plugin.RequiresConfig   = &addVersionSubcommand{}
+	_ plugin.RequiresResource = &addVersionSubcommand{}
+)
+
+type addVersionSubcommand struct {
+	config config.Config
+	// For help text.
+	commandName string
+
+	// from is the existing version whose types are copied
+	from string
+	// source is the resource of the existing version
+	source resource.Resource
+	// resource is the resource of the new version
+	resource *resource.Resource
+
+	// runMake indicates whether to run make or not after adding the version
+	runMake bool
+}
+
+func (p *addVersionSubcommand) UpdateMetadata(cliMeta plugin.CLIMetadata, subcmdMeta *plugin.SubcommandMetadata) {
+	p.commandName = cliMeta.CommandName
+
+	subcmdMeta.Description = `Add a new API version to an existing kind, by copying the types of one of its versions.
+
+The new version:
+  - is scaffolded with the types of the --from version and becomes the storage version of the CRD;
+  - is registered in the scheme of the manager;
+  - becomes the hub of the conversion webhook of the kind, with all its other versions as spokes. The conversion
+    webhook of the previous hub version, if any, is moved to it, and the spokes are converted to the new hub;
+  - gets a sample and the kustomize configuration of the conversion webhook;
+  - gets a job migrating the stored objects to it in config/migration, run by 'make migrate-storage'.
+
+The controllers and the defaulting and validating webhooks of the other versions are kept as is.
+`
+	subcmdMeta.Examples = fmt.Sprintf(`  # Promote Group: ship, Kind: Frigate from v1beta1 to v1
+  %[1]s alpha add-version --group ship --version v1 --kind Frigate --from v1beta1
+`, cliMeta.CommandName)
+}
+
+func (p *addVersionSubcommand) BindFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&p.from, "from", "", "existing version of the kind whose types are copied to the new version")
+	fs.BoolVar(&p.runMake, "make", true, "if true, run `make generate` after adding the version")
+}
+
+func (p *addVersionSubcommand) InjectConfig(c config.Config) error {
+	p.config = c
+	return nil
+}
+
+func (p *addVersionSubcommand) InjectResource(res *resource.Resource) error {
+	if p.from == "" {
+		return errors.New("--from is required, set it to the existing version whose types are copied")
+	}
+	if p.from == res.Version {
+		return fmt.Errorf("--from %q must be different from the new version", p.from)
+	}
+
+	resources, err := p.config.GetResources()
+	if err != nil {
+		return fmt.Errorf("error getting resources: %w", err)
+	}
+
+	// The domain is ignored as the one provided by the CLI is always the project domain
+	var versions []string
+	found := false
+	for _, r := range resources {
+		if r.Group != res.Group || r.Kind != res.Kind || !r.HasAPI() {
+			continue
+		}
+		if r.Version == res.Version {
+			return fmt.Errorf("%s %s already exists", res.Kind, res.Version)
+		}
+		if r.Version == p.from {
+			p.source = r.Copy()
+			found = true
+		}
+		versions = append(versions, r.Version)
+	}
+	if !found {
+		return fmt.Errorf("%s alpha add-version requires a previously created API, "+
+			"no API found for group %q, version %q and kind %q", p.commandName, res.Group, p.from, res.Kind)
+	}
+	if p.source.IsExternal() || p.source.Core {
+		return fmt.Errorf("%s %s is not defined in this project", res.Kind, p.from)
+	}
+	slices.Sort(versions)
+
+	added := p.source.Copy()
+	added.Version = res.Version
+	added.Path = resource.APIPackagePath(p.config.GetRepository(), added.Group, added.Version, p.config.IsMultiGroup())
+	added.API.StorageVersion = true
+	added.Controller = false
+	added.Controllers = nil
+	added.Policies = nil
+	added.Webhooks = &resource.Webhooks{WebhookVersion: "v1", Conversion: true, Spoke: versions}
+
+	if err = added.Validate(); err != nil {
+		return fmt.Errorf("error validating resource: %w", err)
+	}
+	if err = p.checkImportCycle(resources, added); err != nil {
+		return err
+	}
+
+	*res = added
+	p.resource = res
+
+	return nil
+}
+
+// checkImportCycle returns an error if converting the spokes of res to it would make their packages import each
+// other, which happens when the package of res already imports one of them through the conversion of another kind.
+func (p *addVersionSubcommand) checkImportCycle(resources []resource.Resource, res resource.Resource) error {
+	packagePath := func(version string) string {
+		return resource.APIPackagePath(p.config.GetRepository(), res.Group, version, p.config.IsMultiGroup())
+	}
+
+	// The packages of the spoke versions import the package of their hub version
+	imports := make(map[string][]string)
+	for _, r := range resources {
+		if !r.HasConversionWebhook() || r.Group == res.Group && r.Kind == res.Kind {
+			continue
+		}
+		for _, spoke := range r.Webhooks.Spoke {
+			spokePath := resource.APIPackagePath(p.config.GetRepository(), r.Group, spoke, p.config.IsMultiGroup())
+			imports[spokePath] = append(imports[spokePath], r.Path)
+		}
+	}
+
+	visited := make(map[string]bool)
+	queue := []string{res.Path}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, spoke := range res.Webhooks.Spoke {
+			if current == packagePath(spoke) {
+				return fmt.Errorf("%s %s cannot be the hub of %s: %s already imports %s through the conversion "+
+					"of other kinds, which would make an import cycle", res.Kind, res.Version, spoke,
+					res.Path, current)
+			}
+		}
+		for _, imported := range imports[current] {
+			if !visited[imported] {
+				visited[imported] = true
+				queue = append(queue, imported)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (p *addVersionSubcommand) Scaffold(fs machinery.Filesystem) error {
+	scaffolder := scaffolds.NewAddVersionScaffolder(p.config, p.source, *p.resource)
+	scaffolder.InjectFS(fs)
+	if err := scaffolder.Scaffold(); err != nil {
+		return fmt.Errorf("failed to add version: %w", err)
+	}
+	return nil
+}
+
+func (p *addVersionSubcommand) PostScaffold() error {
+	err := pluginutil.RunCmd("Update dependencies", "go", "mod", "tidy")
+	if err != nil {
+		return fmt.Errorf("error updating go dependencies: %w", err)
+	}
+
+	if p.runMake {
+		err = pluginutil.RunCmd("Running make", "make", "generate")
+		if err != nil {
+			return fmt.Errorf("error running make generate: %w", err)
+		}
+	}
+
+	fmt.Print("Next: implement the conversion of the fields flagged in the spoke versions, " +
+		"and regenerate the manifests with:\n$ make manifests\n" +
+		"Once deployed, migrate the stored objects to the new storage version with:\n$ make migrate-storage\n")
+
+	return nil
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v4
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/pflag"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	cfgv3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+)
+
+var _ = Describe("addVersionSubcommand", func() {
+	var (
+		subCmd *addVersionSubcommand
+		cfg    config.Config
+		res    *resource.Resource
+	)
+
+	captain := func(version string) resource.Resource {
+		return resource.Resource{
+			GVK:        resource.GVK{Group: "crew", Domain: "test.io", Version: version, Kind: "Captain"},
+			Plural:     "captains",
+			Path:       "github.com/example/test/api/" + version,
+			API:        &resource.API{CRDVersion: "v1", Namespaced: true},
+			Controller: true,
+		}
+	}
+
+	inject := func(args ...string) error {
+		fs := pflag.NewFlagSet("add-version", pflag.ContinueOnError)
+		subCmd.BindFlags(fs)
+		Expect(fs.Parse(args)).To(Succeed())
+		Expect(subCmd.InjectConfig(cfg)).To(Succeed())
+		return subCmd.InjectResource(res)
+	}
+
+	BeforeEach(func() {
+		subCmd = &addVersionSubcommand{}
+		cfg = cfgv3.New()
+		Expect(cfg.SetRepository("github.com/example/test")).To(Succeed())
+		Expect(cfg.SetDomain("test.io")).To(Succeed())
+
+		Expect(cfg.AddResource(captain("v1alpha1"))).To(Succeed())
+		Expect(cfg.AddResource(captain("v1beta1"))).To(Succeed())
+
+		res = &resource.Resource{GVK: resource.GVK{Group: "crew", Domain: "test.io", Version: "v1", Kind: "Captain"}}
+	})
+
+	It("should require --from", func() {
+		err := inject()
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("--from is required"))
+	})
+
+	It("should fail when the source version does not exist", func() {
+		err := inject("--from", "v2")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("requires a previously created API"))
+	})
+
+	It("should fail when the new version already exists", func() {
+		res.Version = "v1alpha1"
+		err := inject("--from", "v1beta1")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("already exists"))
+	})
+
+	It("should make the new version the storage version and the hub of all the other versions", func() {
+		Expect(inject("--from", "v1beta1")).To(Succeed())
+
+		Expect(res.Version).To(Equal("v1"))
+		Expect(res.Plural).To(Equal("captains"))
+		Expect(res.Path).To(Equal("github.com/example/test/api/v1"))
+		Expect(res.API.StorageVersion).To(BeTrue())
+		Expect(res.HasController()).To(BeFalse())
+		Expect(res.HasConversionWebhook()).To(BeTrue())
+		Expect(res.Webhooks.Spoke).To(Equal([]string{"v1alpha1", "v1beta1"}))
+		Expect(subCmd.source.Version).To(Equal("v1beta1"))
+	})
+
+	It("should fail when the hub would import its spokes through the conversion of other kinds", func() {
+		sailor := resource.Resource{
+			GVK:      resource.GVK{Group: "crew", Domain: "test.io", Version: "v1beta1", Kind: "Sailor"},
+			Plural:   "sailors",
+			Path:     "github.com/example/test/api/v1beta1",
+			API:      &resource.API{CRDVersion: "v1", Namespaced: true},
+			Webhooks: &resource.Webhooks{WebhookVersion: "v1", Conversion: true, Spoke: []string{"v1"}},
+		}
+		Expect(cfg.AddResource(sailor)).To(Succeed())
+
+		err := inject("--from", "v1beta1")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("import cycle"))
+	})
+})
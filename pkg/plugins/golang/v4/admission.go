@@ -0,0 +1,157 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v4
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/pflag"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+)
+
+// admissionFlags binds the flags that configure the admission of defaulting and validating webhooks.
+type admissionFlags struct {
+	failurePolicy      string
+	sideEffects        string
+	timeoutSeconds     int
+	matchPolicy        string
+	reinvocationPolicy string
+	operations         []string
+	namespaceSelector  []string
+	objectSelector     []string
+
+	// fs stores the FlagSet to check if flags were explicitly set
+	fs *pflag.FlagSet
+}
+
+func (f *admissionFlags) bindFlags(fs *pflag.FlagSet) {
+	f.fs = fs
+
+	fs.StringVar(&f.failurePolicy, "failure-policy", "",
+		"how errors calling the webhook are handled, Fail or Ignore")
+	fs.StringVar(&f.sideEffects, "side-effects", "",
+		"whether the webhook has side effects, None or NoneOnDryRun")
+	fs.IntVar(&f.timeoutSeconds, "timeout-seconds", 0,
+		"timeout of the webhook calls, between 1 and 30 seconds")
+	fs.StringVar(&f.matchPolicy, "match-policy", "",
+		"how the webhook rules match the incoming requests, Exact or Equivalent")
+	fs.StringVar(&f.reinvocationPolicy, "reinvocation-policy", "",
+		"whether the defaulting webhook can be called again, Never or IfNeeded")
+	fs.StringSliceVar(&f.operations, "operations", nil,
+		"Comma-separated list of operations the webhook is called for (e.g., --operations CREATE,UPDATE,DELETE)")
+	fs.StringSliceVar(&f.namespaceSelector, "namespace-selector", nil,
+		"labels the namespace of an object must have for the webhook to be called (e.g., env=prod,team=core), "+
+			"an empty value removes the selector")
+	fs.StringSliceVar(&f.objectSelector, "object-selector", nil,
+		"labels an object must have for the webhook to be called (e.g., app=frigate), "+
+			"an empty value removes the selector")
+}
+
+// isSet returns true if any of the admission flags was explicitly set.
+func (f *admissionFlags) isSet() bool {
+	if f.fs == nil {
+		return false
+	}
+
+	for _, name := range []string{
+		"failure-policy", "side-effects", "timeout-seconds", "match-policy",
+		"reinvocation-policy", "operations", "namespace-selector", "object-selector",
+	} {
+		if f.fs.Changed(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// apply returns a copy of config with the admission flags that were explicitly set.
+// The reinvocation policy only applies to defaulting webhooks and is ignored otherwise.
+func (f *admissionFlags) apply(config *resource.AdmissionConfig, mutating bool) (*resource.AdmissionConfig, error) {
+	applied := config.Copy()
+	if applied == nil {
+		applied = &resource.AdmissionConfig{}
+	}
+
+	if f.fs.Changed("failure-policy") {
+		applied.FailurePolicy = f.failurePolicy
+	}
+	if f.fs.Changed("side-effects") {
+		applied.SideEffects = f.sideEffects
+	}
+	if f.fs.Changed("timeout-seconds") {
+		applied.TimeoutSeconds = f.timeoutSeconds
+	}
+	if f.fs.Changed("match-policy") {
+		applied.MatchPolicy = f.matchPolicy
+	}
+	if f.fs.Changed("reinvocation-policy") && mutating {
+		applied.ReinvocationPolicy = f.reinvocationPolicy
+	}
+	if f.fs.Changed("operations") {
+		applied.Operations = nil
+		for _, operation := range f.operations {
+			applied.Operations = append(applied.Operations, strings.ToUpper(strings.TrimSpace(operation)))
+		}
+	}
+	if f.fs.Changed("namespace-selector") {
+		selector, err := parseSelector(f.namespaceSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --namespace-selector: %w", err)
+		}
+		applied.NamespaceSelector = selector
+	}
+	if f.fs.Changed("object-selector") {
+		selector, err := parseSelector(f.objectSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --object-selector: %w", err)
+		}
+		applied.ObjectSelector = selector
+	}
+
+	if applied.IsEmpty() {
+		return nil, nil
+	}
+	return applied, nil
+}
+
+// parseSelector returns the labels of a selector passed as key=value pairs.
+func parseSelector(pairs []string) (map[string]string, error) {
+	if len(pairs) == 0 {
+		return nil, nil
+	}
+
+	selector := make(map[string]string, len(pairs))
+	for _, pair := range pairs {
+		key, value, found := strings.Cut(strings.TrimSpace(pair), "=")
+		if !found || key == "" {
+			return nil, fmt.Errorf("%q must be formatted as key=value", pair)
+		}
+		selector[key] = value
+	}
+	return selector, nil
+}
+
+// validateTargets checks that the reinvocation policy is only set when a defaulting webhook is configured.
+func (f *admissionFlags) validateTargets(mutating bool) error {
+	if f.fs.Changed("reinvocation-policy") && !mutating {
+		return errors.New("--reinvocation-policy can only be used with defaulting webhooks")
+	}
+	return nil
+}
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	log "log/slog"
 	"os"
+	"slices"
 	"strings"
 
 	"github.com/spf13/pflag"
@@ -43,6 +44,9 @@ var _ plugin.CreateAPISubcommand = &createAPISubcommand{}
 type createAPISubcommand struct {
 	config config.Config
 
+	// commandName is the name of the CLI, used in the next steps
+	commandName string
+
 	options *goPlugin.Options
 
 	resource *resource.Resource
@@ -51,6 +55,26 @@ type createAPISubcommand struct {
 	resourceFlag   *pflag.Flag
 	controllerFlag *pflag.Flag
 
+	// Check if the clients of the API were requested, otherwise they follow the other APIs of the project
+	clientsFlag *pflag.Flag
+
+	// printColumns holds the raw values of the --printcolumn flag
+	printColumns []string
+
+	// specFields and statusFields hold the raw values of the --spec-field and --status-field flags
+	specFields   []string
+	statusFields []string
+
+	// celRules hold the raw values of the --cel-rule flag
+	celRules []string
+
+	// owns and watches hold the raw values of the --owns and --watches flags
+	owns    []string
+	watches []string
+
+	// fromCRD is the path of the CRD manifest that the API is created from
+	fromCRD string
+
 	// force indicates that the resource should be created even if it already exists
 	force bool
 
@@ -59,8 +83,21 @@ type createAPISubcommand struct {
 }
 
 func (p *createAPISubcommand) UpdateMetadata(cliMeta plugin.CLIMetadata, subcmdMeta *plugin.SubcommandMetadata) {
+	p.commandName = cliMeta.CommandName
+
 	subcmdMeta.Description = `Scaffold a Kubernetes API by writing a Resource definition and/or a Controller.
 
+With --from-crd, the API of an existing CRD is created: the group, kind, plural,
+scope, short names, categories and printer columns are read from the CRD, and
+the Go types of each served version are generated from its OpenAPI v3 schema,
+with the markers of its validations (enum, pattern, minimum and maximum,
+required, default and x-kubernetes-validations).
+
+With --cel-rule, x-kubernetes-validations rules are added to the types in the
+format path:rule[:message], where path is the JSON path of the validated field,
+e.g. spec.replicas, or empty to validate the whole object. The rules are checked
+with a CEL parser before their markers are written.
+
 If information about whether the resource and controller should be scaffolded
 was not explicitly provided, it will prompt the user if they should be.
 
@@ -70,6 +107,34 @@ make generate will be run.
 	subcmdMeta.Examples = fmt.Sprintf(`  # Create a frigates API with Group: ship, Version: v1beta1 and Kind: Frigate
   %[1]s create api --group ship --version v1beta1 --kind Frigate
 
+  # Create a frigates API with spec and status fields
+  %[1]s create api --group ship --version v1beta1 --kind Frigate \
+    --spec-field crew:int32:required,min=1 --spec-field captainRef:Captain --status-field ready:bool
+
+  # Create a frigates API whose controller manages the Available, Progressing and Degraded conditions
+  %[1]s create api --group ship --version v1beta1 --kind Frigate --conditions
+
+  # Create a frigates API whose controller runs a cleanup before the Frigate objects are deleted
+  %[1]s create api --group ship --version v1beta1 --kind Frigate --finalizer
+
+  # Create a frigates API whose controller owns Deployments and watches the Secrets
+  %[1]s create api --group ship --version v1beta1 --kind Frigate \
+    --owns apps/v1/Deployment --watches core/v1/Secret:findFrigatesForSecret
+
+  # Create a frigates API whose controller applies the desired objects with server-side apply
+  %[1]s create api --group ship --version v1beta1 --kind Frigate --controller-style server-side-apply
+
+  # Create a frigates API with its typed clientset, listers, informers and apply configurations
+  %[1]s create api --group ship --version v1beta1 --kind Frigate --clients
+
+  # Create a frigates API whose crew must not exceed its capacity, checked by a CEL rule
+  %[1]s create api --group ship --version v1beta1 --kind Frigate \
+    --spec-field crew:int32 --spec-field capacity:int32 \
+    --cel-rule 'spec:!has(self.crew) || !has(self.capacity) || self.crew <= self.capacity:too many crew members'
+
+  # Create the API of an existing CRD, generating the Go types of its served versions from their schema
+  %[1]s create api --from-crd path/to/crd.yaml
+
   # Edit the API Scheme
 
   nano api/v1beta1/frigate_types.go
@@ -106,12 +171,59 @@ func (p *createAPISubcommand) BindFlags(fs *pflag.FlagSet) {
 	p.resourceFlag = fs.Lookup("resource")
 	fs.BoolVar(&p.options.Namespaced, "namespaced", true, "resource is namespaced")
 
+	fs.StringSliceVar(&p.options.ShortNames, "short-names", nil,
+		"comma-separated list of short names for the resource (e.g., --short-names fg,frg)")
+	fs.StringSliceVar(&p.options.Categories, "categories", nil,
+		"comma-separated list of categories the resource belongs to (e.g., --categories all,ship)")
+	fs.StringArrayVar(&p.printColumns, "printcolumn", nil,
+		"additional printer column in the format name:jsonpath:type (e.g., --printcolumn Ready:.status.ready:boolean), "+
+			"can be repeated")
+	fs.BoolVar(&p.options.ScaleSubresource, "scale-subresource", false,
+		"enable the scale subresource, adding replicas and selector fields to the spec and status")
+	fs.BoolVar(&p.options.StorageVersion, "storage-version", false,
+		"mark this version as the storage version, removing the marker from the other versions of the kind "+
+			"and scaffolding the migration of their stored objects")
+	fs.BoolVar(&p.options.Conditions, "conditions", false,
+		"manage the Available, Progressing and Degraded status conditions in the scaffolded controllers")
+	fs.BoolVar(&p.options.Clients, "clients", false,
+		"generate the typed clientset, listers, informers and apply configurations of the API in pkg/client "+
+			"with 'make generate-clients', enabled by default once the project generates the clients of an API")
+	p.clientsFlag = fs.Lookup("clients")
+	fs.StringArrayVar(&p.specFields, "spec-field", nil,
+		"spec field in the format name:type[:validation] (e.g., --spec-field replicas:int32:min=1,max=10), "+
+			"can be repeated")
+	fs.StringArrayVar(&p.statusFields, "status-field", nil,
+		"status field in the format name:type[:validation] (e.g., --status-field ready:bool), can be repeated")
+	fs.StringArrayVar(&p.celRules, "cel-rule", nil,
+		"CEL validation rule in the format path:rule[:message] (e.g., --cel-rule 'spec.replicas:self >= 0'), "+
+			"with an empty path for the rules of the whole object, can be repeated")
+	fs.StringVar(&p.fromCRD, "from-crd", "",
+		"path of a CRD manifest to create the API from, taking the group, kind, plural, scope and the other "+
+			"settings of the resource from the CRD and generating the Go types of its served versions "+
+			"from their OpenAPI v3 schema, the controller is scaffolded for --version which defaults to "+
+			"the storage version")
+
 	fs.BoolVar(&p.options.DoController, "controller", true,
 		"if set, generate the controller without prompting the user")
 	p.controllerFlag = fs.Lookup("controller")
 
 	fs.StringVar(&p.options.ControllerName, "controller-name", "",
 		"name of the controller to scaffold (allows multiple controllers per resource)")
+	fs.BoolVar(&p.options.Finalizer, "finalizer", false,
+		"add a finalizer to the resources reconciled by the controller, running a cleanup stub before their deletion")
+	styles := make([]string, 0, len(scaffolds.ControllerStyleNames()))
+	for _, name := range scaffolds.ControllerStyleNames() {
+		style, _ := scaffolds.GetControllerStyle(name)
+		styles = append(styles, fmt.Sprintf("%s (%s)", name, style.Description))
+	}
+	fs.StringVar(&p.options.ControllerStyle, "controller-style", scaffolds.DefaultControllerStyle,
+		"template of the scaffolded controller, one of: "+strings.Join(styles, ", "))
+	fs.StringSliceVar(&p.owns, "owns", nil,
+		"comma-separated list of resources owned by the controller in the format group/version/Kind "+
+			"(e.g., --owns apps/v1/Deployment,core/v1/Service)")
+	fs.StringSliceVar(&p.watches, "watches", nil,
+		"comma-separated list of resources watched by the controller in the format group/version/Kind[:mapper], "+
+			"the mapper function defaults to map<Kind>To<reconciled Kind> (e.g., --watches core/v1/Secret)")
 
 	fs.StringVar(&p.options.ExternalAPIPath, "external-api-path", "",
 		"Specify the Go package import path for the external API. This is used to scaffold controllers for resources "+
@@ -168,6 +280,26 @@ func (p *createAPISubcommand) InjectResource(res *resource.Resource) error {
 		)
 	}
 
+	if err := p.parseCRD(); err != nil {
+		return err
+	}
+
+	if err := p.parseControllerStyle(); err != nil {
+		return err
+	}
+
+	if err := p.parseResourceModellingFlags(); err != nil {
+		return err
+	}
+
+	if err := p.parseWatchedResources(); err != nil {
+		return err
+	}
+
+	if err := p.parseClients(); err != nil {
+		return err
+	}
+
 	// Validate that --external-api-module requires --external-api-path
 	if len(p.options.ExternalAPIModule) != 0 && len(p.options.ExternalAPIPath) == 0 {
 		return errors.New("'--external-api-module' requires '--external-api-path' to be specified")
@@ -186,6 +318,249 @@ func (p *createAPISubcommand) InjectResource(res *resource.Resource) error {
 	return p.validateController()
 }
 
+// parseControllerStyle ensures that the controller style is registered, and enables the status conditions
+// of the API being scaffolded when the controllers of the style manage them.
+func (p *createAPISubcommand) parseControllerStyle() error {
+	style, err := scaffolds.GetControllerStyle(p.options.ControllerStyle)
+	if err != nil {
+		return fmt.Errorf("invalid value for '--controller-style': %w", err)
+	}
+
+	if !p.options.DoController && p.options.ControllerStyle != "" &&
+		p.options.ControllerStyle != scaffolds.DefaultControllerStyle {
+		return errors.New("'--controller-style' can only be used when scaffolding a controller " +
+			"with '--controller=true'")
+	}
+
+	if style.Conditions && p.options.DoAPI {
+		p.options.Conditions = true
+	}
+
+	return nil
+}
+
+// parseClients ensures that the clients are only generated for the APIs of the project, and generates them
+// by default for the new APIs and versions of the projects that already generate the clients of an API.
+func (p *createAPISubcommand) parseClients() error {
+	if !p.options.DoAPI {
+		if p.options.Clients {
+			return errors.New("'--clients' can only be used when creating the API with '--resource=true'")
+		}
+		return nil
+	}
+
+	if p.clientsFlag.Changed {
+		return nil
+	}
+
+	resources, err := p.config.GetResources()
+	if err != nil {
+		return fmt.Errorf("error getting resources: %w", err)
+	}
+	for _, res := range resources {
+		if res.HasAPI() && res.API.Clients {
+			log.Info("Generating the clients of the API, as the project generates the clients of its APIs")
+			p.options.Clients = true
+			break
+		}
+	}
+
+	return nil
+}
+
+// parseResourceModellingFlags parses the printer columns and ensures that the flags which
+// customize the CRD are only used when the API is being scaffolded.
+func (p *createAPISubcommand) parseResourceModellingFlags() error {
+	if !p.options.DoAPI &&
+		(len(p.options.ShortNames) != 0 ||
+			len(p.options.Categories) != 0 ||
+			len(p.printColumns) != 0 ||
+			p.options.ScaleSubresource ||
+			p.options.StorageVersion ||
+			p.options.Conditions ||
+			len(p.specFields) != 0 ||
+			len(p.statusFields) != 0 ||
+			len(p.celRules) != 0) {
+		return errors.New(
+			"'--short-names', '--categories', '--printcolumn', '--scale-subresource', '--storage-version', " +
+				"'--conditions', '--spec-field', '--status-field' and '--cel-rule' can only be used when " +
+				"creating the API with '--resource=true'",
+		)
+	}
+
+	// The printer columns of the APIs created from a CRD are read from it
+	if p.options.CRD == nil {
+		p.options.PrintColumns = nil
+	}
+	for _, value := range p.printColumns {
+		column, err := resource.ParsePrintColumn(value)
+		if err != nil {
+			return fmt.Errorf("invalid value for '--printcolumn': %w", err)
+		}
+		p.options.PrintColumns = append(p.options.PrintColumns, column)
+	}
+
+	// The scaffolded types already define some fields
+	reservedSpec, reservedStatus := []string{}, []string{"conditions"}
+	if p.options.ScaleSubresource {
+		reservedSpec = append(reservedSpec, "replicas")
+		reservedStatus = append(reservedStatus, "replicas", "selector")
+	}
+	if p.options.Conditions {
+		reservedStatus = append(reservedStatus, "observedGeneration")
+	}
+
+	var err error
+	if p.options.SpecFields, err = parseFields("--spec-field", p.specFields, reservedSpec); err != nil {
+		return err
+	}
+	if p.options.StatusFields, err = parseFields("--status-field", p.statusFields, reservedStatus); err != nil {
+		return err
+	}
+	if p.options.CELRules, err = parseCELRules(p.celRules); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// parseCRD loads the CRD that the API is created from, taking the group, version and kind of the resource
+// and its settings from the CRD, and generates the Go types of its served versions.
+func (p *createAPISubcommand) parseCRD() error {
+	if p.fromCRD == "" {
+		return nil
+	}
+
+	if !p.options.DoAPI {
+		return errors.New("'--from-crd' can only be used when creating the API with '--resource=true'")
+	}
+	if p.options.Plural != "" ||
+		len(p.options.ShortNames) != 0 ||
+		len(p.options.Categories) != 0 ||
+		len(p.printColumns) != 0 ||
+		p.options.ScaleSubresource ||
+		p.options.StorageVersion ||
+		len(p.specFields) != 0 ||
+		len(p.statusFields) != 0 {
+		return errors.New(
+			"'--plural', '--short-names', '--categories', '--printcolumn', '--scale-subresource', " +
+				"'--storage-version', '--spec-field' and '--status-field' cannot be used with '--from-crd', " +
+				"the API is defined by the CRD",
+		)
+	}
+
+	crd, err := goPlugin.LoadCRD(p.fromCRD)
+	if err != nil {
+		return fmt.Errorf("invalid value for '--from-crd': %w", err)
+	}
+
+	group, domain := crd.SplitGroup(p.config.GetDomain())
+	if p.resource.Group != "" && p.resource.Group != group {
+		return fmt.Errorf("'--group' %q does not match the group %q of the CRD", p.resource.Group, crd.Spec.Group)
+	}
+	if p.resource.Kind != "" && p.resource.Kind != crd.Spec.Names.Kind {
+		return fmt.Errorf("'--kind' %q does not match the kind %q of the CRD", p.resource.Kind, crd.Spec.Names.Kind)
+	}
+	if p.resource.Version == "" {
+		p.resource.Version = crd.DefaultVersion()
+	}
+	if _, found := crd.ServedVersion(p.resource.Version); !found {
+		return fmt.Errorf("'--version' %q is not a served version of the CRD", p.resource.Version)
+	}
+	p.resource.Group, p.resource.Domain, p.resource.Kind = group, domain, crd.Spec.Names.Kind
+
+	p.options.CRDTypes = make(map[string]*goPlugin.SchemaTypes)
+	for _, res := range crd.Resources(p.config) {
+		if r, err := p.config.GetResource(res.GVK); err == nil && r.HasAPI() && !p.force {
+			return fmt.Errorf("API resource %q of the CRD already exists", res.GVK)
+		}
+
+		version, _ := crd.ServedVersion(res.Version)
+		if p.options.CRDTypes[res.Version], err = goPlugin.GenerateTypes(res.Kind, version); err != nil {
+			return fmt.Errorf("invalid value for '--from-crd': %w", err)
+		}
+
+		if res.Version == p.resource.Version {
+			p.options.Plural = res.Plural
+			p.options.Namespaced = res.API.Namespaced
+			p.options.ShortNames = res.API.ShortNames
+			p.options.Categories = res.API.Categories
+			p.options.PrintColumns = res.API.PrintColumns
+			p.options.StorageVersion = res.API.StorageVersion
+		}
+	}
+	p.options.CRD = crd
+
+	return nil
+}
+
+// parseWatchedResources parses the resources owned and watched by the scaffolded controller.
+func (p *createAPISubcommand) parseWatchedResources() error {
+	if !p.options.DoController && (len(p.owns) != 0 || len(p.watches) != 0) {
+		return errors.New("'--owns' and '--watches' can only be used when scaffolding a controller " +
+			"with '--controller=true'")
+	}
+
+	p.options.Owns = nil
+	for _, value := range p.owns {
+		gvk, err := goPlugin.ParseOwnedResource(p.config, value)
+		if err != nil {
+			return fmt.Errorf("invalid value for '--owns': %w", err)
+		}
+		if gvk.IsEqualTo(p.resource.GVK) {
+			return errors.New("invalid value for '--owns': the controller cannot own the resource it reconciles")
+		}
+		p.options.Owns = append(p.options.Owns, gvk)
+	}
+
+	p.options.Watches = nil
+	for _, value := range p.watches {
+		watch, err := goPlugin.ParseWatchedResource(p.config, value, p.resource.Kind)
+		if err != nil {
+			return fmt.Errorf("invalid value for '--watches': %w", err)
+		}
+		if watch.IsEqualTo(p.resource.GVK) {
+			return errors.New("invalid value for '--watches': " +
+				"the controller already watches the resource it reconciles")
+		}
+		p.options.Watches = append(p.options.Watches, watch)
+	}
+
+	return nil
+}
+
+// parseFields parses the values of the flag into fields, ensuring that their names are unique and not reserved.
+func parseFields(flag string, values, reserved []string) ([]goPlugin.Field, error) {
+	fields := make([]goPlugin.Field, 0, len(values))
+	names := slices.Clone(reserved)
+	for _, value := range values {
+		field, err := goPlugin.ParseField(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for '%s': %w", flag, err)
+		}
+		if slices.Contains(names, field.Name) {
+			return nil, fmt.Errorf("invalid value for '%s': field %q is duplicated or already scaffolded",
+				flag, field.Name)
+		}
+		names = append(names, field.Name)
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+// parseCELRules parses the values of the --cel-rule flag.
+func parseCELRules(values []string) ([]goPlugin.CELRule, error) {
+	rules := make([]goPlugin.CELRule, 0, len(values))
+	for _, value := range values {
+		rule, err := goPlugin.ParseCELRule(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for '--cel-rule': %w", err)
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
 func (p *createAPISubcommand) validateAPI() error {
 	if !p.options.DoAPI {
 		return nil
@@ -209,9 +584,33 @@ func (p *createAPISubcommand) validateAPI() error {
 
 func (p *createAPISubcommand) validateController() error {
 	if !p.options.DoController {
+		if p.options.Finalizer {
+			return errors.New("'--finalizer' can only be used when scaffolding a controller with '--controller=true'")
+		}
 		return nil
 	}
 
+	if p.options.Finalizer && p.resource.Path == "" {
+		return errors.New("'--finalizer' requires a resource with a Go type to reconcile, " +
+			"create the API or use a core type or '--external-api-path'")
+	}
+
+	style, err := scaffolds.GetControllerStyle(p.options.ControllerStyle)
+	if err != nil {
+		return fmt.Errorf("invalid value for '--controller-style': %w", err)
+	}
+	if style.RequiresType && p.resource.Path == "" {
+		return fmt.Errorf("'--controller-style %s' requires a resource with a Go type to reconcile, "+
+			"create the API or use a core type or '--external-api-path'", p.options.ControllerStyle)
+	}
+	if style.Conditions && !p.options.DoAPI {
+		if res, err := p.config.GetResource(p.resource.GVK); err != nil ||
+			!res.HasAPI() || res.External || !res.API.Conditions {
+			return fmt.Errorf("'--controller-style %s' requires an API whose status conditions are managed "+
+				"by the controllers, scaffolded with '--conditions'", p.options.ControllerStyle)
+		}
+	}
+
 	existingRes, err := p.config.GetResource(p.resource.GVK)
 	if err != nil {
 		// Resource does not exist yet, no validation needed
@@ -296,7 +695,7 @@ func (p *createAPISubcommand) PreScaffold(machinery.Filesystem) error {
 }
 
 func (p *createAPISubcommand) Scaffold(fs machinery.Filesystem) error {
-	scaffolder := scaffolds.NewAPIScaffolder(p.config, *p.resource, p.force)
+	scaffolder := scaffolds.NewAPIScaffolder(p.config, *p.resource, p.force, *p.options)
 	scaffolder.InjectFS(fs)
 	if err := scaffolder.Scaffold(); err != nil {
 		return fmt.Errorf("error scaffolding API: %w", err)
@@ -327,6 +726,17 @@ func (p *createAPISubcommand) PostScaffold() error {
 		}
 		fmt.Print("Next: implement your new API and generate the manifests (e.g. CRDs,CRs) with:\n$ make manifests\n")
 	}
+	if p.options.CRD != nil && p.options.CRD.UsesConversionWebhook() {
+		hub, spokes := p.options.CRD.DefaultVersion(), []string{}
+		for _, version := range p.options.CRD.ServedVersions() {
+			if version.Name != hub {
+				spokes = append(spokes, version.Name)
+			}
+		}
+		fmt.Printf("Next: the CRD converts its versions with a webhook, scaffold it with:\n"+
+			"$ %s create webhook --group %s --version %s --kind %s --conversion --spoke %s\n",
+			p.commandName, p.resource.Group, hub, p.resource.Kind, strings.Join(spokes, ","))
+	}
 
 	return nil
 }
@@ -17,6 +17,9 @@ limitations under the License.
 package v4
 
 import (
+	"os"
+	"path/filepath"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/spf13/pflag"
@@ -42,6 +45,7 @@ var _ = Describe("createAPISubcommand", func() {
 		subCmd.options = &goPlugin.Options{}
 		subCmd.resourceFlag = &pflag.Flag{Changed: true}
 		subCmd.controllerFlag = &pflag.Flag{Changed: true}
+		subCmd.clientsFlag = &pflag.Flag{}
 
 		res = &resource.Resource{
 			GVK: resource.GVK{
@@ -155,4 +159,314 @@ var _ = Describe("createAPISubcommand", func() {
 
 		Expect(subCmd.InjectResource(res)).To(Succeed())
 	})
+
+	It("should parse the printer columns into the resource", func() {
+		subCmd.options.DoAPI = true
+		subCmd.options.ShortNames = []string{"cpt"}
+		subCmd.printColumns = []string{"Ready:.status.ready:boolean"}
+
+		Expect(subCmd.InjectResource(res)).To(Succeed())
+		Expect(res.API.ShortNames).To(Equal([]string{"cpt"}))
+		Expect(res.API.PrintColumns).To(Equal([]resource.PrintColumn{
+			{Name: "Ready", JSONPath: ".status.ready", Type: "boolean"},
+		}))
+	})
+
+	It("should reject invalid printer columns", func() {
+		subCmd.options.DoAPI = true
+		subCmd.printColumns = []string{"Ready:.status.ready"}
+
+		err := subCmd.InjectResource(res)
+
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("invalid value for '--printcolumn'"))
+	})
+
+	It("should reject resource modelling flags when not creating the API", func() {
+		subCmd.options.DoAPI = false
+		subCmd.options.DoController = true
+		subCmd.options.StorageVersion = true
+
+		err := subCmd.InjectResource(res)
+
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("can only be used when creating the API"))
+	})
+
+	It("should parse the spec and status fields", func() {
+		subCmd.options.DoAPI = true
+		subCmd.specFields = []string{"replicas:int32:min=1", "image:string:required"}
+		subCmd.statusFields = []string{"ready:bool"}
+
+		Expect(subCmd.InjectResource(res)).To(Succeed())
+		Expect(subCmd.options.SpecFields).To(HaveLen(2))
+		Expect(subCmd.options.SpecFields[1].Required).To(BeTrue())
+		Expect(subCmd.options.StatusFields).To(HaveLen(1))
+	})
+
+	It("should reject duplicated and already scaffolded fields", func() {
+		subCmd.options.DoAPI = true
+		subCmd.specFields = []string{"image:string", "image:string"}
+
+		err := subCmd.InjectResource(res)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(`field "image" is duplicated or already scaffolded`))
+
+		subCmd.specFields = nil
+		subCmd.statusFields = []string{"conditions:[]string"}
+		err = subCmd.InjectResource(res)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("invalid value for '--status-field'"))
+	})
+
+	It("should reserve the observedGeneration status field when managing conditions", func() {
+		subCmd.options.DoAPI = true
+		subCmd.options.Conditions = true
+		subCmd.statusFields = []string{"observedGeneration:int64"}
+
+		err := subCmd.InjectResource(res)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(`field "observedGeneration" is duplicated or already scaffolded`))
+	})
+
+	It("should reject a finalizer without a controller", func() {
+		subCmd.options.DoAPI = true
+		subCmd.options.DoController = false
+		subCmd.options.Finalizer = true
+
+		err := subCmd.InjectResource(res)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("'--finalizer' can only be used when scaffolding a controller"))
+	})
+
+	It("should reject a finalizer for a resource without a Go type", func() {
+		subCmd.options.DoAPI = false
+		subCmd.options.DoController = true
+		subCmd.options.Finalizer = true
+
+		err := subCmd.InjectResource(res)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("'--finalizer' requires a resource with a Go type"))
+	})
+
+	It("should reject owned and watched resources without a controller", func() {
+		subCmd.options.DoAPI = true
+		subCmd.options.DoController = false
+		subCmd.owns = []string{"apps/v1/Deployment"}
+
+		err := subCmd.InjectResource(res)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("'--owns' and '--watches' can only be used when scaffolding a controller"))
+	})
+
+	It("should record the owned and watched resources on the controller", func() {
+		subCmd.options.DoAPI = true
+		subCmd.options.DoController = true
+		subCmd.owns = []string{"apps/v1/Deployment"}
+		subCmd.watches = []string{"core/v1/Secret"}
+
+		Expect(subCmd.InjectResource(res)).To(Succeed())
+		Expect(res.Controllers).NotTo(BeNil())
+		Expect(*res.Controllers).To(HaveLen(1))
+		controller := (*res.Controllers)[0]
+		Expect(controller.Name).To(Equal("captain"))
+		Expect(controller.Owns).To(Equal([]resource.GVK{{Group: "apps", Version: "v1", Kind: "Deployment"}}))
+		Expect(controller.Watches).To(HaveLen(1))
+		Expect(controller.Watches[0].Mapper).To(Equal("mapSecretToCaptain"))
+	})
+
+	It("should record the finalizer of a second named controller", func() {
+		Expect(cfg.AddResource(resource.Resource{
+			GVK:        res.GVK,
+			Plural:     "captains",
+			Path:       "github.com/example/test/api/v1",
+			API:        &resource.API{CRDVersion: "v1", Namespaced: true},
+			Controller: true,
+		})).To(Succeed())
+
+		subCmd.options.DoAPI = false
+		subCmd.options.DoController = true
+		subCmd.options.ControllerName = "captain-backup"
+		subCmd.options.Finalizer = true
+
+		Expect(subCmd.InjectResource(res)).To(Succeed())
+		Expect(cfg.UpdateResource(*res)).To(Succeed())
+
+		stored, err := cfg.GetResource(res.GVK)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stored.Controllers).NotTo(BeNil())
+		Expect(*stored.Controllers).To(ConsistOf(
+			resource.Controller{Name: "captain"},
+			resource.Controller{Name: "captain-backup", Finalizer: true},
+		))
+	})
+
+	It("should reject an unknown controller style", func() {
+		subCmd.options.DoAPI = true
+		subCmd.options.DoController = true
+		subCmd.options.ControllerStyle = "unknown"
+
+		err := subCmd.InjectResource(res)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(`unknown controller style "unknown"`))
+	})
+
+	It("should reject a controller style without a controller", func() {
+		subCmd.options.DoAPI = true
+		subCmd.options.DoController = false
+		subCmd.options.ControllerStyle = "phased"
+
+		err := subCmd.InjectResource(res)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("'--controller-style' can only be used when scaffolding a controller"))
+	})
+
+	It("should reject a controller style requiring a Go type for a resource without one", func() {
+		subCmd.options.DoAPI = false
+		subCmd.options.DoController = true
+		subCmd.options.ControllerStyle = "server-side-apply"
+
+		err := subCmd.InjectResource(res)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("'--controller-style server-side-apply' requires a resource with a Go type"))
+	})
+
+	It("should manage the status conditions of the API with the status-driven controller style", func() {
+		subCmd.options.DoAPI = true
+		subCmd.options.DoController = true
+		subCmd.options.ControllerStyle = "status-driven"
+
+		Expect(subCmd.InjectResource(res)).To(Succeed())
+		Expect(subCmd.options.Conditions).To(BeTrue())
+		Expect(res.API.Conditions).To(BeTrue())
+	})
+
+	It("should reject the clients when not creating the API", func() {
+		subCmd.options.DoAPI = false
+		subCmd.options.DoController = true
+		subCmd.options.Clients = true
+
+		err := subCmd.InjectResource(res)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("'--clients' can only be used when creating the API"))
+	})
+
+	It("should generate the clients when the project generates the clients of its other APIs", func() {
+		Expect(cfg.AddResource(resource.Resource{
+			GVK:  resource.GVK{Group: "crew", Domain: "test.io", Version: "v1", Kind: "FirstMate"},
+			Path: "github.com/example/test/api/v1",
+			API:  &resource.API{CRDVersion: "v1", Namespaced: true, Clients: true},
+		})).To(Succeed())
+		subCmd.options.DoAPI = true
+		subCmd.options.DoController = true
+
+		Expect(subCmd.InjectResource(res)).To(Succeed())
+		Expect(res.API.Clients).To(BeTrue())
+	})
+
+	It("should not generate the clients when disabled with the flag", func() {
+		Expect(cfg.AddResource(resource.Resource{
+			GVK:  resource.GVK{Group: "crew", Domain: "test.io", Version: "v1", Kind: "FirstMate"},
+			Path: "github.com/example/test/api/v1",
+			API:  &resource.API{CRDVersion: "v1", Namespaced: true, Clients: true},
+		})).To(Succeed())
+		subCmd.options.DoAPI = true
+		subCmd.options.DoController = true
+		subCmd.clientsFlag.Changed = true
+
+		Expect(subCmd.InjectResource(res)).To(Succeed())
+		Expect(res.API.Clients).To(BeFalse())
+	})
+
+	Context("with --from-crd", func() {
+		const crd = `apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: frigates.ship.test.io
+spec:
+  group: ship.test.io
+  names:
+    kind: Frigate
+    plural: frigates
+  scope: Cluster
+  versions:
+  - name: v1beta1
+    served: true
+    storage: false
+    schema:
+      openAPIV3Schema:
+        type: object
+  - name: v1
+    served: true
+    storage: true
+    schema:
+      openAPIV3Schema:
+        type: object
+        properties:
+          spec:
+            type: object
+            properties:
+              crew: {type: integer, format: int32, minimum: 1}
+`
+
+		BeforeEach(func() {
+			subCmd.fromCRD = filepath.Join(GinkgoT().TempDir(), "crd.yaml")
+			Expect(os.WriteFile(subCmd.fromCRD, []byte(crd), 0o600)).To(Succeed())
+			Expect(cfg.SetDomain("test.io")).To(Succeed())
+			subCmd.options.DoAPI = true
+			subCmd.options.DoController = true
+			res.Group, res.Version, res.Kind, res.Plural = "", "", "", ""
+		})
+
+		It("should take the resource from the CRD and generate the types of its served versions", func() {
+			Expect(subCmd.InjectResource(res)).To(Succeed())
+			Expect(res.GVK).To(Equal(resource.GVK{Group: "ship", Domain: "test.io", Version: "v1", Kind: "Frigate"}))
+			Expect(res.Plural).To(Equal("frigates"))
+			Expect(res.API.Namespaced).To(BeFalse())
+			Expect(res.API.StorageVersion).To(BeTrue())
+			Expect(subCmd.options.CRDTypes).To(HaveKey("v1beta1"))
+			Expect(subCmd.options.CRDTypes["v1"].Types[0].Fields[0].Markers).To(ContainElement(
+				"+kubebuilder:validation:Minimum=1"))
+		})
+
+		It("should scaffold the controller for the provided version", func() {
+			res.Version = "v1beta1"
+
+			Expect(subCmd.InjectResource(res)).To(Succeed())
+			Expect(res.Version).To(Equal("v1beta1"))
+			Expect(res.API.StorageVersion).To(BeFalse())
+		})
+
+		It("should reject a version that is not served", func() {
+			res.Version = "v2"
+
+			err := subCmd.InjectResource(res)
+			Expect(err).To(MatchError(ContainSubstring(`'--version' "v2" is not a served version of the CRD`)))
+		})
+
+		It("should reject a kind that does not match the CRD", func() {
+			res.Kind = "Captain"
+
+			err := subCmd.InjectResource(res)
+			Expect(err).To(MatchError(ContainSubstring(`'--kind' "Captain" does not match the kind "Frigate"`)))
+		})
+
+		It("should reject the flags that are defined by the CRD", func() {
+			subCmd.specFields = []string{"crew:int32"}
+
+			err := subCmd.InjectResource(res)
+			Expect(err).To(MatchError(ContainSubstring("cannot be used with '--from-crd'")))
+		})
+
+		It("should reject the versions that already exist", func() {
+			Expect(cfg.AddResource(resource.Resource{
+				GVK:  resource.GVK{Group: "ship", Domain: "test.io", Version: "v1beta1", Kind: "Frigate"},
+				Path: "github.com/example/test/api/v1beta1",
+				API:  &resource.API{CRDVersion: "v1"},
+			})).To(Succeed())
+
+			err := subCmd.InjectResource(res)
+			Expect(err).To(MatchError(ContainSubstring("of the CRD already exists")))
+		})
+	})
 })
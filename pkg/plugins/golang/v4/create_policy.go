@@ -0,0 +1,146 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v4
+
+import (
+	"fmt"
+
+	"github.com/spf13/pflag"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds"
+)
+
+var (
+	_ plugin.Subcommand       = &createPolicySubcommand{}
+	_ plugin.RequiresConfig   = &createPolicySubcommand{}
+	_ plugin.RequiresResource = &createPolicySubcommand{}
+)
+
+type createPolicySubcommand struct {
+	config config.Config
+	// For help text.
+	commandName string
+
+	resource *resource.Resource
+
+	// policyType is the type of the admission policy to scaffold
+	policyType string
+
+	// force indicates that the policy tests should be scaffolded even if they exist
+	force bool
+}
+
+func (p *createPolicySubcommand) UpdateMetadata(cliMeta plugin.CLIMetadata, subcmdMeta *plugin.SubcommandMetadata) {
+	p.commandName = cliMeta.CommandName
+
+	subcmdMeta.Description = `Scaffold an admission policy for a resource, an alternative to webhooks that
+does not require running a webhook server.
+
+--type validating scaffolds a ValidatingAdmissionPolicy, whose CEL rules reject the requests that do not
+match them. --type mutating scaffolds a MutatingAdmissionPolicy, whose CEL mutations are applied to the
+requests. MutatingAdmissionPolicies are beta and need to be enabled in the cluster.
+
+The policy and its binding are scaffolded under config/policies, with an example rule to replace.
+An envtest based test that applies them and checks their admission is scaffolded under internal/policy.
+
+The resource must have been created with create api, or be a Kubernetes core type.
+`
+	subcmdMeta.Examples = fmt.Sprintf(`  # Create a ValidatingAdmissionPolicy for the Frigate API
+  %[1]s create policy --group ship --version v1beta1 --kind Frigate --type validating
+
+  # Create a MutatingAdmissionPolicy for the Deployment core type
+  %[1]s create policy --group apps --version v1 --kind Deployment --type mutating
+`, cliMeta.CommandName)
+}
+
+func (p *createPolicySubcommand) BindFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&p.policyType, "type", "",
+		fmt.Sprintf("type of the admission policy, one of %q or %q", resource.ValidatingPolicy, resource.MutatingPolicy))
+	fs.BoolVar(&p.force, "force", false, "attempt to create policy files even if they already exist")
+}
+
+func (p *createPolicySubcommand) InjectConfig(c config.Config) error {
+	p.config = c
+	return nil
+}
+
+func (p *createPolicySubcommand) InjectResource(res *resource.Resource) error {
+	policyType := resource.PolicyType(p.policyType)
+	if err := policyType.Validate(); err != nil {
+		return fmt.Errorf("invalid --type: %w", err)
+	}
+
+	if existing, found := p.lookupResource(res.GVK); found {
+		*res = existing
+	} else {
+		// Core types can be used without being part of the project yet.
+		golang.Options{}.UpdateResource(res, p.config)
+		if !res.Core {
+			return fmt.Errorf("%s create policy requires a previously created API or a core type, "+
+				"no resource found for group %q, version %q and kind %q",
+				p.commandName, res.Group, res.Version, res.Kind)
+		}
+	}
+
+	if res.HasPolicy(policyType) && !p.force {
+		return fmt.Errorf("%s policy already exists for this resource, use --force to scaffold it again", policyType)
+	}
+
+	if res.Policies == nil {
+		res.Policies = &resource.Policies{}
+	}
+	if err := res.Policies.Set(policyType); err != nil {
+		return fmt.Errorf("error setting policy: %w", err)
+	}
+	p.resource = res
+
+	return nil
+}
+
+// lookupResource returns the resource of the project configuration matching gvk. The domain is ignored
+// as the one provided by the CLI is always the project domain, which does not apply to core types.
+func (p *createPolicySubcommand) lookupResource(gvk resource.GVK) (resource.Resource, bool) {
+	if res, err := p.config.GetResource(gvk); err == nil {
+		return res.Copy(), true
+	}
+
+	resources, err := p.config.GetResources()
+	if err != nil {
+		return resource.Resource{}, false
+	}
+	for _, res := range resources {
+		if res.Group == gvk.Group && res.Version == gvk.Version && res.Kind == gvk.Kind {
+			return res.Copy(), true
+		}
+	}
+
+	return resource.Resource{}, false
+}
+
+func (p *createPolicySubcommand) Scaffold(fs machinery.Filesystem) error {
+	scaffolder := scaffolds.NewPolicyScaffolder(p.config, *p.resource, resource.PolicyType(p.policyType), p.force)
+	scaffolder.InjectFS(fs)
+	if err := scaffolder.Scaffold(); err != nil {
+		return fmt.Errorf("failed to scaffold policy: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v4
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/pflag"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	cfgv3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+)
+
+var _ = Describe("createPolicySubcommand", func() {
+	var (
+		subCmd *createPolicySubcommand
+		cfg    config.Config
+		res    *resource.Resource
+	)
+
+	gvk := resource.GVK{Group: "crew", Domain: "test.io", Version: "v1", Kind: "Captain"}
+
+	inject := func(args ...string) error {
+		fs := pflag.NewFlagSet("create-policy", pflag.ContinueOnError)
+		subCmd.BindFlags(fs)
+		Expect(fs.Parse(args)).To(Succeed())
+		Expect(subCmd.InjectConfig(cfg)).To(Succeed())
+		return subCmd.InjectResource(res)
+	}
+
+	BeforeEach(func() {
+		subCmd = &createPolicySubcommand{}
+		cfg = cfgv3.New()
+		Expect(cfg.SetRepository("github.com/example/test")).To(Succeed())
+		Expect(cfg.SetDomain("test.io")).To(Succeed())
+
+		Expect(cfg.AddResource(resource.Resource{
+			GVK:    gvk,
+			Plural: "captains",
+			Path:   "github.com/example/test/api/v1",
+			API:    &resource.API{CRDVersion: "v1", Namespaced: true},
+		})).To(Succeed())
+
+		res = &resource.Resource{GVK: gvk, Plural: "captains"}
+	})
+
+	It("should fail for an unsupported type", func() {
+		err := inject("--type", "audit")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("invalid --type"))
+	})
+
+	It("should record the policy on the resource of the project", func() {
+		Expect(inject("--type", "validating")).To(Succeed())
+		Expect(res.HasPolicy(resource.ValidatingPolicy)).To(BeTrue())
+		Expect(res.HasPolicy(resource.MutatingPolicy)).To(BeFalse())
+		Expect(res.HasAPI()).To(BeTrue())
+	})
+
+	It("should fail when the policy already exists unless forced", func() {
+		previous, err := cfg.GetResource(gvk)
+		Expect(err).NotTo(HaveOccurred())
+		previous.Policies = &resource.Policies{Mutating: true}
+		Expect(cfg.UpdateResource(previous)).To(Succeed())
+
+		err = inject("--type", "mutating")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("already exists"))
+
+		subCmd = &createPolicySubcommand{}
+		Expect(inject("--type", "mutating", "--force")).To(Succeed())
+	})
+
+	It("should accept core types", func() {
+		res = &resource.Resource{
+			GVK:    resource.GVK{Group: "apps", Domain: "test.io", Version: "v1", Kind: "Deployment"},
+			Plural: "deployments",
+		}
+
+		Expect(inject("--type", "mutating")).To(Succeed())
+		Expect(res.Core).To(BeTrue())
+		Expect(res.Domain).To(BeEmpty())
+		Expect(res.Path).To(Equal("k8s.io/api/apps/v1"))
+		Expect(res.HasPolicy(resource.MutatingPolicy)).To(BeTrue())
+	})
+
+	It("should fail for a resource that does not exist", func() {
+		res.Kind = "FirstMate"
+
+		err := inject("--type", "validating")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("requires a previously created API or a core type"))
+	})
+})
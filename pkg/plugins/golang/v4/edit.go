@@ -51,8 +51,9 @@ func (p *editSubcommand) UpdateMetadata(cliMeta plugin.CLIMetadata, subcmdMeta *
 Multigroup (--multigroup):
   Enable or disable multi-group layout.
   Changes API structure: api/<version>/ becomes api/<group>/<version>/
-  Automatic: Updates PROJECT file, future APIs use new structure
-  Manual: Move existing API files, update import paths in controllers
+  Automatic: Updates PROJECT file, moves existing API, webhook and controller packages,
+  rewrites their imports and renames the kustomize manifests that depend on the layout
+  Disabling it requires all the APIs of the project to belong to a single group
   More info: https://book.kubebuilder.io/migration/multi-group.html
 
 Namespaced (--namespaced):
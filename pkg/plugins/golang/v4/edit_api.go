@@ -0,0 +1,539 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v4
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/pflag"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+	pluginutil "sigs.k8s.io/kubebuilder/v4/pkg/plugin/util"
+	goPlugin "sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds"
+)
+
+var _ plugin.EditAPISubcommand = &editAPISubcommand{}
+
+type editAPISubcommand struct {
+	config config.Config
+	// For help text.
+	commandName string
+
+	// previous is the resource as found in the project configuration before the edition
+	previous resource.Resource
+	// resource is the resource once edited
+	resource *resource.Resource
+
+	defaulting     bool
+	validation     bool
+	conversion     bool
+	spoke          []string
+	defaultingPath string
+	validationPath string
+	namespaced     bool
+	storageVersion bool
+
+	// controllerName is the name of the controller to rename
+	controllerName string
+	// renameController is the new name of the controller
+	renameController string
+
+	// celRuleValues hold the raw values of the --cel-rule flag, parsed into celRules
+	celRuleValues []string
+	celRules      []goPlugin.CELRule
+
+	// webhookName is the name of the named webhook whose admission is configured
+	webhookName string
+	// admission holds the flags that configure the admission of the webhooks
+	admission admissionFlags
+
+	// runMake indicates whether to run make or not after editing the API
+	runMake bool
+
+	// fs stores the FlagSet to check if flags were explicitly set
+	fs *pflag.FlagSet
+}
+
+func (p *editAPISubcommand) UpdateMetadata(cliMeta plugin.CLIMetadata, subcmdMeta *plugin.SubcommandMetadata) {
+	p.commandName = cliMeta.CommandName
+
+	subcmdMeta.Description = `Edit an existing API.
+
+Only the settings passed as flags are changed. The PROJECT file is updated and only the code
+related to the changed settings is added or removed, the rest of the scaffolded files are kept as is.
+
+Webhooks (--defaulting, --programmatic-validation, --conversion):
+  Add a webhook with --<webhook> or remove it with --<webhook>=false.
+  Removing a webhook deletes its marker, its type and its methods from the webhook file,
+  and the webhook file itself when no webhook remains.
+
+Admission (--failure-policy, --side-effects, --timeout-seconds, --match-policy, --reinvocation-policy,
+--operations, --namespace-selector, --object-selector):
+  Update in place the admission configuration of the defaulting and validating webhooks, only the
+  passed settings are changed. Use --defaulting or --programmatic-validation to configure only one of
+  them, or --webhook-name to configure a named webhook.
+
+Spokes (--spoke):
+  Add spoke versions to the conversion webhook of the resource.
+
+Scope (--namespaced):
+  Make the resource namespaced or, with --namespaced=false, cluster-scoped.
+
+Storage version (--storage-version):
+  Make the version the storage version of the CRD, and scaffold the job migrating the stored objects to it
+  in config/migration, the migrate-storage Makefile target running it and its e2e test. Use it on the current
+  storage version to only scaffold the migration.
+
+CEL rules (--cel-rule):
+  Add x-kubernetes-validations rules to the types, in the format path:rule[:message] where path is the JSON
+  path of the validated field, e.g. spec.replicas, or empty to validate the whole object. The rules are
+  checked with a CEL parser and their markers are added to the field in <kind>_types.go.
+
+Controller (--rename-controller):
+  Rename the controller of the resource, e.g. its file, its reconciler and the name it is registered with.
+  Use --controller-name to select the controller when the resource has several.
+`
+	subcmdMeta.Examples = fmt.Sprintf(`  # Add a validating webhook and remove the defaulting webhook of Group: ship, Version: v1beta1, Kind: Frigate
+  %[1]s edit api --group ship --version v1beta1 --kind Frigate --programmatic-validation --defaulting=false
+
+  # Add the v1 spoke to the conversion webhook of Group: ship, Version: v1beta1, Kind: Frigate
+  %[1]s edit api --group ship --version v1beta1 --kind Frigate --conversion --spoke v1
+
+  # Make the validating webhook of Group: ship, Version: v1beta1, Kind: Frigate ignore failures
+  %[1]s edit api --group ship --version v1beta1 --kind Frigate --programmatic-validation \
+    --failure-policy Ignore
+
+  # Make Group: ship, Version: v1beta1, Kind: Frigate cluster-scoped
+  %[1]s edit api --group ship --version v1beta1 --kind Frigate --namespaced=false
+
+  # Make Group: ship, Version: v1, Kind: Frigate the storage version and migrate the stored objects to it
+  %[1]s edit api --group ship --version v1 --kind Frigate --storage-version
+
+  # Require the replicas of Group: ship, Version: v1beta1, Kind: Frigate to be non-negative
+  %[1]s edit api --group ship --version v1beta1 --kind Frigate \
+    --cel-rule 'spec.replicas:self >= 0:replicas must be non-negative'
+
+  # Rename the controller of Group: ship, Version: v1beta1, Kind: Frigate
+  %[1]s edit api --group ship --version v1beta1 --kind Frigate --rename-controller frigate-fleet
+`, cliMeta.CommandName)
+}
+
+func (p *editAPISubcommand) BindFlags(fs *pflag.FlagSet) {
+	p.fs = fs
+
+	fs.BoolVar(&p.runMake, "make", true, "if true, run `make generate` after editing the API")
+
+	fs.BoolVar(&p.defaulting, "defaulting", false,
+		"add the defaulting webhook, or remove it with --defaulting=false")
+	fs.BoolVar(&p.validation, "programmatic-validation", false,
+		"add the validating webhook, or remove it with --programmatic-validation=false")
+	fs.BoolVar(&p.conversion, "conversion", false,
+		"add the conversion webhook, or remove it with --conversion=false")
+	fs.StringSliceVar(&p.spoke, "spoke", nil,
+		"Comma-separated list of spoke versions to be added to the conversion webhook (e.g., --spoke v1,v2)")
+	fs.StringVar(&p.defaultingPath, "defaulting-path", "",
+		"Custom path for the defaulting/mutating webhook (only valid when adding it with --defaulting)")
+	fs.StringVar(&p.validationPath, "validation-path", "",
+		"Custom path for the validation webhook (only valid when adding it with --programmatic-validation)")
+
+	fs.BoolVar(&p.namespaced, "namespaced", true,
+		"make the resource namespaced, or cluster-scoped with --namespaced=false")
+	fs.BoolVar(&p.storageVersion, "storage-version", false,
+		"make the version the storage version of the CRD, and scaffold the migration of the stored objects to it")
+
+	fs.StringVar(&p.controllerName, "controller-name", "",
+		"name of the controller to rename, required if the resource has several controllers")
+	fs.StringVar(&p.renameController, "rename-controller", "", "new name of the controller")
+
+	fs.StringArrayVar(&p.celRuleValues, "cel-rule", nil,
+		"CEL validation rule in the format path:rule[:message] (e.g., --cel-rule 'spec.replicas:self >= 0'), "+
+			"with an empty path for the rules of the whole object, can be repeated")
+
+	fs.StringVar(&p.webhookName, "webhook-name", "",
+		"name of the named webhook to configure with the admission flags")
+	p.admission.bindFlags(fs)
+}
+
+func (p *editAPISubcommand) InjectConfig(c config.Config) error {
+	p.config = c
+	return nil
+}
+
+func (p *editAPISubcommand) InjectResource(res *resource.Resource) error {
+	previous, err := p.lookupResource(res.GVK)
+	if err != nil {
+		return err
+	}
+	p.previous = previous
+
+	edited := previous.Copy()
+	if edited.Webhooks == nil {
+		edited.Webhooks = &resource.Webhooks{}
+	}
+
+	webhooksChanged, err := p.editWebhooks(&edited)
+	if err != nil {
+		return err
+	}
+	admissionChanged, err := p.editAdmission(&edited)
+	if err != nil {
+		return err
+	}
+	scopeChanged, err := p.editScope(&edited)
+	if err != nil {
+		return err
+	}
+	controllerChanged, err := p.editController(&edited)
+	if err != nil {
+		return err
+	}
+	storageVersionChanged, err := p.editStorageVersion(&edited)
+	if err != nil {
+		return err
+	}
+	celRulesAdded, err := p.editCELRules(&edited)
+	if err != nil {
+		return err
+	}
+
+	if !webhooksChanged && !admissionChanged && !scopeChanged && !controllerChanged && !storageVersionChanged &&
+		!celRulesAdded {
+		return fmt.Errorf("%s edit api has nothing to change: use --defaulting, --programmatic-validation, "+
+			"--conversion, --spoke, the admission flags, --namespaced, --storage-version, --cel-rule or "+
+			"--rename-controller to edit the resource", p.commandName)
+	}
+
+	if err = edited.Validate(); err != nil {
+		return fmt.Errorf("error validating resource: %w", err)
+	}
+
+	*res = edited
+	p.resource = res
+
+	return nil
+}
+
+// lookupResource returns the resource of the project configuration matching gvk. The domain is ignored
+// as the one provided by the CLI is always the project domain, which does not apply to core types.
+func (p *editAPISubcommand) lookupResource(gvk resource.GVK) (resource.Resource, error) {
+	if res, err := p.config.GetResource(gvk); err == nil {
+		return res, nil
+	}
+
+	resources, err := p.config.GetResources()
+	if err != nil {
+		return resource.Resource{}, fmt.Errorf("error getting resources: %w", err)
+	}
+	for _, res := range resources {
+		if res.Group == gvk.Group && res.Version == gvk.Version && res.Kind == gvk.Kind {
+			return res, nil
+		}
+	}
+
+	return resource.Resource{}, fmt.Errorf("%s edit api requires a previously created API, "+
+		"no resource found for group %q, version %q and kind %q", p.commandName, gvk.Group, gvk.Version, gvk.Kind)
+}
+
+// editWebhooks applies the webhook flags to res and reports whether its webhooks changed.
+func (p *editAPISubcommand) editWebhooks(res *resource.Resource) (bool, error) {
+	webhooks := res.Webhooks
+	changed := false
+
+	if p.fs.Changed("defaulting") && p.defaulting != webhooks.Defaulting {
+		webhooks.Defaulting = p.defaulting
+		webhooks.DefaultingPath = ""
+		webhooks.DefaultingAdmission = nil
+		if p.defaulting {
+			webhooks.DefaultingPath = p.defaultingPath
+		}
+		changed = true
+	} else if p.defaultingPath != "" {
+		return false, errors.New("--defaulting-path can only be used when adding the defaulting webhook")
+	}
+
+	if p.fs.Changed("programmatic-validation") && p.validation != webhooks.Validation {
+		webhooks.Validation = p.validation
+		webhooks.ValidationPath = ""
+		webhooks.ValidationAdmission = nil
+		if p.validation {
+			webhooks.ValidationPath = p.validationPath
+		}
+		changed = true
+	} else if p.validationPath != "" {
+		return false, errors.New("--validation-path can only be used when adding the validating webhook")
+	}
+
+	if p.fs.Changed("conversion") && p.conversion != webhooks.Conversion {
+		webhooks.Conversion = p.conversion
+		webhooks.Spoke = nil
+		changed = true
+	}
+
+	for _, spoke := range p.spoke {
+		spoke = strings.TrimSpace(spoke)
+		if !webhooks.Conversion {
+			return false, fmt.Errorf("spoke version %q requires a conversion webhook, use --conversion to add it", spoke)
+		}
+		if spoke == res.Version {
+			return false, fmt.Errorf("spoke version %q cannot be the hub version", spoke)
+		}
+		if !isValidVersion(spoke, res, p.config) {
+			return false, fmt.Errorf("invalid spoke version %q", spoke)
+		}
+		if !slices.Contains(webhooks.Spoke, spoke) {
+			webhooks.AddSpoke(spoke)
+			changed = true
+		}
+	}
+
+	if !changed {
+		return false, nil
+	}
+
+	if webhooks.Defaulting || webhooks.Validation || webhooks.Conversion {
+		webhooks.WebhookVersion = "v1"
+		if res.Path == "" {
+			res.Path = resource.APIPackagePath(p.config.GetRepository(), res.Group, res.Version, p.config.IsMultiGroup())
+		}
+	} else if webhooks.Named.IsEmpty() {
+		res.Webhooks = &resource.Webhooks{}
+	}
+
+	return true, nil
+}
+
+// editAdmission applies the admission flags to the selected webhooks of res and reports whether they changed.
+// Without --defaulting, --programmatic-validation or --webhook-name, both default webhooks are configured.
+func (p *editAPISubcommand) editAdmission(res *resource.Resource) (bool, error) {
+	if !p.admission.isSet() {
+		if p.webhookName != "" {
+			return false, errors.New("--webhook-name can only be used with the admission flags")
+		}
+		return false, nil
+	}
+
+	webhooks := res.Webhooks
+	changed := false
+	update := func(config **resource.AdmissionConfig, mutating bool) error {
+		applied, err := p.admission.apply(*config, mutating)
+		if err != nil {
+			return err
+		}
+		if !applied.Equal(*config) {
+			*config = applied
+			changed = true
+		}
+		return nil
+	}
+
+	if p.webhookName != "" {
+		if p.fs.Changed("defaulting") || p.fs.Changed("programmatic-validation") {
+			return false, errors.New("--webhook-name cannot be used with --defaulting or --programmatic-validation")
+		}
+		for i, webhook := range webhooks.Named {
+			if webhook.Name != p.webhookName {
+				continue
+			}
+			mutating := webhook.Type == resource.DefaultingWebhook
+			if err := p.admission.validateTargets(mutating); err != nil {
+				return false, err
+			}
+			if err := update(&webhooks.Named[i].Admission, mutating); err != nil {
+				return false, err
+			}
+			return changed, nil
+		}
+		return false, fmt.Errorf("%s has no webhook named %q, found: %s",
+			res.Kind, p.webhookName, strings.Join(webhooks.Named.GetWebhookNames(), ", "))
+	}
+
+	defaulting := p.fs.Changed("defaulting") && p.defaulting
+	validation := p.fs.Changed("programmatic-validation") && p.validation
+	if !defaulting && !validation {
+		defaulting, validation = webhooks.Defaulting, webhooks.Validation
+	}
+	if !defaulting && !validation {
+		return false, fmt.Errorf("%s has no defaulting or validating webhook to configure, "+
+			"use --webhook-name to configure a named webhook", res.Kind)
+	}
+	if err := p.admission.validateTargets(defaulting); err != nil {
+		return false, err
+	}
+
+	if defaulting {
+		if err := update(&webhooks.DefaultingAdmission, true); err != nil {
+			return false, err
+		}
+	}
+	if validation {
+		if err := update(&webhooks.ValidationAdmission, false); err != nil {
+			return false, err
+		}
+	}
+
+	return changed, nil
+}
+
+// editScope applies the --namespaced flag to res and reports whether its scope changed.
+func (p *editAPISubcommand) editScope(res *resource.Resource) (bool, error) {
+	if !p.fs.Changed("namespaced") {
+		return false, nil
+	}
+
+	if !res.HasAPI() {
+		return false, fmt.Errorf("the scope of %s can only be changed for APIs scaffolded in this project", res.Kind)
+	}
+
+	if res.API.Namespaced == p.namespaced {
+		return false, nil
+	}
+
+	res.API.Namespaced = p.namespaced
+	return true, nil
+}
+
+// editStorageVersion applies the --storage-version flag to res. The migration of the stored objects is scaffolded
+// even when res already is the storage version, so it always reports a change. The flag is also bound by the
+// kustomize plugin, so only its value is synced and whether it was set cannot be checked.
+func (p *editAPISubcommand) editStorageVersion(res *resource.Resource) (bool, error) {
+	if !p.storageVersion {
+		return false, nil
+	}
+
+	if !res.HasAPI() || res.IsExternal() {
+		return false, fmt.Errorf("the storage version of %s can only be changed for APIs scaffolded in this project",
+			res.Kind)
+	}
+
+	res.API.StorageVersion = true
+	return true, nil
+}
+
+// editCELRules parses the --cel-rule flags and reports whether rules are added to the types of res.
+func (p *editAPISubcommand) editCELRules(res *resource.Resource) (bool, error) {
+	if len(p.celRuleValues) == 0 {
+		return false, nil
+	}
+
+	if !res.HasAPI() || res.IsExternal() {
+		return false, fmt.Errorf("CEL rules can only be added to the types of %s for APIs scaffolded in this project",
+			res.Kind)
+	}
+
+	rules, err := parseCELRules(p.celRuleValues)
+	if err != nil {
+		return false, err
+	}
+	p.celRules = rules
+
+	return true, nil
+}
+
+// editController applies the --rename-controller flag to res and reports whether its controllers changed.
+func (p *editAPISubcommand) editController(res *resource.Resource) (bool, error) {
+	if p.renameController == "" {
+		if p.controllerName != "" {
+			return false, errors.New("--controller-name can only be used with --rename-controller")
+		}
+		return false, nil
+	}
+
+	if !res.HasController() {
+		return false, fmt.Errorf("%s has no controller to rename", res.Kind)
+	}
+
+	names := res.GetControllerNames()
+	if p.controllerName == "" {
+		if len(names) != 1 {
+			return false, fmt.Errorf("%s has several controllers (%s), use --controller-name to select the one "+
+				"to rename", res.Kind, strings.Join(names, ", "))
+		}
+		p.controllerName = names[0]
+	} else if !slices.Contains(names, p.controllerName) {
+		return false, fmt.Errorf("%s has no controller named %q, found: %s",
+			res.Kind, p.controllerName, strings.Join(names, ", "))
+	}
+
+	if p.renameController == p.controllerName {
+		return false, nil
+	}
+
+	// The controllers keep what they own and watch, only the renamed one changes its name.
+	var renamed resource.Controllers
+	if res.Controllers != nil && !res.Controllers.IsEmpty() {
+		renamed = res.Controllers.Copy()
+	} else {
+		for _, name := range names {
+			renamed = append(renamed, resource.Controller{Name: name})
+		}
+	}
+	for i := range renamed {
+		if renamed[i].Name == p.controllerName {
+			renamed[i].Name = p.renameController
+		}
+	}
+	if err := renamed.Validate(); err != nil {
+		return false, fmt.Errorf("invalid controller name: %w", err)
+	}
+
+	res.Controller = false
+	res.Controllers = &renamed
+
+	return true, nil
+}
+
+func (p *editAPISubcommand) Scaffold(fs machinery.Filesystem) error {
+	scaffolder := scaffolds.NewEditAPIScaffolder(p.config, p.previous, *p.resource,
+		p.controllerName, p.renameController, p.celRules)
+	scaffolder.InjectFS(fs)
+	if err := scaffolder.Scaffold(); err != nil {
+		return fmt.Errorf("failed to edit API: %w", err)
+	}
+
+	if p.storageVersion {
+		migrationScaffolder := scaffolds.NewMigrationScaffolder(p.config, *p.resource)
+		migrationScaffolder.InjectFS(fs)
+		if err := migrationScaffolder.Scaffold(); err != nil {
+			return fmt.Errorf("failed to scaffold the storage version migration: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (p *editAPISubcommand) PostScaffold() error {
+	if p.runMake {
+		err := pluginutil.RunCmd("Running make", "make", "generate")
+		if err != nil {
+			return fmt.Errorf("error running make generate: %w", err)
+		}
+	}
+
+	fmt.Print("Next: regenerate the manifests with:\n$ make manifests\n")
+	if p.storageVersion {
+		fmt.Print("Once deployed, migrate the stored objects to the storage version with:\n$ make migrate-storage\n")
+	}
+
+	return nil
+}
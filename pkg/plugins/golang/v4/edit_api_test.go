@@ -0,0 +1,207 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v4
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/pflag"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	cfgv3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+)
+
+var _ = Describe("editAPISubcommand", func() {
+	var (
+		subCmd *editAPISubcommand
+		cfg    config.Config
+		res    *resource.Resource
+	)
+
+	gvk := resource.GVK{Group: "crew", Domain: "test.io", Version: "v1", Kind: "Captain"}
+
+	inject := func(args ...string) error {
+		fs := pflag.NewFlagSet("edit-api", pflag.ContinueOnError)
+		subCmd.BindFlags(fs)
+		Expect(fs.Parse(args)).To(Succeed())
+		Expect(subCmd.InjectConfig(cfg)).To(Succeed())
+		return subCmd.InjectResource(res)
+	}
+
+	BeforeEach(func() {
+		subCmd = &editAPISubcommand{}
+		cfg = cfgv3.New()
+		Expect(cfg.SetRepository("github.com/example/test")).To(Succeed())
+		Expect(cfg.SetDomain("test.io")).To(Succeed())
+
+		Expect(cfg.AddResource(resource.Resource{
+			GVK:        gvk,
+			Plural:     "captains",
+			Path:       "github.com/example/test/api/v1",
+			API:        &resource.API{CRDVersion: "v1", Namespaced: true},
+			Controller: true,
+			Webhooks:   &resource.Webhooks{WebhookVersion: "v1", Defaulting: true},
+		})).To(Succeed())
+
+		res = &resource.Resource{GVK: gvk, Plural: "captains"}
+	})
+
+	It("should fail for a resource that does not exist", func() {
+		res.Kind = "FirstMate"
+
+		err := inject("--defaulting=false")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("requires a previously created API"))
+	})
+
+	It("should fail when nothing changes", func() {
+		err := inject("--defaulting")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("nothing to change"))
+	})
+
+	It("should add and remove webhooks", func() {
+		Expect(inject("--defaulting=false", "--programmatic-validation", "--validation-path", "/validate")).
+			To(Succeed())
+		Expect(res.HasDefaultingWebhook()).To(BeFalse())
+		Expect(res.HasValidationWebhook()).To(BeTrue())
+		Expect(res.Webhooks.ValidationPath).To(Equal("/validate"))
+		Expect(subCmd.previous.HasDefaultingWebhook()).To(BeTrue())
+	})
+
+	It("should clear the webhooks when the last one is removed", func() {
+		Expect(inject("--defaulting=false")).To(Succeed())
+		Expect(res.Webhooks.IsEmpty()).To(BeTrue())
+	})
+
+	It("should reject a path for a webhook that is not added", func() {
+		err := inject("--defaulting-path", "/mutate")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("--defaulting-path can only be used when adding"))
+	})
+
+	It("should reject a spoke without a conversion webhook", func() {
+		err := inject("--spoke", "v2")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("requires a conversion webhook"))
+	})
+
+	It("should add spokes to the conversion webhook", func() {
+		spoke := resource.Resource{GVK: gvk, API: &resource.API{CRDVersion: "v1", Namespaced: true}}
+		spoke.Version = "v2"
+		Expect(cfg.AddResource(spoke)).To(Succeed())
+
+		Expect(inject("--conversion", "--spoke", "v2")).To(Succeed())
+		Expect(res.HasConversionWebhook()).To(BeTrue())
+		Expect(res.Webhooks.Spoke).To(ConsistOf("v2"))
+	})
+
+	It("should update the admission configuration of the existing webhooks", func() {
+		Expect(inject("--timeout-seconds", "5", "--namespace-selector", "env=prod")).To(Succeed())
+		Expect(res.Webhooks.DefaultingAdmission).To(Equal(&resource.AdmissionConfig{
+			TimeoutSeconds:    5,
+			NamespaceSelector: map[string]string{"env": "prod"},
+		}))
+		Expect(subCmd.previous.Webhooks.DefaultingAdmission).To(BeNil())
+	})
+
+	It("should only change the admission settings that are passed", func() {
+		Expect(inject("--failure-policy", "Ignore")).To(Succeed())
+		Expect(cfg.ReplaceResource(*res)).To(Succeed())
+
+		subCmd = &editAPISubcommand{}
+		res = &resource.Resource{GVK: gvk, Plural: "captains"}
+		Expect(inject("--match-policy", "Exact")).To(Succeed())
+		Expect(res.Webhooks.DefaultingAdmission).To(Equal(&resource.AdmissionConfig{
+			FailurePolicy: "Ignore",
+			MatchPolicy:   "Exact",
+		}))
+	})
+
+	It("should configure a named webhook", func() {
+		captain, err := cfg.GetResource(gvk)
+		Expect(err).NotTo(HaveOccurred())
+		captain.Webhooks.Named = resource.NamedWebhooks{{Name: "audit", Type: resource.ValidationWebhook}}
+		Expect(cfg.ReplaceResource(captain)).To(Succeed())
+
+		Expect(inject("--webhook-name", "audit", "--failure-policy", "Ignore")).To(Succeed())
+		Expect(res.Webhooks.Named[0].Admission).To(Equal(&resource.AdmissionConfig{FailurePolicy: "Ignore"}))
+		Expect(res.Webhooks.DefaultingAdmission).To(BeNil())
+	})
+
+	It("should reject an unknown webhook name", func() {
+		err := inject("--webhook-name", "audit", "--failure-policy", "Ignore")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(`no webhook named "audit"`))
+	})
+
+	It("should change the scope", func() {
+		Expect(inject("--namespaced=false")).To(Succeed())
+		Expect(res.API.Namespaced).To(BeFalse())
+	})
+
+	It("should make the version the storage version", func() {
+		Expect(inject("--storage-version")).To(Succeed())
+		Expect(res.API.StorageVersion).To(BeTrue())
+	})
+
+	It("should parse the CEL rules", func() {
+		Expect(inject("--cel-rule", "spec.crew:self >= 0:crew must be non-negative")).To(Succeed())
+		Expect(subCmd.celRules).To(HaveLen(1))
+		Expect(subCmd.celRules[0].Path).To(Equal("spec.crew"))
+	})
+
+	It("should reject an invalid CEL rule", func() {
+		err := inject("--cel-rule", "spec.crew:self >=")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(`invalid CEL rule "self >="`))
+	})
+
+	It("should rename the controller", func() {
+		Expect(inject("--rename-controller", "captain-fleet")).To(Succeed())
+		Expect(res.Controller).To(BeFalse())
+		Expect(res.GetControllerNames()).To(ConsistOf("captain-fleet"))
+		Expect(subCmd.controllerName).To(Equal("captain"))
+	})
+
+	It("should keep what the renamed controller owns and watches", func() {
+		deployment := resource.GVK{Group: "apps", Version: "v1", Kind: "Deployment"}
+		watches := []resource.Watch{{GVK: resource.GVK{Group: "batch", Version: "v1", Kind: "Job"}, Mapper: "findCaptains"}}
+		stored, err := cfg.GetResource(gvk)
+		Expect(err).NotTo(HaveOccurred())
+		stored.Controller = false
+		stored.Controllers = &resource.Controllers{
+			{Name: "captain", Owns: []resource.GVK{deployment}, Watches: watches},
+			{Name: "captain-backup"},
+		}
+		Expect(cfg.ReplaceResource(stored)).To(Succeed())
+
+		Expect(inject("--controller-name", "captain", "--rename-controller", "fleet")).To(Succeed())
+		Expect(res.Controllers).NotTo(BeNil())
+		Expect(*res.Controllers).To(Equal(resource.Controllers{
+			{Name: "fleet", Owns: []resource.GVK{deployment}, Watches: watches},
+			{Name: "captain-backup"},
+		}))
+	})
+
+	It("should reject an unknown controller name", func() {
+		err := inject("--controller-name", "other", "--rename-controller", "captain-fleet")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(`no controller named "other"`))
+	})
+})
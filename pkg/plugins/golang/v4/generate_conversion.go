@@ -0,0 +1,72 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v4
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds"
+)
+
+var (
+	_ plugin.Subcommand     = &generateConversionSubcommand{}
+	_ plugin.RequiresConfig = &generateConversionSubcommand{}
+)
+
+type generateConversionSubcommand struct {
+	config config.Config
+}
+
+func (p *generateConversionSubcommand) UpdateMetadata(cliMeta plugin.CLIMetadata,
+	subcmdMeta *plugin.SubcommandMetadata,
+) {
+	subcmdMeta.Description = `Regenerate the functions converting the spoke versions of the resources with a conversion
+webhook to and from their hub versions, after changing their API types.
+
+The functions are generated in the zz_generated.conversion.go file of each spoke version, from the Go types
+of the hub and spoke versions:
+
+  - The fields with the same name and type are copied, and the nested types with the same name are
+    converted recursively, including through pointers, slices and maps.
+  - The fields that do not map are flagged, and the Convert_ functions of their types are not generated.
+    They must be implemented by hand in another file of the package, which does not compile until then.
+  - The fields that only exist in the hub version are stashed in an annotation of the spoke version,
+    and restored when it is converted back, so that they survive a round trip.
+
+The conversion functions implemented by hand are never generated.
+`
+	subcmdMeta.Examples = fmt.Sprintf(`  # Regenerate the conversion functions after changing the API types
+  %[1]s generate conversion
+`, cliMeta.CommandName)
+}
+
+func (p *generateConversionSubcommand) InjectConfig(c config.Config) error {
+	p.config = c
+	return nil
+}
+
+func (p *generateConversionSubcommand) Scaffold(fs machinery.Filesystem) error {
+	scaffolder := scaffolds.NewConversionScaffolder(p.config)
+	scaffolder.InjectFS(fs)
+	if err := scaffolder.Scaffold(); err != nil {
+		return fmt.Errorf("failed to generate conversions: %w", err)
+	}
+	return nil
+}
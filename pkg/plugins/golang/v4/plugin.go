@@ -31,7 +31,11 @@ var (
 	supportedProjectVersions = []config.Version{cfgv3.Version}
 )
 
-var _ plugin.Full = Plugin{}
+var (
+	_ plugin.Full                = Plugin{}
+	_ plugin.EditAPI             = Plugin{}
+	_ plugin.HasExtraSubcommands = Plugin{}
+)
 
 // Plugin implements the plugin.Full interface
 type Plugin struct {
@@ -39,6 +43,10 @@ type Plugin struct {
 	createAPISubcommand
 	createWebhookSubcommand
 	editSubcommand
+	editAPISubcommand
+	createPolicySubcommand
+	generateConversionSubcommand
+	addVersionSubcommand
 }
 
 // Name returns the name of the plugin
@@ -64,6 +72,33 @@ func (p Plugin) GetCreateWebhookSubcommand() plugin.CreateWebhookSubcommand {
 // GetEditSubcommand will return the subcommand which is responsible for editing the scaffold of the project
 func (p Plugin) GetEditSubcommand() plugin.EditSubcommand { return &p.editSubcommand }
 
+// GetEditAPISubcommand will return the subcommand which is responsible for editing an existing API
+func (p Plugin) GetEditAPISubcommand() plugin.EditAPISubcommand { return &p.editAPISubcommand }
+
+// GetExtraSubcommands returns the subcommands that the plugin adds to the CLI
+func (p Plugin) GetExtraSubcommands() []plugin.ExtraSubcommand {
+	return []plugin.ExtraSubcommand{
+		{
+			Command:       "create policy",
+			Short:         "Scaffold a ValidatingAdmissionPolicy or MutatingAdmissionPolicy for a resource",
+			ProjectAccess: plugin.ProjectAccessWrite,
+			Subcommand:    &p.createPolicySubcommand,
+		},
+		{
+			Command:       "generate conversion",
+			Short:         "Regenerate the conversion functions of the spoke versions from the API types",
+			ProjectAccess: plugin.ProjectAccessRead,
+			Subcommand:    &p.generateConversionSubcommand,
+		},
+		{
+			Command:       "alpha add-version",
+			Short:         "Add a new API version to an existing kind, converted from its other versions",
+			ProjectAccess: plugin.ProjectAccessWrite,
+			Subcommand:    &p.addVersionSubcommand,
+		},
+	}
+}
+
 // Description returns a short description of the plugin
 func (Plugin) Description() string {
 	return "Default scaffold (go/v4 + kustomize/v2)"
@@ -21,6 +21,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	cfgv3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
 )
 
 var _ = Describe("Plugin", func() {
@@ -34,4 +35,24 @@ var _ = Describe("Plugin", func() {
 	It("should not be deprecated", func() {
 		Expect(p.DeprecationWarning()).To(BeEmpty())
 	})
+
+	It("should provide the generate conversion subcommand", func() {
+		var commands []string
+		for _, subcommand := range p.GetExtraSubcommands() {
+			Expect(subcommand.ProjectAccess.Validate()).To(Succeed())
+			commands = append(commands, subcommand.Command)
+		}
+		Expect(commands).To(ContainElement("generate conversion"))
+	})
+
+	It("should provide the alpha add-version subcommand with write access", func() {
+		var found bool
+		for _, subcommand := range p.GetExtraSubcommands() {
+			if subcommand.Command == "alpha add-version" {
+				found = true
+				Expect(subcommand.ProjectAccess).To(Equal(plugin.ProjectAccessWrite))
+			}
+		}
+		Expect(found).To(BeTrue())
+	})
 })
@@ -0,0 +1,221 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	"errors"
+	"fmt"
+	log "log/slog"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds/internal/templates/api"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds/internal/templates/cmd"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds/internal/templates/hack"
+)
+
+var _ plugins.Scaffolder = &addVersionScaffolder{}
+
+// packageClause matches the package clause of a Go file
+var packageClause = regexp.MustCompile(`(?m)^package \w+$`)
+
+type addVersionScaffolder struct {
+	config config.Config
+
+	// source is the existing version whose types are copied
+	source resource.Resource
+	// resource is the new version, the hub of the conversion webhook of the kind
+	resource resource.Resource
+
+	// fs is the filesystem that will be used by the scaffolder
+	fs machinery.Filesystem
+}
+
+// NewAddVersionScaffolder returns a new Scaffolder that adds a version to a kind by copying the types of the
+// source version. The new version becomes the storage version and the hub of the conversion webhook of the kind.
+func NewAddVersionScaffolder(cfg config.Config, source, res resource.Resource) plugins.Scaffolder {
+	return &addVersionScaffolder{
+		config:   cfg,
+		source:   source,
+		resource: res,
+	}
+}
+
+// InjectFS implements cmdutil.Scaffolder
+func (s *addVersionScaffolder) InjectFS(fs machinery.Filesystem) {
+	s.fs = fs
+}
+
+// Scaffold implements cmdutil.Scaffolder
+func (s *addVersionScaffolder) Scaffold() error {
+	log.Info("Adding the API version...", "kind", s.resource.Kind, "version", s.resource.Version,
+		"from", s.source.Version)
+
+	if err := s.copyTypes(); err != nil {
+		return err
+	}
+
+	if err := s.config.UpdateResource(s.resource); err != nil {
+		return fmt.Errorf("error updating resource: %w", err)
+	}
+
+	// The conversion webhook moves to the new version, so the previous hub becomes a spoke
+	if err := s.removePreviousHub(); err != nil {
+		return err
+	}
+
+	boilerplate, err := afero.ReadFile(s.fs.FS, hack.DefaultBoilerplatePath)
+	if err != nil {
+		if !errors.Is(err, afero.ErrFileNotFound) {
+			return fmt.Errorf("error adding version: failed to load boilerplate: %w", err)
+		}
+		log.Warn("unable to find boilerplate file", "file_path", hack.DefaultBoilerplatePath)
+		boilerplate = []byte("")
+	}
+
+	scaffold := machinery.NewScaffold(s.fs,
+		machinery.WithConfig(s.config),
+		machinery.WithBoilerplate(string(boilerplate)),
+		machinery.WithResource(&s.resource),
+	)
+
+	// The new version is scaffolded as a new API created with --storage-version would be
+	apiScaffolder := &apiScaffolder{config: s.config, resource: s.resource, fs: s.fs}
+	if _, err = apiScaffolder.unsetStorageVersion(); err != nil {
+		return err
+	}
+	if err = scaffold.Execute(&api.Group{}); err != nil {
+		return fmt.Errorf("error scaffolding the API group: %w", err)
+	}
+	if s.resource.API.Clients {
+		if err = apiScaffolder.scaffoldClients(scaffold); err != nil {
+			return err
+		}
+	}
+	if err = scaffold.Execute(&cmd.MainUpdater{WireResource: true}); err != nil {
+		return fmt.Errorf("error updating cmd/main.go: %w", err)
+	}
+
+	webhookScaffolder := NewWebhookScaffolder(s.config, s.resource, false, false)
+	webhookScaffolder.InjectFS(s.fs)
+	if err = webhookScaffolder.Scaffold(); err != nil {
+		return fmt.Errorf("error scaffolding the conversion webhook: %w", err)
+	}
+
+	// The objects stored in the previous storage version need to be migrated to the new one
+	migrationScaffolder := NewMigrationScaffolder(s.config, s.resource)
+	migrationScaffolder.InjectFS(s.fs)
+	if err = migrationScaffolder.Scaffold(); err != nil {
+		return fmt.Errorf("error scaffolding the storage version migration: %w", err)
+	}
+
+	log.Info(fmt.Sprintf("%s %s is the storage version and converts %s. The controllers and webhooks of "+
+		"the other versions are kept, move them to %s if needed.", s.resource.Kind, s.resource.Version,
+		strings.Join(s.resource.Webhooks.Spoke, ", "), s.resource.Version))
+
+	return nil
+}
+
+// removePreviousHub removes the conversion webhook from the previous hub version of the kind, if any,
+// including the hub and spoke implementations that convert to it.
+func (s *addVersionScaffolder) removePreviousHub() error {
+	resources, err := s.config.GetResources()
+	if err != nil {
+		return fmt.Errorf("error getting resources: %w", err)
+	}
+
+	for _, res := range resources {
+		if res.Group != s.resource.Group || res.Domain != s.resource.Domain || res.Kind != s.resource.Kind ||
+			res.Version == s.resource.Version || !res.HasConversionWebhook() {
+			continue
+		}
+
+		edited := res.Copy()
+		edited.Webhooks.Conversion = false
+		edited.Webhooks.Spoke = nil
+		if !edited.Webhooks.Defaulting && !edited.Webhooks.Validation && edited.Webhooks.Named.IsEmpty() {
+			edited.Webhooks = &resource.Webhooks{}
+		}
+
+		log.Info("Moving the conversion webhook to the new version", "from", res.Version, "to", s.resource.Version)
+		editScaffolder := NewEditAPIScaffolder(s.config, res, edited, "", "", nil)
+		editScaffolder.InjectFS(s.fs)
+		if err = editScaffolder.Scaffold(); err != nil {
+			return fmt.Errorf("error removing the conversion webhook of %s: %w", res.Version, err)
+		}
+		log.Warn("The Convert_ functions implemented by hand for the previous hub version must be renamed and "+
+			"updated to convert to the new one", "previous", res.Version, "hub", s.resource.Version)
+	}
+
+	return nil
+}
+
+// copyTypes copies the types file of the source version to the package of the new version. The storage
+// version marker is removed as it is added back by the conversion webhook, which makes the new version the hub.
+func (s *addVersionScaffolder) copyTypes() error {
+	sourcePath := s.typesFilePath(s.source.Version)
+	path := s.typesFilePath(s.resource.Version)
+
+	if exists, err := afero.Exists(s.fs.FS, path); err != nil {
+		return fmt.Errorf("error checking %q: %w", path, err)
+	} else if exists {
+		return fmt.Errorf("the types file %q already exists", path)
+	}
+
+	content, err := afero.ReadFile(s.fs.FS, sourcePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("unable to find the types file %q of %s %s, types moved elsewhere must be "+
+				"copied manually", sourcePath, s.source.Kind, s.source.Version)
+		}
+		return fmt.Errorf("error reading %q: %w", sourcePath, err)
+	}
+
+	loc := packageClause.FindStringIndex(string(content))
+	if loc == nil {
+		return fmt.Errorf("unable to find the package clause of %q", sourcePath)
+	}
+	copied := string(content[:loc[0]]) + "package " + s.resource.Version + string(content[loc[1]:])
+	copied = strings.ReplaceAll(copied, storageVersionMarker, "")
+
+	if err = s.fs.FS.MkdirAll(filepath.Dir(path), machinery.DefaultDirectoryPermission); err != nil {
+		return fmt.Errorf("error creating the directory of %q: %w", path, err)
+	}
+	if err = afero.WriteFile(s.fs.FS, path, []byte(copied), machinery.DefaultFilePermission); err != nil {
+		return fmt.Errorf("error writing %q: %w", path, err)
+	}
+	log.Info("Copied the types", "from", sourcePath, "to", path)
+
+	return nil
+}
+
+// typesFilePath returns the path of the types file of the kind in the given version.
+func (s *addVersionScaffolder) typesFilePath(version string) string {
+	name := strings.ToLower(s.resource.Kind) + "_types.go"
+	if s.config.IsMultiGroup() && s.resource.Group != "" {
+		return filepath.Join("api", s.resource.Group, version, name)
+	}
+	return filepath.Join("api", version, name)
+}
@@ -0,0 +1,139 @@
+//go:build integration
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	pluginutil "sigs.k8s.io/kubebuilder/v4/pkg/plugin/util"
+	"sigs.k8s.io/kubebuilder/v4/test/e2e/utils"
+)
+
+var _ = Describe("Add Version Integration Test", func() {
+	var kbc *utils.TestContext
+
+	BeforeEach(func() {
+		var err error
+		kbc, err = utils.NewTestContext(pluginutil.KubebuilderBinName, "GO111MODULE=on")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(kbc.Prepare()).To(Succeed())
+
+		By("initializing a project")
+		err = kbc.Init(
+			"--domain", "test.io",
+			"--repo", "test.io/addversiontest",
+		)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		kbc.Destroy()
+	})
+
+	It("should promote a kind to a new version that becomes the hub of the previous ones", func() {
+		By("creating v1alpha1 and v1beta1 with a conversion webhook on v1beta1")
+		for _, version := range []string{"v1alpha1", "v1beta1"} {
+			err := kbc.CreateAPI(
+				"--group", "batch",
+				"--version", version,
+				"--kind", "CronJob",
+				"--resource", "--controller=false",
+				"--make=false",
+			)
+			Expect(err).NotTo(HaveOccurred())
+		}
+		err := kbc.CreateWebhook(
+			"--group", "batch",
+			"--version", "v1beta1",
+			"--kind", "CronJob",
+			"--conversion",
+			"--spoke", "v1alpha1",
+			"--make=false",
+		)
+		Expect(err).NotTo(HaveOccurred())
+
+		By("adding v1 from v1beta1")
+		cmd := exec.Command(kbc.BinaryName, "alpha", "add-version",
+			"--group", "batch",
+			"--version", "v1",
+			"--kind", "CronJob",
+			"--from", "v1beta1",
+			"--make=false",
+		)
+		_, err = kbc.Run(cmd)
+		Expect(err).NotTo(HaveOccurred())
+
+		readFile := func(path string) string {
+			content, readErr := os.ReadFile(filepath.Join(kbc.Dir, path))
+			Expect(readErr).NotTo(HaveOccurred())
+			return string(content)
+		}
+
+		By("verifying the types were copied and v1 is the storage version")
+		types := readFile("api/v1/cronjob_types.go")
+		Expect(types).To(ContainSubstring("package v1\n"))
+		Expect(types).To(ContainSubstring("// +kubebuilder:storageversion"))
+		Expect(readFile("api/v1beta1/cronjob_types.go")).NotTo(ContainSubstring("// +kubebuilder:storageversion"))
+		Expect(readFile("api/v1/groupversion_info.go")).To(ContainSubstring(`Version: "v1"`))
+
+		By("verifying v1 is the hub and the previous versions are its spokes")
+		Expect(readFile("api/v1/cronjob_conversion.go")).To(ContainSubstring("func (*CronJob) Hub() {}"))
+		for _, spoke := range []string{"v1alpha1", "v1beta1"} {
+			Expect(readFile(filepath.Join("api", spoke, "cronjob_conversion.go"))).To(
+				ContainSubstring("Convert_" + spoke + "_CronJob_To_v1_CronJob(src, dst)"))
+			Expect(readFile(filepath.Join("api", spoke, "zz_generated.conversion.go"))).To(
+				ContainSubstring(`batchv1 "test.io/addversiontest/api/v1"`))
+			Expect(readFile(filepath.Join("api", spoke, "cronjob_conversion_test.go"))).To(
+				ContainSubstring("func FuzzCronJobSpokeHubSpoke(f *testing.F)"))
+		}
+
+		By("verifying the conversion webhook moved to v1")
+		_, err = os.Stat(filepath.Join(kbc.Dir, "internal/webhook/v1beta1/cronjob_webhook.go"))
+		Expect(os.IsNotExist(err)).To(BeTrue())
+		Expect(readFile("internal/webhook/v1/cronjob_webhook.go")).To(
+			ContainSubstring("func SetupCronJobWebhookWithManager("))
+
+		main := readFile("cmd/main.go")
+		Expect(main).To(ContainSubstring("utilruntime.Must(batchv1.AddToScheme(scheme))"))
+		Expect(main).To(ContainSubstring("webhookv1.SetupCronJobWebhookWithManager(mgr)"))
+		Expect(main).NotTo(ContainSubstring("webhookv1beta1.SetupCronJobWebhookWithManager(mgr)"))
+
+		By("verifying the sample and the PROJECT file")
+		Expect(readFile("config/samples/kustomization.yaml")).To(ContainSubstring("- batch_v1_cronjob.yaml"))
+		_, err = os.Stat(filepath.Join(kbc.Dir, "config/samples/batch_v1_cronjob.yaml"))
+		Expect(err).NotTo(HaveOccurred())
+
+		By("verifying the migration of the stored objects to v1")
+		Expect(readFile("config/migration/kustomization.yaml")).To(ContainSubstring("- cronjob_migration.yaml"))
+		Expect(readFile("config/migration/cronjob_migration.yaml")).To(
+			ContainSubstring(`--patch={"status":{"storedVersions":["v1"]}}`))
+		Expect(readFile("Makefile")).To(ContainSubstring("\nmigrate-storage: kustomize ##"))
+		Expect(readFile("test/e2e/e2e_test.go")).To(
+			ContainSubstring(`It("should migrate the stored CronJob objects to the storage version"`))
+
+		project := readFile("PROJECT")
+		Expect(project).To(ContainSubstring("storageVersion: true"))
+		Expect(project).To(ContainSubstring("    spoke:\n    - v1alpha1\n    - v1beta1\n"))
+	})
+})
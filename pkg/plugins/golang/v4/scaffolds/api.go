@@ -20,6 +20,9 @@ import (
 	"errors"
 	"fmt"
 	log "log/slog"
+	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/spf13/afero"
 
@@ -27,8 +30,10 @@ import (
 	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
 	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugins"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds/internal/templates/api"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds/internal/templates/cmd"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds/internal/templates/config/samples"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds/internal/templates/controllers"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds/internal/templates/hack"
 )
@@ -46,14 +51,45 @@ type apiScaffolder struct {
 
 	// force indicates whether to scaffold controller files even if it exists or not
 	force bool
+
+	// specFields and statusFields are the fields scaffolded in the API types
+	specFields   []golang.Field
+	statusFields []golang.Field
+
+	// finalizer indicates whether the controller manages a finalizer
+	finalizer bool
+
+	// controllerStyle is the name of the template of the controller
+	controllerStyle string
+
+	// owns and watches are the resources owned and watched by the controller
+	owns    []resource.GVK
+	watches []resource.Watch
+
+	// crd is the CRD that the API is created from, and crdTypes the types generated for its versions
+	crd      *golang.CRD
+	crdTypes map[string]*golang.SchemaTypes
+
+	// celRules are the CEL validation rules added to the API types
+	celRules []golang.CELRule
 }
 
-// NewAPIScaffolder returns a new Scaffolder for API/controller creation operations
-func NewAPIScaffolder(cfg config.Config, res resource.Resource, force bool) plugins.Scaffolder {
+// NewAPIScaffolder returns a new Scaffolder for API/controller creation operations.
+// The options provide the scaffolding settings that are not stored in the resource, such as its fields.
+func NewAPIScaffolder(cfg config.Config, res resource.Resource, force bool, opts golang.Options) plugins.Scaffolder {
 	return &apiScaffolder{
-		config:   cfg,
-		resource: res,
-		force:    force,
+		config:          cfg,
+		resource:        res,
+		force:           force,
+		specFields:      opts.SpecFields,
+		statusFields:    opts.StatusFields,
+		finalizer:       opts.Finalizer,
+		controllerStyle: opts.ControllerStyle,
+		owns:            opts.Owns,
+		watches:         opts.Watches,
+		crd:             opts.CRD,
+		crdTypes:        opts.CRDTypes,
+		celRules:        opts.CELRules,
 	}
 }
 
@@ -96,16 +132,67 @@ func (s *apiScaffolder) Scaffold() error {
 		return fmt.Errorf("error updating resource: %w", err)
 	}
 
+	if doAPI && s.resource.API.StorageVersion {
+		migrate, err := s.unsetStorageVersion()
+		if err != nil {
+			return err
+		}
+		// The objects stored in the previous storage version of the kind need to be migrated
+		if migrate {
+			scaffolder := NewMigrationScaffolder(s.config, s.resource)
+			scaffolder.InjectFS(s.fs)
+			if err = scaffolder.Scaffold(); err != nil {
+				return fmt.Errorf("error scaffolding the storage version migration: %w", err)
+			}
+		}
+	}
+
 	if doAPI {
 		if err := scaffold.Execute(
-			&api.Types{Force: s.force},
+			&api.Types{
+				Force:        s.force,
+				SpecFields:   s.specFields,
+				StatusFields: s.statusFields,
+				Schema:       s.crdTypes[s.resource.Version],
+			},
 			&api.Group{},
 		); err != nil {
 			return fmt.Errorf("error scaffolding APIs: %w", err)
 		}
+
+		if err := s.addCELRules(); err != nil {
+			return err
+		}
+
+		if err := s.updateSample(scaffold); err != nil {
+			return err
+		}
+
+		if s.crd != nil {
+			if err := s.scaffoldCRDVersions(string(boilerplate)); err != nil {
+				return err
+			}
+		}
+
+		if s.resource.API.Clients {
+			if err := s.scaffoldClients(scaffold); err != nil {
+				return err
+			}
+		}
 	}
 
 	if doController {
+		// The status conditions are managed by the controllers of the APIs scaffolded with them,
+		// even when the controller is added later
+		conditions := false
+		// The tests of controllers managing a finalizer exercise the deletion of the resources,
+		// which they can create when the API is owned by the project
+		testAPI := doAPI
+		if res, err := s.config.GetResource(s.resource.GVK); err == nil && res.HasAPI() && !res.External {
+			conditions = res.API.Conditions
+			testAPI = testAPI || s.finalizer
+		}
+
 		// Get the controller name to scaffold
 		// If using the new Controllers field, get the last added controller name
 		// Otherwise, use empty string to generate default name
@@ -118,17 +205,52 @@ func (s *apiScaffolder) Scaffold() error {
 			}
 		}
 
+		owns, watches, err := s.relatedResources()
+		if err != nil {
+			return err
+		}
+
+		style, err := GetControllerStyle(s.controllerStyle)
+		if err != nil {
+			return fmt.Errorf("error scaffolding controller: %w", err)
+		}
+
 		if err := scaffold.Execute(
 			&controllers.SuiteTest{Force: s.force},
 			&controllers.Controller{
 				ControllerRuntimeVersion: ControllerRuntimeVersion,
 				Force:                    s.force,
 				ControllerName:           controllerName,
+				Conditions:               conditions,
+				Finalizer:                s.finalizer,
+				Owns:                     owns,
+				Watches:                  watches,
+				ReconcileTemplate:        style.Reconcile,
+				StyleImports:             style.Imports,
+			},
+			&controllers.ControllerTest{
+				Force:          s.force,
+				DoAPI:          testAPI,
+				Conditions:     conditions,
+				Finalizer:      s.finalizer,
+				ControllerName: controllerName,
+				SpecFields:     s.specFields,
 			},
-			&controllers.ControllerTest{Force: s.force, DoAPI: doAPI},
 		); err != nil {
 			return fmt.Errorf("error scaffolding controller: %w", err)
 		}
+
+		// The external types owned or watched by the controller need to be registered in the scheme
+		for _, res := range owns {
+			if err := s.wireExternalType(scaffold, res); err != nil {
+				return err
+			}
+		}
+		for _, watch := range watches {
+			if err := s.wireExternalType(scaffold, watch.Resource); err != nil {
+				return err
+			}
+		}
 	}
 
 	if err := scaffold.Execute(
@@ -151,3 +273,158 @@ func (s *apiScaffolder) Scaffold() error {
 
 	return nil
 }
+
+// addCELRules adds the markers of the CEL rules to the scaffolded types.
+func (s *apiScaffolder) addCELRules() error {
+	if len(s.celRules) == 0 {
+		return nil
+	}
+
+	editScaffolder := &editAPIScaffolder{config: s.config, resource: s.resource, fs: s.fs}
+	path := editScaffolder.apiFilePath(s.resource.Version, "types")
+	if err := editScaffolder.editGoFile(path, func(content string) (string, error) {
+		return addCELRules(path, content, s.resource.Kind, s.celRules)
+	}); err != nil {
+		return fmt.Errorf("error adding the CEL rules of %s: %w", s.resource.Kind, err)
+	}
+
+	return nil
+}
+
+// updateSample fills the sample CR with the spec fields. The sample is scaffolded by the kustomize plugin,
+// so it is only updated when it was found.
+func (s *apiScaffolder) updateSample(scaffold *machinery.Scaffold) error {
+	if len(s.specFields) == 0 {
+		return nil
+	}
+
+	if exists, err := afero.Exists(s.fs.FS, samples.SamplePath(s.resource)); err != nil || !exists {
+		return nil
+	}
+
+	if err := scaffold.Execute(&samples.CRDSample{SpecFields: s.specFields}); err != nil {
+		return fmt.Errorf("error updating the sample: %w", err)
+	}
+
+	return nil
+}
+
+// scaffoldCRDVersions scaffolds the APIs of the other served versions of the CRD that the API is created from,
+// which are registered in the scheme without a controller.
+func (s *apiScaffolder) scaffoldCRDVersions(boilerplate string) error {
+	for _, res := range s.crd.Resources(s.config) {
+		if res.Version == s.resource.Version {
+			continue
+		}
+		res.API.Clients = s.resource.API.Clients
+
+		if err := s.config.UpdateResource(res); err != nil {
+			return fmt.Errorf("error updating resource %q: %w", res.GVK, err)
+		}
+
+		scaffold := machinery.NewScaffold(s.fs,
+			machinery.WithConfig(s.config),
+			machinery.WithBoilerplate(boilerplate),
+			machinery.WithResource(&res),
+		)
+		if err := scaffold.Execute(
+			&api.Types{Force: s.force, Schema: s.crdTypes[res.Version]},
+			&api.Group{},
+			&cmd.MainUpdater{WireResource: true},
+		); err != nil {
+			return fmt.Errorf("error scaffolding the API of version %q: %w", res.Version, err)
+		}
+	}
+
+	return nil
+}
+
+// storageVersionMarker is the marker that flags the storage version of a CRD
+const storageVersionMarker = "// +kubebuilder:storageversion\n"
+
+// unsetStorageVersion removes the storage version from every other version of the same kind,
+// both in the project configuration and in their types files, as a CRD can only have one.
+// Markers that were moved or reworded by hand are left untouched. It reports whether the kind
+// has other versions, whose stored objects need to be migrated to the new storage version.
+func (s *apiScaffolder) unsetStorageVersion() (bool, error) {
+	resources, err := s.config.GetResources()
+	if err != nil {
+		return false, fmt.Errorf("error getting resources: %w", err)
+	}
+
+	otherVersions := false
+	for _, res := range resources {
+		if res.Group != s.resource.Group || res.Domain != s.resource.Domain || res.Kind != s.resource.Kind ||
+			res.Version == s.resource.Version || !res.HasAPI() {
+			continue
+		}
+		otherVersions = true
+
+		if res.API.StorageVersion {
+			res.API.StorageVersion = false
+			if err := s.config.ReplaceResource(res); err != nil {
+				return false, fmt.Errorf("error unsetting the storage version of %q: %w", res.GVK, err)
+			}
+		}
+
+		path := filepath.Join("api", res.Version, strings.ToLower(res.Kind)+"_types.go")
+		if s.config.IsMultiGroup() && res.Group != "" {
+			path = filepath.Join("api", res.Group, res.Version, strings.ToLower(res.Kind)+"_types.go")
+		}
+
+		content, err := afero.ReadFile(s.fs.FS, path)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return false, fmt.Errorf("error reading %q: %w", path, err)
+		}
+
+		if !strings.Contains(string(content), storageVersionMarker) {
+			continue
+		}
+
+		edited := strings.Replace(string(content), storageVersionMarker, "", 1)
+		if err := afero.WriteFile(s.fs.FS, path, []byte(edited), machinery.DefaultFilePermission); err != nil {
+			return false, fmt.Errorf("error writing %q: %w", path, err)
+		}
+		log.Info("removed the storage version marker", "file", path)
+	}
+
+	return otherVersions, nil
+}
+
+// relatedResources returns the resources owned and watched by the controller, with the Go packages that define them.
+func (s *apiScaffolder) relatedResources() ([]resource.Resource, []controllers.Watch, error) {
+	owns := make([]resource.Resource, 0, len(s.owns))
+	for _, gvk := range s.owns {
+		res, err := golang.RelatedResource(s.config, gvk)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error scaffolding controller: %w", err)
+		}
+		owns = append(owns, res)
+	}
+
+	watches := make([]controllers.Watch, 0, len(s.watches))
+	for _, watch := range s.watches {
+		res, err := golang.RelatedResource(s.config, watch.GVK)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error scaffolding controller: %w", err)
+		}
+		watches = append(watches, controllers.Watch{Resource: res, Mapper: watch.Mapper})
+	}
+
+	return owns, watches, nil
+}
+
+// wireExternalType imports the package of an external type in cmd/main.go and adds it to the scheme.
+func (s *apiScaffolder) wireExternalType(scaffold *machinery.Scaffold, res resource.Resource) error {
+	if !res.IsExternal() {
+		return nil
+	}
+
+	if err := scaffold.Execute(&cmd.MainUpdater{ResourceMixin: machinery.ResourceMixin{Resource: &res}}); err != nil {
+		return fmt.Errorf("error registering the external type %s in cmd/main.go: %w", res.Kind, err)
+	}
+	return nil
+}
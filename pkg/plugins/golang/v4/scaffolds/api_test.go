@@ -0,0 +1,135 @@
+//go:build integration
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	"strings"
+
+	"github.com/spf13/afero"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	cfgv3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+)
+
+var _ = Describe("apiScaffolder", func() {
+	Context("unsetStorageVersion", func() {
+		const typesV1 = `package v1
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// Captain is the Schema for the captains API
+type Captain struct{}
+`
+
+		It("should remove the storage version from the other versions of the kind", func() {
+			cfg := cfgv3.New()
+			Expect(cfg.SetRepository("test.io/storage")).To(Succeed())
+
+			gvk := resource.GVK{Group: "crew", Domain: "test.io", Version: "v1", Kind: "Captain"}
+			Expect(cfg.AddResource(resource.Resource{
+				GVK: gvk,
+				API: &resource.API{CRDVersion: "v1", Namespaced: true, StorageVersion: true},
+			})).To(Succeed())
+
+			fs := machinery.Filesystem{FS: afero.NewMemMapFs()}
+			Expect(afero.WriteFile(fs.FS, "api/v1/captain_types.go", []byte(typesV1), 0o644)).To(Succeed())
+
+			res := resource.Resource{
+				GVK: resource.GVK{Group: "crew", Domain: "test.io", Version: "v2", Kind: "Captain"},
+				API: &resource.API{CRDVersion: "v1", Namespaced: true, StorageVersion: true},
+			}
+			s := &apiScaffolder{config: cfg, resource: res, fs: fs}
+			otherVersions, err := s.unsetStorageVersion()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(otherVersions).To(BeTrue())
+
+			stored, err := cfg.GetResource(gvk)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stored.API.StorageVersion).To(BeFalse())
+
+			content, err := afero.ReadFile(fs.FS, "api/v1/captain_types.go")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).NotTo(ContainSubstring("+kubebuilder:storageversion"))
+			Expect(string(content)).To(ContainSubstring("// +kubebuilder:subresource:status\n\n// Captain is"))
+		})
+	})
+
+	Context("addClientsTargets", func() {
+		const makefile = `.PHONY: generate
+generate: controller-gen ## Generate code containing DeepCopy, DeepCopyInto, and DeepCopyObject method implementations.
+	"$(CONTROLLER_GEN)" object:headerFile="hack/boilerplate.go.txt",year=$(YEAR) paths="./..."
+
+.PHONY: fmt
+fmt: ## Run go fmt against code.
+	go fmt ./...
+
+## Tool Binaries
+ENVTEST ?= $(LOCALBIN)/setup-envtest
+GOLANGCI_LINT = $(LOCALBIN)/golangci-lint
+
+## Tool Versions
+GOLANGCI_LINT_VERSION ?= v2.8.0
+
+.PHONY: golangci-lint
+golangci-lint: $(GOLANGCI_LINT) ## Download golangci-lint locally if necessary.
+`
+
+		var s *apiScaffolder
+
+		BeforeEach(func() {
+			s = &apiScaffolder{fs: machinery.Filesystem{FS: afero.NewMemMapFs()}}
+		})
+
+		It("should add the generate-clients target run by the generate target", func() {
+			Expect(afero.WriteFile(s.fs.FS, makefilePath, []byte(makefile), 0o644)).To(Succeed())
+			Expect(s.addClientsTargets()).To(Succeed())
+
+			content, err := afero.ReadFile(s.fs.FS, makefilePath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(ContainSubstring(
+				"paths=\"./...\"\n\t$(MAKE) generate-clients\n\n.PHONY: generate-clients\n"))
+			Expect(string(content)).To(ContainSubstring(
+				"ENVTEST ?= $(LOCALBIN)/setup-envtest\nCLIENT_GEN ?= $(LOCALBIN)/client-gen\n"))
+			Expect(string(content)).To(ContainSubstring("CODE_GENERATOR_VERSION ?="))
+			Expect(string(content)).To(ContainSubstring(".PHONY: code-generator\n"))
+
+			By("adding the targets only once")
+			Expect(s.addClientsTargets()).To(Succeed())
+			again, err := afero.ReadFile(s.fs.FS, makefilePath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(again).To(Equal(content))
+		})
+
+		It("should leave the Makefiles whose layout was changed untouched", func() {
+			changed := strings.Replace(makefile, ".PHONY: fmt\n", "", 1)
+			Expect(afero.WriteFile(s.fs.FS, makefilePath, []byte(changed), 0o644)).To(Succeed())
+			Expect(s.addClientsTargets()).To(Succeed())
+
+			content, err := afero.ReadFile(s.fs.FS, makefilePath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(Equal(changed))
+		})
+	})
+})
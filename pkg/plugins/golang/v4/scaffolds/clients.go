@@ -0,0 +1,155 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	"errors"
+	"fmt"
+	log "log/slog"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds/internal/templates/client"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds/internal/templates/hack"
+)
+
+// makefilePath is the path to the Makefile of the project
+const makefilePath = "Makefile"
+
+// generateClientsTarget is the Makefile target that runs hack/update-codegen.sh
+const generateClientsTarget = `.PHONY: generate-clients
+generate-clients: code-generator ## Generate the typed clientset, listers, informers and apply configurations in pkg/client.
+	CLIENT_GEN="$(CLIENT_GEN)" LISTER_GEN="$(LISTER_GEN)" INFORMER_GEN="$(INFORMER_GEN)" \
+		APPLYCONFIGURATION_GEN="$(APPLYCONFIGURATION_GEN)" bash hack/update-codegen.sh
+
+`
+
+// codeGeneratorBinaries are the Makefile variables holding the paths to the code generators
+const codeGeneratorBinaries = `
+CLIENT_GEN ?= $(LOCALBIN)/client-gen
+LISTER_GEN ?= $(LOCALBIN)/lister-gen
+INFORMER_GEN ?= $(LOCALBIN)/informer-gen
+APPLYCONFIGURATION_GEN ?= $(LOCALBIN)/applyconfiguration-gen`
+
+// codeGeneratorVersion is the Makefile variable holding the version of the code generators
+const codeGeneratorVersion = `#CODE_GENERATOR_VERSION is the version of k8s.io/code-generator, which follows the version of k8s.io/api
+CODE_GENERATOR_VERSION ?= $(shell v='$(call gomodver,k8s.io/api)'; \
+  [ -n "$$v" ] || { echo "Set CODE_GENERATOR_VERSION manually (k8s.io/api replace has no tag)" >&2; exit 1; }; \
+  printf '%s\n' "$$v")
+
+`
+
+// codeGeneratorTarget is the Makefile target that installs the code generators
+const codeGeneratorTarget = `.PHONY: code-generator
+code-generator: $(LOCALBIN) ## Download the client, lister, informer and apply configuration generators locally if necessary.
+	$(call go-install-tool,$(CLIENT_GEN),k8s.io/code-generator/cmd/client-gen,$(CODE_GENERATOR_VERSION))
+	$(call go-install-tool,$(LISTER_GEN),k8s.io/code-generator/cmd/lister-gen,$(CODE_GENERATOR_VERSION))
+	$(call go-install-tool,$(INFORMER_GEN),k8s.io/code-generator/cmd/informer-gen,$(CODE_GENERATOR_VERSION))
+	$(call go-install-tool,$(APPLYCONFIGURATION_GEN),k8s.io/code-generator/cmd/applyconfiguration-gen,$(CODE_GENERATOR_VERSION))
+
+`
+
+// generateObjectRecipe matches the line of the generate target that generates the DeepCopy methods
+var generateObjectRecipe = regexp.MustCompile(`(?m)^\t"?\$\(CONTROLLER_GEN\)"? object:.*$`)
+
+// scaffoldClients scaffolds the script generating the clients of all the APIs created with clients,
+// the package holding them and the Makefile targets running the script.
+func (s *apiScaffolder) scaffoldClients(scaffold *machinery.Scaffold) error {
+	resources, err := s.config.GetResources()
+	if err != nil {
+		return fmt.Errorf("error getting resources: %w", err)
+	}
+
+	var packages []string
+	for _, res := range resources {
+		if res.HasAPI() && !res.IsExternal() && res.API.Clients && !slices.Contains(packages, res.Path) {
+			packages = append(packages, res.Path)
+		}
+	}
+	slices.Sort(packages)
+
+	if err := scaffold.Execute(
+		&hack.UpdateCodegen{Packages: packages},
+		&client.Doc{},
+	); err != nil {
+		return fmt.Errorf("error scaffolding the clients: %w", err)
+	}
+
+	return s.addClientsTargets()
+}
+
+// addClientsTargets adds the generate-clients target to the Makefile, run by its generate target.
+// Makefiles whose layout was changed by hand are left untouched, and the targets need to be added manually.
+func (s *apiScaffolder) addClientsTargets() error {
+	content, err := afero.ReadFile(s.fs.FS, makefilePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("error reading %q: %w", makefilePath, err)
+	}
+
+	makefile := string(content)
+	if strings.Contains(makefile, "\ngenerate-clients:") {
+		return nil
+	}
+
+	// The code is inserted before its anchor, or after it when the anchor is the previous line
+	insertions := []struct {
+		anchor, code string
+		after        bool
+	}{
+		{anchor: ".PHONY: fmt\n", code: generateClientsTarget},
+		{anchor: "ENVTEST ?= $(LOCALBIN)/setup-envtest", code: codeGeneratorBinaries, after: true},
+		{anchor: "GOLANGCI_LINT_VERSION ?=", code: codeGeneratorVersion},
+		{anchor: ".PHONY: golangci-lint\n", code: codeGeneratorTarget},
+	}
+
+	recipe := generateObjectRecipe.FindStringIndex(makefile)
+	layoutChanged := recipe == nil
+	for _, insertion := range insertions {
+		layoutChanged = layoutChanged || !strings.Contains(makefile, insertion.anchor)
+	}
+	if layoutChanged {
+		log.Warn("unable to add the generate-clients target to the Makefile, as its layout was changed. "+
+			"Add a target installing the code generators and running hack/update-codegen.sh",
+			"file_path", makefilePath)
+		return nil
+	}
+
+	// Generate the clients after the DeepCopy methods, as the packages of the APIs need to compile
+	makefile = makefile[:recipe[1]] + "\n\t$(MAKE) generate-clients" + makefile[recipe[1]:]
+	for _, insertion := range insertions {
+		i := strings.Index(makefile, insertion.anchor)
+		if insertion.after {
+			i += len(insertion.anchor)
+		}
+		makefile = makefile[:i] + insertion.code + makefile[i:]
+	}
+
+	if err := afero.WriteFile(s.fs.FS, makefilePath, []byte(makefile), machinery.DefaultFilePermission); err != nil {
+		return fmt.Errorf("error writing %q: %w", makefilePath, err)
+	}
+	log.Info("added the generate-clients target", "file", makefilePath)
+
+	return nil
+}
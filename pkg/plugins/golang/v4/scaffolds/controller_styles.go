@@ -0,0 +1,121 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds/internal/templates/controllers"
+)
+
+// DefaultControllerStyle is the style of the controllers scaffolded when none is selected
+const DefaultControllerStyle = "minimal"
+
+// ControllerStyle is a template of the controllers scaffolded by create api, selected with its
+// --controller-style flag. The rest of the controller file, such as its RBAC markers, its finalizer
+// and its SetupWithManager method, is shared by all the styles.
+type ControllerStyle struct {
+	// Description summarizes the style in the help of the --controller-style flag.
+	Description string
+
+	// Reconcile is the template of the Reconcile method of the reconciler and of the helpers it calls.
+	// It is executed with the data of the controller file, and can use the "fetchResource" template to get
+	// the reconciled object and run its finalizer, and the "markProgressing" and "updateConditions" templates
+	// to manage its status conditions. See the built-in styles for examples.
+	Reconcile string
+
+	// Imports are the imports required by the Reconcile template in addition to the ones of the
+	// controller file, e.g. "fmt".
+	Imports []string
+
+	// Conditions is true if the controllers of this style manage the status conditions of the resource,
+	// which requires its API to be scaffolded with them.
+	Conditions bool
+
+	// RequiresType is true if the controllers of this style need the Go type of the reconciled resource,
+	// so they cannot be scaffolded for resources whose type is unknown.
+	RequiresType bool
+}
+
+var controllerStyles = map[string]ControllerStyle{
+	DefaultControllerStyle: {
+		Description: "a skeleton of the Reconcile method to implement",
+		Reconcile:   controllers.MinimalReconcileTemplate,
+	},
+	"server-side-apply": {
+		Description:  "builds the desired objects and applies them with field ownership",
+		Reconcile:    controllers.ServerSideApplyReconcileTemplate,
+		Imports:      []string{`"fmt"`},
+		RequiresType: true,
+	},
+	"phased": {
+		Description:  "runs a chain of sub-reconcilers returning results",
+		Reconcile:    controllers.PhasedReconcileTemplate,
+		RequiresType: true,
+	},
+	"status-driven": {
+		Description:  "tracks the observed generation and the status conditions of the resource",
+		Reconcile:    controllers.MinimalReconcileTemplate,
+		Conditions:   true,
+		RequiresType: true,
+	},
+}
+
+// RegisterControllerStyle allows plugins to register additional controller styles, which can then be
+// selected with the --controller-style flag. It returns an error if the name is already registered.
+func RegisterControllerStyle(name string, style ControllerStyle) error {
+	if name == "" {
+		return errors.New("controller style name cannot be empty")
+	}
+	if style.Reconcile == "" {
+		return fmt.Errorf("controller style %q must define the template of the Reconcile method", name)
+	}
+	if _, exists := controllerStyles[name]; exists {
+		return fmt.Errorf("controller style %q is already registered", name)
+	}
+
+	controllerStyles[name] = style
+	return nil
+}
+
+// GetControllerStyle returns the controller style registered with the given name, or the default one
+// if the name is empty.
+func GetControllerStyle(name string) (ControllerStyle, error) {
+	if name == "" {
+		name = DefaultControllerStyle
+	}
+
+	style, exists := controllerStyles[name]
+	if !exists {
+		return ControllerStyle{}, fmt.Errorf("unknown controller style %q, must be one of: %s",
+			name, strings.Join(ControllerStyleNames(), ", "))
+	}
+	return style, nil
+}
+
+// ControllerStyleNames returns the sorted names of the registered controller styles.
+func ControllerStyleNames() []string {
+	names := make([]string, 0, len(controllerStyles))
+	for name := range controllerStyles {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
@@ -0,0 +1,69 @@
+//go:build integration
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Controller styles", func() {
+	It("should return the default style when no name is given", func() {
+		style, err := GetControllerStyle("")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(style).To(Equal(controllerStyles[DefaultControllerStyle]))
+	})
+
+	It("should list the built-in styles sorted by name", func() {
+		Expect(ControllerStyleNames()).To(Equal([]string{"minimal", "phased", "server-side-apply", "status-driven"}))
+	})
+
+	It("should reject an unknown style", func() {
+		_, err := GetControllerStyle("unknown")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("must be one of: minimal, phased, server-side-apply, status-driven"))
+	})
+
+	Context("RegisterControllerStyle", func() {
+		AfterEach(func() {
+			delete(controllerStyles, "custom")
+		})
+
+		It("should register a style which can then be selected", func() {
+			custom := ControllerStyle{Description: "custom", Reconcile: "{{ .Resource.Kind }}"}
+			Expect(RegisterControllerStyle("custom", custom)).To(Succeed())
+
+			style, err := GetControllerStyle("custom")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(style).To(Equal(custom))
+			Expect(ControllerStyleNames()).To(ContainElement("custom"))
+		})
+
+		It("should reject a style without a name or a Reconcile template", func() {
+			Expect(RegisterControllerStyle("", ControllerStyle{Reconcile: "{{ .Resource.Kind }}"})).NotTo(Succeed())
+			Expect(RegisterControllerStyle("custom", ControllerStyle{})).NotTo(Succeed())
+		})
+
+		It("should reject a style that is already registered", func() {
+			err := RegisterControllerStyle(DefaultControllerStyle, ControllerStyle{Reconcile: "{{ .Resource.Kind }}"})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("already registered"))
+		})
+	})
+})
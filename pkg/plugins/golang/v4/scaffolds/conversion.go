@@ -0,0 +1,178 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	"errors"
+	"fmt"
+	log "log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds/internal/templates/api"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds/internal/templates/hack"
+)
+
+// conversionDataAnnotation is the suffix of the annotation, prefixed by the qualified group, in which the
+// spoke versions stash the fields of the hub versions that they cannot represent
+const conversionDataAnnotation = "/conversion-data"
+
+var _ plugins.Scaffolder = &conversionScaffolder{}
+
+type conversionScaffolder struct {
+	config config.Config
+
+	// fs is the filesystem that will be used by the scaffolder
+	fs machinery.Filesystem
+}
+
+// NewConversionScaffolder returns a new Scaffolder that regenerates the conversion functions of all the spoke
+// versions of the project
+func NewConversionScaffolder(cfg config.Config) plugins.Scaffolder {
+	return &conversionScaffolder{config: cfg}
+}
+
+// InjectFS implements cmdutil.Scaffolder
+func (s *conversionScaffolder) InjectFS(fs machinery.Filesystem) {
+	s.fs = fs
+}
+
+// Scaffold implements cmdutil.Scaffolder
+func (s *conversionScaffolder) Scaffold() error {
+	log.Info("Generating the conversion functions...")
+
+	resources, err := s.config.GetResources()
+	if err != nil {
+		return fmt.Errorf("error getting resources: %w", err)
+	}
+	spokes := make(map[string][]string)
+	var groups []string
+	for _, res := range resources {
+		if !res.HasConversionWebhook() {
+			continue
+		}
+		if _, found := spokes[res.Group]; !found {
+			groups = append(groups, res.Group)
+		}
+		for _, spoke := range res.Webhooks.Spoke {
+			if !slices.Contains(spokes[res.Group], spoke) {
+				spokes[res.Group] = append(spokes[res.Group], spoke)
+			}
+		}
+	}
+	if len(groups) == 0 {
+		return errors.New("no resource has a conversion webhook, create one with create webhook --conversion")
+	}
+
+	boilerplate, err := afero.ReadFile(s.fs.FS, hack.DefaultBoilerplatePath)
+	if err != nil {
+		if !errors.Is(err, afero.ErrFileNotFound) {
+			return fmt.Errorf("error generating conversions: failed to load boilerplate: %w", err)
+		}
+		log.Warn("unable to find boilerplate file", "file_path", hack.DefaultBoilerplatePath)
+		boilerplate = []byte("")
+	}
+
+	scaffold := machinery.NewScaffold(s.fs,
+		machinery.WithConfig(s.config),
+		machinery.WithBoilerplate(string(boilerplate)),
+	)
+
+	slices.Sort(groups)
+	for _, group := range groups {
+		slices.Sort(spokes[group])
+		if err = scaffoldConversions(s.config, s.fs, scaffold, group, spokes[group]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// scaffoldConversions generates the conversion functions of the given spoke versions of group, for all the kinds
+// of the project converted from them. The generated file of a spoke version without any such kind is removed.
+func scaffoldConversions(cfg config.Config, fs machinery.Filesystem, scaffold *machinery.Scaffold,
+	group string, spokes []string,
+) error {
+	resources, err := cfg.GetResources()
+	if err != nil {
+		return fmt.Errorf("error getting resources: %w", err)
+	}
+	slices.SortFunc(resources, func(a, b resource.Resource) int { return strings.Compare(a.Kind, b.Kind) })
+
+	for _, spoke := range spokes {
+		spokeDir := filepath.Join("api", spoke)
+		if cfg.IsMultiGroup() && group != "" {
+			spokeDir = filepath.Join("api", group, spoke)
+		}
+
+		var kinds []golang.ConversionKind
+		var hubs []api.ConversionHub
+		var hubPaths []string
+		var annotation string
+		for _, res := range resources {
+			if res.Group != group || !res.HasConversionWebhook() || !slices.Contains(res.Webhooks.Spoke, spoke) {
+				continue
+			}
+			kinds = append(kinds, golang.ConversionKind{Kind: res.Kind, HubPath: res.Path, HubAlias: res.ImportAlias()})
+			if !slices.Contains(hubPaths, res.Path) {
+				hubPaths = append(hubPaths, res.Path)
+				hubs = append(hubs, api.ConversionHub{Alias: res.ImportAlias(), Path: res.Path})
+			}
+			annotation = res.QualifiedGroup() + conversionDataAnnotation
+		}
+
+		if len(kinds) == 0 {
+			path := filepath.Join(spokeDir, golang.ConversionFileName)
+			if err = fs.FS.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+				return fmt.Errorf("error removing %q: %w", path, err)
+			}
+			continue
+		}
+
+		spokePath := resource.APIPackagePath(cfg.GetRepository(), group, spoke, cfg.IsMultiGroup())
+		pkgs, err := golang.LoadConversionPackages(spokeDir, spokePath, hubPaths)
+		if err != nil {
+			return fmt.Errorf("error loading the API types of %s: %w", spokePath, err)
+		}
+		conversions, err := pkgs.GenerateConversions(kinds, annotation)
+		if err != nil {
+			return fmt.Errorf("error generating the conversion functions of %s: %w", spokePath, err)
+		}
+
+		if err = scaffold.Execute(&api.Conversion{
+			Group:        group,
+			SpokeVersion: spoke,
+			Hubs:         hubs,
+			Functions:    conversions.Functions,
+			Stashes:      conversions.Stashes,
+		}); err != nil {
+			return fmt.Errorf("error scaffolding the conversion functions of %s: %w", spokePath, err)
+		}
+	}
+
+	return nil
+}
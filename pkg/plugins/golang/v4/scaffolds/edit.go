@@ -82,8 +82,9 @@ func (s *editScaffolder) Scaffold() error {
 		}
 	}
 
-	// Track if we're toggling namespaced mode
+	// Track if we're toggling namespaced mode or multigroup layout
 	wasNamespaced := s.config.IsNamespaced()
+	wasMultiGroup := s.config.IsMultiGroup()
 
 	// Update config flags
 	if s.multigroup {
@@ -98,6 +99,13 @@ func (s *editScaffolder) Scaffold() error {
 		_ = s.config.ClearNamespaced()
 	}
 
+	// Move the existing packages and manifests to the new layout before any of them is scaffolded again
+	if s.multigroup != wasMultiGroup {
+		if layoutErr := s.moveToLayout(); layoutErr != nil {
+			return fmt.Errorf("failed to move the project to the new layout: %w", layoutErr)
+		}
+	}
+
 	// Scaffold appropriate RBAC and manager config based on namespaced flag
 	if s.namespaced && !wasNamespaced {
 		// Switching to namespaced layout: scaffold Role/RoleBinding and WATCH_NAMESPACE
@@ -166,6 +174,25 @@ func (s *editScaffolder) Scaffold() error {
 	return nil
 }
 
+// moveToLayout moves the Go packages of the APIs, webhooks and controllers, and renames the manifests whose
+// names depend on the layout, from the previous multigroup layout to the one of the project configuration.
+func (s *editScaffolder) moveToLayout() error {
+	// The Go packages are checked to be movable before any file is changed
+	migration, err := newLayoutMigration(s.config, s.fs)
+	if err != nil {
+		return err
+	}
+
+	// Use the kustomize/v2 scaffolder to rename the manifests of the resources
+	layoutScaffolder := kustomizecommonv2.NewLayoutScaffolder(s.config)
+	layoutScaffolder.InjectFS(s.fs)
+	if err = layoutScaffolder.Scaffold(); err != nil {
+		return fmt.Errorf("failed to rename the manifests: %w", err)
+	}
+
+	return migration.apply()
+}
+
 func (s *editScaffolder) scaffoldNamespacedRBAC(force bool) error {
 	// Use the kustomize/v2 scaffolder to scaffold namespace-scoped RBAC and manager config
 	rbacScaffolder := kustomizecommonv2.NewEditScaffolder(s.config, true, force)
@@ -0,0 +1,596 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	"errors"
+	"fmt"
+	log "log/slog"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds/internal/templates/api"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds/internal/templates/hack"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds/internal/templates/webhooks"
+)
+
+const mainPath = "cmd/main.go"
+
+var _ plugins.Scaffolder = &editAPIScaffolder{}
+
+type editAPIScaffolder struct {
+	config config.Config
+
+	// previous is the resource as found in the project configuration before the edition
+	previous resource.Resource
+	// resource is the resource once edited
+	resource resource.Resource
+
+	// controllerName is the name of the renamed controller and newControllerName its new name
+	controllerName    string
+	newControllerName string
+
+	// celRules are the CEL validation rules added to the types
+	celRules []golang.CELRule
+
+	// fs is the filesystem that will be used by the scaffolder
+	fs machinery.Filesystem
+}
+
+// NewEditAPIScaffolder returns a new Scaffolder that updates the files of an existing API from
+// its previous state to the edited one, only adding or removing the code related to the changes.
+// The CEL rules are added to its types.
+func NewEditAPIScaffolder(cfg config.Config, previous, res resource.Resource,
+	controllerName, newControllerName string, celRules []golang.CELRule,
+) plugins.Scaffolder {
+	return &editAPIScaffolder{
+		config:            cfg,
+		previous:          previous,
+		resource:          res,
+		controllerName:    controllerName,
+		newControllerName: newControllerName,
+		celRules:          celRules,
+	}
+}
+
+// InjectFS implements cmdutil.Scaffolder
+func (s *editAPIScaffolder) InjectFS(fs machinery.Filesystem) {
+	s.fs = fs
+}
+
+// Scaffold implements cmdutil.Scaffolder
+func (s *editAPIScaffolder) Scaffold() error {
+	log.Info("Updating the API files...")
+
+	if err := s.addWebhooks(); err != nil {
+		return err
+	}
+	if err := s.removeWebhooks(); err != nil {
+		return err
+	}
+	if err := s.updateAdmission(); err != nil {
+		return err
+	}
+	if err := s.updateScope(); err != nil {
+		return err
+	}
+	if err := s.updateStorageVersion(); err != nil {
+		return err
+	}
+	if err := s.addCELRules(); err != nil {
+		return err
+	}
+	if err := s.renameController(); err != nil {
+		return err
+	}
+
+	if err := s.config.ReplaceResource(s.resource); err != nil {
+		return fmt.Errorf("error updating resource: %w", err)
+	}
+
+	return s.updateConversions()
+}
+
+// addWebhooks scaffolds the webhooks and spokes that the edited resource has but the previous one did not.
+func (s *editAPIScaffolder) addWebhooks() error {
+	added := s.resource.Copy()
+	added.Webhooks = &resource.Webhooks{WebhookVersion: s.resource.Webhooks.WebhookVersion}
+	if s.resource.HasDefaultingWebhook() && !s.previous.HasDefaultingWebhook() {
+		added.Webhooks.Defaulting = true
+		added.Webhooks.DefaultingPath = s.resource.Webhooks.DefaultingPath
+		added.Webhooks.DefaultingAdmission = s.resource.Webhooks.DefaultingAdmission
+	}
+	if s.resource.HasValidationWebhook() && !s.previous.HasValidationWebhook() {
+		added.Webhooks.Validation = true
+		added.Webhooks.ValidationPath = s.resource.Webhooks.ValidationPath
+		added.Webhooks.ValidationAdmission = s.resource.Webhooks.ValidationAdmission
+	}
+	if s.resource.HasConversionWebhook() && !s.previous.HasConversionWebhook() {
+		added.Webhooks.Conversion = true
+		added.Webhooks.Spoke = s.resource.Webhooks.Spoke
+	}
+
+	if added.HasDefaultingWebhook() || added.HasValidationWebhook() || added.HasConversionWebhook() {
+		// The webhook scaffolder updates the existing files with the new webhooks only.
+		scaffolder := NewWebhookScaffolder(s.config, added, false, false)
+		scaffolder.InjectFS(s.fs)
+		if err := scaffolder.Scaffold(); err != nil {
+			return fmt.Errorf("error adding webhooks: %w", err)
+		}
+		return nil
+	}
+
+	if !s.resource.HasConversionWebhook() {
+		return nil
+	}
+
+	// The conversion webhook already exists, so only the new spokes need to be scaffolded.
+	var newSpokes []string
+	for _, spoke := range s.resource.Webhooks.Spoke {
+		if s.previous.Webhooks == nil || !slices.Contains(s.previous.Webhooks.Spoke, spoke) {
+			newSpokes = append(newSpokes, spoke)
+		}
+	}
+	if len(newSpokes) == 0 {
+		return nil
+	}
+
+	scaffold, err := s.newScaffold()
+	if err != nil {
+		return err
+	}
+	for _, spoke := range newSpokes {
+		log.Info("Scaffolding for spoke version", "version", spoke)
+		if err = scaffold.Execute(&api.Spoke{SpokeVersion: spoke}, &api.ConversionTest{SpokeVersion: spoke}); err != nil {
+			return fmt.Errorf("failed to scaffold spoke %s: %w", spoke, err)
+		}
+	}
+
+	return nil
+}
+
+// removeWebhooks removes the code of the webhooks that the previous resource had but the edited one does not.
+func (s *editAPIScaffolder) removeWebhooks() error {
+	removeDefaulting := s.previous.HasDefaultingWebhook() && !s.resource.HasDefaultingWebhook()
+	removeValidation := s.previous.HasValidationWebhook() && !s.resource.HasValidationWebhook()
+	removeConversion := s.previous.HasConversionWebhook() && !s.resource.HasConversionWebhook()
+	if !removeDefaulting && !removeValidation && !removeConversion {
+		return nil
+	}
+
+	webhookPath := s.webhookFilePath(false)
+	testPath := s.webhookFilePath(true)
+	if exists, err := afero.Exists(s.fs.FS, webhookPath); err != nil {
+		return fmt.Errorf("error checking webhook file %q: %w", webhookPath, err)
+	} else if !exists {
+		return fmt.Errorf("unable to find the webhook file %q, webhooks scaffolded with --legacy "+
+			"or moved elsewhere must be removed manually", webhookPath)
+	}
+
+	kind := s.resource.Kind
+	if removeDefaulting {
+		if err := s.editGoFile(webhookPath, func(content string) (string, error) {
+			content = removeChainCall(content, fmt.Sprintf("WithDefaulter(&%sCustomDefaulter{})", kind),
+				"WithDefaulterCustomPath(")
+			return removeGoDecls(webhookPath, content, kind+"CustomDefaulter", webhookMarker(true))
+		}); err != nil {
+			return fmt.Errorf("error removing the defaulting webhook: %w", err)
+		}
+		if err := s.editGoFile(testPath, func(content string) (string, error) {
+			content = removeLines(content, regexp.MustCompile(
+				fmt.Sprintf(`(?m)^\s*defaulter\s*(=\s*)?%sCustomDefaulter(\{\})?\s*\n`, kind)))
+			content = removeLines(content, regexp.MustCompile(`(?m)^\s*Expect\(defaulter\)\..*\n`))
+			return removeBlock(content, fmt.Sprintf(`Context("When creating %s under Defaulting Webhook"`, kind)), nil
+		}); err != nil {
+			return fmt.Errorf("error removing the defaulting webhook tests: %w", err)
+		}
+	}
+
+	if removeValidation {
+		if err := s.editGoFile(webhookPath, func(content string) (string, error) {
+			content = removeChainCall(content, fmt.Sprintf("WithValidator(&%sCustomValidator{})", kind),
+				"WithValidatorCustomPath(")
+			return removeGoDecls(webhookPath, content, kind+"CustomValidator", webhookMarker(false))
+		}); err != nil {
+			return fmt.Errorf("error removing the validating webhook: %w", err)
+		}
+		if err := s.editGoFile(testPath, func(content string) (string, error) {
+			content = removeLines(content, regexp.MustCompile(
+				fmt.Sprintf(`(?m)^\s*validator\s*(=\s*)?%sCustomValidator(\{\})?\s*\n`, kind)))
+			content = removeLines(content, regexp.MustCompile(`(?m)^\s*Expect\(validator\)\..*\n`))
+			return removeBlock(content,
+				fmt.Sprintf(`Context("When creating or updating %s under Validating Webhook"`, kind)), nil
+		}); err != nil {
+			return fmt.Errorf("error removing the validating webhook tests: %w", err)
+		}
+	}
+
+	if removeConversion {
+		if err := s.removeConversion(testPath); err != nil {
+			return err
+		}
+	}
+
+	if s.resource.HasDefaultingWebhook() || s.resource.HasValidationWebhook() || s.resource.HasConversionWebhook() {
+		return nil
+	}
+
+	// No webhook remains, so the webhook is no longer registered in the manager.
+	for _, path := range []string{webhookPath, testPath} {
+		if err := s.fs.FS.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("error removing %q: %w", path, err)
+		}
+	}
+	if err := s.editGoFile(mainPath, func(content string) (string, error) {
+		setup := regexp.MustCompile(`(?m)^[ \t]*// nolint:goconst\n` +
+			`[ \t]*if os\.Getenv\("ENABLE_WEBHOOKS"\) != "false" \{\n` +
+			`[ \t]*if err := \w+\.Setup` + kind + `WebhookWithManager\(mgr\); err != nil \{\n` +
+			`(?:.*\n){2}[ \t]*\}\n[ \t]*\}\n`)
+		if !setup.MatchString(content) {
+			log.Warn("Could not find the webhook setup in the manager", "file", mainPath, "kind", kind,
+				"suggestion", "Manually remove the call to Setup"+kind+"WebhookWithManager")
+		}
+		return setup.ReplaceAllString(content, ""), nil
+	}); err != nil {
+		return fmt.Errorf("error removing the webhook setup: %w", err)
+	}
+
+	suitePath := filepath.Join(filepath.Dir(webhookPath), "webhook_suite_test.go")
+	if err := s.editGoFile(suitePath, func(content string) (string, error) {
+		return removeLines(content, regexp.MustCompile(`(?m)^[ \t]*err = Setup`+kind+`WebhookWithManager\(mgr\)\n`+
+			`[ \t]*Expect\(err\)\.NotTo\(HaveOccurred\(\)\)\n\n?`)), nil
+	}); err != nil {
+		return fmt.Errorf("error removing the webhook setup from the test suite: %w", err)
+	}
+
+	return nil
+}
+
+// updateAdmission updates in place the markers of the existing webhooks whose admission configuration changed.
+// The markers of the added webhooks are already scaffolded with their admission configuration.
+func (s *editAPIScaffolder) updateAdmission() error {
+	previous := make(map[string]resource.AdmissionWebhook)
+	for _, webhook := range s.previous.GetAdmissionWebhooks() {
+		previous[webhook.ConfigurationName(s.previous)] = webhook
+	}
+
+	for _, webhook := range s.resource.GetAdmissionWebhooks() {
+		name := webhook.ConfigurationName(s.resource)
+		old, found := previous[name]
+		if !found || old.Admission.Equal(webhook.Admission) {
+			continue
+		}
+
+		path := s.webhookFilePath(false)
+		if webhook.Name != "" {
+			path = s.namedWebhookFilePath(webhook.Name)
+		}
+		if err := s.editGoFile(path, func(content string) (string, error) {
+			return webhooks.UpdateAdmissionMarker(content, name, old.Admission, webhook.Admission)
+		}); err != nil {
+			return fmt.Errorf("error updating the admission of the webhook %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// removeConversion removes the hub and spoke implementations, their round-trip tests and the conversion webhook
+// tests.
+func (s *editAPIScaffolder) removeConversion(testPath string) error {
+	versions := []string{s.resource.Version}
+	if s.previous.Webhooks != nil {
+		versions = append(versions, s.previous.Webhooks.Spoke...)
+	}
+	for _, version := range versions {
+		for _, suffix := range []string{"conversion", "conversion_test"} {
+			path := s.apiFilePath(version, suffix)
+			if err := s.fs.FS.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+				return fmt.Errorf("error removing %q: %w", path, err)
+			}
+		}
+	}
+
+	if err := s.editGoFile(testPath, func(content string) (string, error) {
+		return removeBlock(content,
+			fmt.Sprintf(`Context("When creating %s under Conversion Webhook"`, s.resource.Kind)), nil
+	}); err != nil {
+		return fmt.Errorf("error removing the conversion webhook tests: %w", err)
+	}
+
+	// The conversion webhook of the CRD is still needed when it moved to another version of the kind
+	resources, err := s.config.GetResources()
+	if err != nil {
+		return fmt.Errorf("error getting resources: %w", err)
+	}
+	for _, res := range resources {
+		if res.Group == s.resource.Group && res.Kind == s.resource.Kind && res.Version != s.resource.Version &&
+			res.HasConversionWebhook() {
+			return nil
+		}
+	}
+
+	log.Warn("The conversion webhook was removed. Remove the conversion patch of the CRD from " +
+		"config/crd/kustomization.yaml if it is no longer needed.")
+
+	return nil
+}
+
+// updateConversions regenerates the conversion functions of the spoke versions that were added to or removed
+// from the conversion webhook. Those of a newly added conversion webhook are generated by the webhook scaffolder.
+func (s *editAPIScaffolder) updateConversions() error {
+	var spokes []string
+	if s.previous.HasConversionWebhook() {
+		for _, spoke := range s.resource.Webhooks.Spoke {
+			if !slices.Contains(s.previous.Webhooks.Spoke, spoke) {
+				spokes = append(spokes, spoke)
+			}
+		}
+	}
+	if s.previous.Webhooks != nil {
+		for _, spoke := range s.previous.Webhooks.Spoke {
+			if s.resource.Webhooks == nil || !slices.Contains(s.resource.Webhooks.Spoke, spoke) {
+				spokes = append(spokes, spoke)
+			}
+		}
+	}
+	if len(spokes) == 0 {
+		return nil
+	}
+
+	scaffold, err := s.newScaffold()
+	if err != nil {
+		return err
+	}
+	if err = scaffoldConversions(s.config, s.fs, scaffold, s.resource.Group, spokes); err != nil {
+		log.Warn("unable to generate the conversion functions, run \"kubebuilder generate conversion\" "+
+			"once the API packages can be loaded", "error", err)
+	}
+
+	return nil
+}
+
+// updateScope adds or removes the cluster scope of the resource marker in the types file.
+func (s *editAPIScaffolder) updateScope() error {
+	if !s.previous.HasAPI() || s.previous.API.Namespaced == s.resource.API.Namespaced {
+		return nil
+	}
+
+	path := s.apiFilePath(s.resource.Version, "types")
+	if err := s.editGoFile(path, func(content string) (string, error) {
+		return setClusterScope(content, s.resource.Kind, !s.resource.API.Namespaced)
+	}); err != nil {
+		return fmt.Errorf("error updating the scope of %s: %w", s.resource.Kind, err)
+	}
+
+	return nil
+}
+
+// updateStorageVersion adds the storage version marker to the types file when the resource becomes the
+// storage version, and removes it from the other versions of the kind.
+func (s *editAPIScaffolder) updateStorageVersion() error {
+	if !s.resource.HasAPI() || !s.resource.API.StorageVersion || s.previous.API.StorageVersion {
+		return nil
+	}
+
+	scaffold, err := s.newScaffold()
+	if err != nil {
+		return err
+	}
+	if err = scaffold.Execute(&api.TypesUpdater{StorageVersion: true}); err != nil {
+		return fmt.Errorf("error adding the storage version marker of %s: %w", s.resource.Kind, err)
+	}
+
+	apiScaffolder := &apiScaffolder{config: s.config, resource: s.resource, fs: s.fs}
+	if _, err = apiScaffolder.unsetStorageVersion(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// addCELRules adds the markers of the CEL rules to the types file.
+func (s *editAPIScaffolder) addCELRules() error {
+	if len(s.celRules) == 0 {
+		return nil
+	}
+
+	path := s.apiFilePath(s.resource.Version, "types")
+	if exists, err := afero.Exists(s.fs.FS, path); err != nil {
+		return fmt.Errorf("error checking types file %q: %w", path, err)
+	} else if !exists {
+		return fmt.Errorf("unable to find the types file %q to add the CEL rules to", path)
+	}
+	if err := s.editGoFile(path, func(content string) (string, error) {
+		return addCELRules(path, content, s.resource.Kind, s.celRules)
+	}); err != nil {
+		return fmt.Errorf("error adding the CEL rules of %s: %w", s.resource.Kind, err)
+	}
+
+	return nil
+}
+
+// renameController renames the controller file and the reconciler, and updates the name used to register it.
+func (s *editAPIScaffolder) renameController() error {
+	if s.newControllerName == "" || s.newControllerName == s.controllerName {
+		return nil
+	}
+
+	kind, group, multiGroup := s.resource.Kind, s.resource.Group, s.config.IsMultiGroup()
+	oldReconciler := resource.NormalizeReconcilerName(s.controllerName, kind)
+	newReconciler := resource.NormalizeReconcilerName(s.newControllerName, kind)
+	oldRuntimeName := resource.GetControllerName(s.controllerName, kind, group, multiGroup)
+	newRuntimeName := resource.GetControllerName(s.newControllerName, kind, group, multiGroup)
+
+	// The manager refers to the reconciler through the package of the controllers of the group in the
+	// multigroup layout.
+	dir, qualifier := filepath.Join("internal", "controller"), "controller"
+	if multiGroup && group != "" {
+		dir = filepath.Join(dir, group)
+		qualifier = s.resource.PackageName() + "controller"
+	}
+	oldPath := filepath.Join(dir, resource.NormalizeFileName(s.controllerName)+"_controller.go")
+	newPath := filepath.Join(dir, resource.NormalizeFileName(s.newControllerName)+"_controller.go")
+	oldTestPath := strings.TrimSuffix(oldPath, ".go") + "_test.go"
+	newTestPath := strings.TrimSuffix(newPath, ".go") + "_test.go"
+
+	// The test of the controller, if any, is renamed with it, so both new names must be free.
+	hasTest, err := afero.Exists(s.fs.FS, oldTestPath)
+	if err != nil {
+		return fmt.Errorf("error checking controller test file %q: %w", oldTestPath, err)
+	}
+	for _, path := range []string{newPath, newTestPath} {
+		if exists, existsErr := afero.Exists(s.fs.FS, path); existsErr != nil {
+			return fmt.Errorf("error checking controller file %q: %w", path, existsErr)
+		} else if exists {
+			return fmt.Errorf("unable to rename the controller, %q already exists", path)
+		}
+	}
+	if err = s.fs.FS.Rename(oldPath, newPath); err != nil {
+		return fmt.Errorf("error renaming controller file %q: %w", oldPath, err)
+	}
+	if hasTest {
+		if err = s.fs.FS.Rename(oldTestPath, newTestPath); err != nil {
+			return fmt.Errorf("error renaming controller test file %q: %w", oldTestPath, err)
+		}
+	}
+
+	reconciler := regexp.MustCompile(`\b` + oldReconciler + `\b`)
+	if err = s.editGoFile(newPath, func(content string) (string, error) {
+		content = reconciler.ReplaceAllString(content, newReconciler)
+		return strings.ReplaceAll(content,
+			fmt.Sprintf("Named(%q)", oldRuntimeName), fmt.Sprintf("Named(%q)", newRuntimeName)), nil
+	}); err != nil {
+		return fmt.Errorf("error renaming the controller: %w", err)
+	}
+
+	// The reconciler is also referenced by the tests of the package and by the manager.
+	tests, err := afero.Glob(s.fs.FS, filepath.Join(dir, "*_test.go"))
+	if err != nil {
+		return fmt.Errorf("error listing controller tests: %w", err)
+	}
+	for _, path := range tests {
+		if err = s.editGoFile(path, func(content string) (string, error) {
+			return reconciler.ReplaceAllString(content, newReconciler), nil
+		}); err != nil {
+			return fmt.Errorf("error renaming the controller in %q: %w", path, err)
+		}
+	}
+
+	if err = s.editGoFile(mainPath, func(content string) (string, error) {
+		content = regexp.MustCompile(`\b`+qualifier+`\.`+oldReconciler+`\b`).
+			ReplaceAllString(content, qualifier+"."+newReconciler)
+		return strings.ReplaceAll(content,
+			fmt.Sprintf(`"controller", %q)`, oldRuntimeName), fmt.Sprintf(`"controller", %q)`, newRuntimeName)), nil
+	}); err != nil {
+		return fmt.Errorf("error renaming the controller in the manager: %w", err)
+	}
+
+	return nil
+}
+
+// newScaffold returns a machinery.Scaffold for the edited resource.
+func (s *editAPIScaffolder) newScaffold() (*machinery.Scaffold, error) {
+	boilerplate, err := afero.ReadFile(s.fs.FS, hack.DefaultBoilerplatePath)
+	if err != nil {
+		if !errors.Is(err, afero.ErrFileNotFound) {
+			return nil, fmt.Errorf("failed to load boilerplate: %w", err)
+		}
+		log.Warn("unable to find boilerplate file", "file_path", hack.DefaultBoilerplatePath)
+		boilerplate = []byte("")
+	}
+
+	return machinery.NewScaffold(s.fs,
+		machinery.WithConfig(s.config),
+		machinery.WithBoilerplate(string(boilerplate)),
+		machinery.WithResource(&s.resource),
+	), nil
+}
+
+// editGoFile applies edit to the content of the Go file found in path. Missing files are skipped.
+func (s *editAPIScaffolder) editGoFile(path string, edit func(string) (string, error)) error {
+	content, err := afero.ReadFile(s.fs.FS, path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			log.Warn("skipping missing file", "file", path)
+			return nil
+		}
+		return fmt.Errorf("error reading %q: %w", path, err)
+	}
+
+	edited, err := edit(string(content))
+	if err != nil {
+		return err
+	}
+	if edited == string(content) {
+		return nil
+	}
+
+	formatted, err := formatGoFile(path, edited)
+	if err != nil {
+		return err
+	}
+
+	if err = afero.WriteFile(s.fs.FS, path, formatted, machinery.DefaultFilePermission); err != nil {
+		return fmt.Errorf("error writing %q: %w", path, err)
+	}
+
+	return nil
+}
+
+// webhookFilePath returns the path of the webhook file, or of its test file, of the resource.
+func (s *editAPIScaffolder) webhookFilePath(test bool) string {
+	name := strings.ToLower(s.resource.Kind) + "_webhook.go"
+	if test {
+		name = strings.ToLower(s.resource.Kind) + "_webhook_test.go"
+	}
+
+	if s.config.IsMultiGroup() && s.resource.Group != "" {
+		return filepath.Join("internal", "webhook", s.resource.Group, s.resource.Version, name)
+	}
+	return filepath.Join("internal", "webhook", s.resource.Version, name)
+}
+
+// namedWebhookFilePath returns the path of the file of the named webhook of the resource.
+func (s *editAPIScaffolder) namedWebhookFilePath(name string) string {
+	fileName := fmt.Sprintf("%s_%s_webhook.go", strings.ToLower(s.resource.Kind), resource.NormalizeFileName(name))
+
+	if s.config.IsMultiGroup() && s.resource.Group != "" {
+		return filepath.Join("internal", "webhook", s.resource.Group, s.resource.Version, fileName)
+	}
+	return filepath.Join("internal", "webhook", s.resource.Version, fileName)
+}
+
+// apiFilePath returns the path of the `<kind>_<suffix>.go` file of the resource in the provided version.
+func (s *editAPIScaffolder) apiFilePath(version, suffix string) string {
+	name := fmt.Sprintf("%s_%s.go", strings.ToLower(s.resource.Kind), suffix)
+
+	if s.config.IsMultiGroup() && s.resource.Group != "" {
+		return filepath.Join("api", s.resource.Group, version, name)
+	}
+	return filepath.Join("api", version, name)
+}
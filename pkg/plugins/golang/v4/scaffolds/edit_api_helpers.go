@@ -0,0 +1,371 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	log "log/slog"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/imports"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang"
+)
+
+var blankLines = regexp.MustCompile(`\n{3,}`)
+
+// formatGoFile formats the Go source and removes the imports that are no longer used.
+func formatGoFile(path, content string) ([]byte, error) {
+	formatted, err := imports.Process(path, []byte(content), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error formatting %q: %w", path, err)
+	}
+	return formatted, nil
+}
+
+// webhookMarker returns a function matching the `+kubebuilder:webhook` marker of the mutating
+// or of the validating webhook.
+func webhookMarker(mutating bool) func(string) bool {
+	return func(comment string) bool {
+		return strings.Contains(comment, "+kubebuilder:webhook:") &&
+			strings.Contains(comment, fmt.Sprintf("mutating=%t", mutating))
+	}
+}
+
+// removeGoDecls removes from the Go source the declaration of typeName and of its methods, with their doc
+// comments, and the comment groups holding a comment for which isMarker returns true.
+func removeGoDecls(path, content, typeName string, isMarker func(string) bool) (string, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, content, parser.ParseComments)
+	if err != nil {
+		return "", fmt.Errorf("error parsing %q: %w", path, err)
+	}
+
+	type span struct{ start, end int }
+	var spans []span
+	add := func(doc *ast.CommentGroup, node ast.Node) {
+		start := node.Pos()
+		if doc != nil {
+			start = doc.Pos()
+		}
+		spans = append(spans, span{fset.Position(start).Offset, fset.Position(node.End()).Offset})
+	}
+
+	for _, decl := range file.Decls {
+		switch d := decl.(type) {
+		case *ast.GenDecl:
+			if d.Tok != token.TYPE || len(d.Specs) != 1 {
+				continue
+			}
+			if spec, ok := d.Specs[0].(*ast.TypeSpec); ok && spec.Name.Name == typeName {
+				add(d.Doc, d)
+			}
+		case *ast.FuncDecl:
+			if d.Recv != nil && len(d.Recv.List) == 1 && receiverTypeName(d.Recv.List[0].Type) == typeName {
+				add(d.Doc, d)
+			}
+		}
+	}
+	for _, group := range file.Comments {
+		if slices.ContainsFunc(group.List, func(c *ast.Comment) bool { return isMarker(c.Text) }) {
+			add(nil, group)
+		}
+	}
+
+	if len(spans) == 0 {
+		log.Warn("Could not find the code to remove", "file", path, "type", typeName)
+		return content, nil
+	}
+
+	// Remove the spans from the last one so that the offsets of the previous ones remain valid.
+	slices.SortFunc(spans, func(a, b span) int { return b.start - a.start })
+	end := len(content)
+	for _, s := range spans {
+		if s.end > end {
+			continue
+		}
+		start, stop := lineBounds(content, s.start, s.end)
+		content = content[:start] + content[stop:]
+		end = start
+	}
+
+	return blankLines.ReplaceAllString(content, "\n\n"), nil
+}
+
+// receiverTypeName returns the name of the type of a method receiver, e.g. T for both `t T` and `t *T`.
+func receiverTypeName(expr ast.Expr) string {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	if ident, ok := expr.(*ast.Ident); ok {
+		return ident.Name
+	}
+	return ""
+}
+
+// lineBounds extends the [start, end) range of content to the full lines it spans, including the last newline.
+func lineBounds(content string, start, end int) (int, int) {
+	start = strings.LastIndex(content[:start], "\n") + 1
+	if i := strings.Index(content[end:], "\n"); i != -1 {
+		end += i + 1
+	} else {
+		end = len(content)
+	}
+	return start, end
+}
+
+// removeChainCall removes the line calling call in a method chain, e.g. `WithDefaulter(&FooCustomDefaulter{}).`,
+// and the following line if it calls next, e.g. `WithDefaulterCustomPath("/path").`.
+func removeChainCall(content, call, next string) string {
+	pattern := regexp.MustCompile(`(?m)^[ \t]*` + regexp.QuoteMeta(call) + `\.[ \t]*\n` +
+		`(?:[ \t]*` + regexp.QuoteMeta(next) + `[^\n]*\n)?`)
+	return pattern.ReplaceAllString(content, "")
+}
+
+// removeLines removes the lines matching pattern.
+func removeLines(content string, pattern *regexp.Regexp) string {
+	return pattern.ReplaceAllString(content, "")
+}
+
+// removeBlock removes the block starting with the line containing start and ending with the
+// `})` line with the same indentation, e.g. a Ginkgo Context, and the blank line preceding it.
+func removeBlock(content, start string) string {
+	lines := strings.SplitAfter(content, "\n")
+
+	first := slices.IndexFunc(lines, func(line string) bool { return strings.Contains(line, start) })
+	if first == -1 {
+		log.Warn("Could not find the block to remove", "block", start)
+		return content
+	}
+
+	indent := lines[first][:len(lines[first])-len(strings.TrimLeft(lines[first], " \t"))]
+	last := -1
+	for i := first + 1; i < len(lines); i++ {
+		if strings.TrimRight(lines[i], "\n") == indent+"})" {
+			last = i
+			break
+		}
+	}
+	if last == -1 {
+		log.Warn("Could not find the end of the block to remove", "block", start)
+		return content
+	}
+
+	if first > 0 && strings.TrimSpace(lines[first-1]) == "" {
+		first--
+	}
+
+	return strings.Join(append(lines[:first:first], lines[last+1:]...), "")
+}
+
+// setClusterScope adds or removes `scope=Cluster` from the `+kubebuilder:resource` marker of the kind type.
+func setClusterScope(content, kind string, cluster bool) (string, error) {
+	typeDef := regexp.MustCompile(`(?m)^type\s+` + regexp.QuoteMeta(kind) + `\s+struct`).FindStringIndex(content)
+	if typeDef == nil {
+		return "", fmt.Errorf("unable to find the %s type", kind)
+	}
+
+	const rootMarker = "// +kubebuilder:object:root=true"
+	blockStart := strings.LastIndex(content[:typeDef[0]], rootMarker)
+	if blockStart == -1 {
+		return "", fmt.Errorf("unable to find the markers of the %s type", kind)
+	}
+
+	// The markers are the consecutive comment lines starting with the root marker.
+	blockEnd := blockStart
+	for blockEnd < typeDef[0] {
+		next := strings.Index(content[blockEnd:], "\n")
+		if next == -1 || !strings.HasPrefix(strings.TrimSpace(content[blockEnd:blockEnd+next]), "//") {
+			break
+		}
+		blockEnd += next + 1
+	}
+	block := content[blockStart:blockEnd]
+
+	resourceMarker := regexp.MustCompile(`(?m)^//\s*\+kubebuilder:resource:(.*)\n`)
+	if match := resourceMarker.FindStringSubmatchIndex(block); match != nil {
+		args := make([]string, 0)
+		for _, arg := range strings.Split(block[match[2]:match[3]], ",") {
+			if arg = strings.TrimSpace(arg); arg != "" && !strings.HasPrefix(arg, "scope=") {
+				args = append(args, arg)
+			}
+		}
+		if cluster {
+			args = append(args, "scope=Cluster")
+		}
+
+		marker := ""
+		if len(args) != 0 {
+			marker = "// +kubebuilder:resource:" + strings.Join(args, ",") + "\n"
+		}
+		block = block[:match[0]] + marker + block[match[1]:]
+	} else if cluster {
+		block += "// +kubebuilder:resource:scope=Cluster\n"
+	}
+
+	return content[:blockStart] + block + content[blockEnd:], nil
+}
+
+// addCELRules adds the XValidation markers of the rules to the kind type, or to the fields along their path,
+// in the types file. The path is resolved through the struct types declared in the file by the JSON names
+// of their fields, and the rules already found in the markers are skipped.
+func addCELRules(path, content, kind string, rules []golang.CELRule) (string, error) {
+	for _, rule := range rules {
+		fset := token.NewFileSet()
+		file, err := parser.ParseFile(fset, path, content, parser.ParseComments)
+		if err != nil {
+			return "", fmt.Errorf("error parsing %q: %w", path, err)
+		}
+
+		structs := make(map[string]*ast.StructType)
+		var target token.Pos
+		for _, decl := range file.Decls {
+			d, ok := decl.(*ast.GenDecl)
+			if !ok || d.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range d.Specs {
+				typeSpec, isType := spec.(*ast.TypeSpec)
+				if !isType {
+					continue
+				}
+				if structType, isStruct := typeSpec.Type.(*ast.StructType); isStruct {
+					structs[typeSpec.Name.Name] = structType
+				}
+				if typeSpec.Name.Name == kind {
+					target = typeSpec.Pos()
+					if len(d.Specs) == 1 {
+						target = d.Pos()
+					}
+				}
+			}
+		}
+		if _, found := structs[kind]; !found {
+			return "", fmt.Errorf("unable to find the %s type in %q", kind, path)
+		}
+
+		typeName, fields := kind, rule.Fields()
+		for i, name := range fields {
+			field := findJSONField(structs, typeName, name)
+			if field == nil {
+				return "", fmt.Errorf("unable to add the CEL rule to %q: %s has no field with the JSON name %q",
+					rule.Path, typeName, name)
+			}
+			target = field.Pos()
+			if i == len(fields)-1 {
+				break
+			}
+			if typeName = receiverTypeName(field.Type); structs[typeName] == nil {
+				return "", fmt.Errorf("unable to add the CEL rule to %q: the type of %q is not a struct "+
+					"declared in %q", rule.Path, strings.Join(fields[:i+1], "."), path)
+			}
+		}
+
+		content = insertMarker(content, fset.Position(target).Offset, rule.Marker(), len(fields) == 0)
+	}
+
+	return content, nil
+}
+
+// findJSONField returns the field of the struct type with the provided JSON name, looking into the embedded
+// structs declared in the same file.
+func findJSONField(structs map[string]*ast.StructType, typeName, name string) *ast.Field {
+	structType := structs[typeName]
+	if structType == nil {
+		return nil
+	}
+
+	for _, field := range structType.Fields.List {
+		jsonName := ""
+		if field.Tag != nil {
+			if tag, err := strconv.Unquote(field.Tag.Value); err == nil {
+				jsonName, _, _ = strings.Cut(reflect.StructTag(tag).Get("json"), ",")
+			}
+		}
+		if jsonName == name && len(field.Names) != 0 {
+			return field
+		}
+		if jsonName == "" && len(field.Names) == 0 {
+			if embedded := findJSONField(structs, receiverTypeName(field.Type), name); embedded != nil {
+				return embedded
+			}
+		}
+	}
+
+	return nil
+}
+
+// insertMarker inserts the marker in the comment above the declaration found at offset, after its last marker
+// but before +optional and +required, as the markers of the scaffolded fields, or at its end when it has none.
+// The markers of a type may also be in the comment separated from its doc by a blank line, as the root markers
+// of the scaffolded kinds. The content is unchanged if the marker exists.
+func insertMarker(content string, offset int, marker string, typeDecl bool) string {
+	lineStart := strings.LastIndex(content[:offset], "\n") + 1
+	indent := content[lineStart:offset]
+	line := indent + "// " + marker + "\n"
+
+	// commentAbove returns the start of the comment lines ending at end and the offset where to insert the marker
+	// among them, or -1 if they have no marker.
+	commentAbove := func(end int) (start, insertAt int, exists bool) {
+		start, insertAt = end, -1
+		lastMarker, optional := -1, -1
+		for start > 0 {
+			prev := strings.LastIndex(content[:start-1], "\n") + 1
+			text := strings.TrimSpace(content[prev:start])
+			if !strings.HasPrefix(text, "//") {
+				break
+			}
+			switch text = strings.TrimSpace(strings.TrimPrefix(text, "//")); {
+			case text == "+optional" || text == "+required":
+				optional = prev
+			case strings.HasPrefix(text, "+") && lastMarker == -1:
+				lastMarker = start
+			}
+			exists = exists || content[prev:start] == line
+			start = prev
+		}
+
+		if lastMarker != -1 {
+			return start, lastMarker, exists
+		}
+		return start, optional, exists
+	}
+
+	start, insertAt, exists := commentAbove(lineStart)
+	if insertAt == -1 && typeDecl && start > 0 {
+		// The markers are in the comment separated from the doc of the type by a blank line.
+		if blank := strings.LastIndex(content[:start-1], "\n") + 1; strings.TrimSpace(content[blank:start]) == "" {
+			_, insertAt, exists = commentAbove(blank)
+		}
+	}
+	if exists {
+		log.Warn("Skipping the CEL rule already found in the markers", "marker", marker)
+		return content
+	}
+
+	if insertAt == -1 {
+		insertAt = lineStart
+	}
+	return content[:insertAt] + line + content[insertAt:]
+}
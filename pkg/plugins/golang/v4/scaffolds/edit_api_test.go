@@ -0,0 +1,364 @@
+//go:build integration
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	"fmt"
+
+	"github.com/spf13/afero"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	cfgv3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds/internal/templates/webhooks"
+)
+
+var _ = Describe("edit api helpers", func() {
+	Context("setClusterScope", func() {
+		const types = `package v1
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// Captain is the Schema for the captains API
+type Captain struct{}
+`
+
+		It("should add and remove the cluster scope", func() {
+			content, err := setClusterScope(types, "Captain", true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(content).To(ContainSubstring("// +kubebuilder:subresource:status\n" +
+				"// +kubebuilder:resource:scope=Cluster\n\n// Captain is"))
+
+			content, err = setClusterScope(content, "Captain", false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(content).To(Equal(types))
+		})
+
+		It("should keep the other arguments of the resource marker", func() {
+			withPath := `// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=admirales,scope=Cluster
+type Admiral struct{}
+`
+			content, err := setClusterScope(withPath, "Admiral", false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(content).To(ContainSubstring("// +kubebuilder:resource:path=admirales\n"))
+		})
+
+		It("should fail when the type cannot be found", func() {
+			_, err := setClusterScope(types, "Sailor", true)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("addCELRules", func() {
+		const types = `package v1
+
+type CaptainSpec struct {
+	// ship is the ship of the captain.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Ship *ShipRef ` + "`json:\"ship,omitempty\"`" + `
+
+	Crew int32 ` + "`json:\"crew\"`" + `
+}
+
+type ShipRef struct {
+	Name string ` + "`json:\"name\"`" + `
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// Captain is the Schema for the captains API
+type Captain struct {
+	Spec CaptainSpec ` + "`json:\"spec\"`" + `
+}
+`
+
+		rule := func(value string) golang.CELRule {
+			celRule, err := golang.ParseCELRule(value)
+			Expect(err).NotTo(HaveOccurred())
+			return celRule
+		}
+
+		It("should add the markers to the kind and to the fields along the path", func() {
+			content, err := addCELRules("captain_types.go", types, "Captain", []golang.CELRule{
+				rule(":self.metadata.name.size() < 64"),
+				rule("spec.ship:has(self.name):the ship needs a name"),
+				rule("spec.crew:self >= 0"),
+				rule("spec.ship.name:self != 'titanic'"),
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(content).To(ContainSubstring("// +kubebuilder:subresource:status\n" +
+				`// +kubebuilder:validation:XValidation:rule="self.metadata.name.size() < 64"` + "\n\n// Captain is"))
+			Expect(content).To(ContainSubstring("// +kubebuilder:validation:MinLength=1\n" +
+				`	// +kubebuilder:validation:XValidation:rule="has(self.name)",message="the ship needs a name"` + "\n" +
+				"	// +optional\n"))
+			Expect(content).To(ContainSubstring("\n\n" +
+				`	// +kubebuilder:validation:XValidation:rule="self >= 0"` + "\n	Crew int32"))
+			Expect(content).To(ContainSubstring("type ShipRef struct {\n" +
+				`	// +kubebuilder:validation:XValidation:rule="self != 'titanic'"` + "\n	Name string"))
+		})
+
+		It("should skip the rules already added", func() {
+			rules := []golang.CELRule{rule("spec.crew:self >= 0")}
+			content, err := addCELRules("captain_types.go", types, "Captain", rules)
+			Expect(err).NotTo(HaveOccurred())
+			again, err := addCELRules("captain_types.go", content, "Captain", rules)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(again).To(Equal(content))
+		})
+
+		It("should fail for the paths that cannot be resolved", func() {
+			_, err := addCELRules("captain_types.go", types, "Captain", []golang.CELRule{rule("spec.mate:true")})
+			Expect(err).To(MatchError(ContainSubstring(`CaptainSpec has no field with the JSON name "mate"`)))
+
+			_, err = addCELRules("captain_types.go", types, "Captain", []golang.CELRule{rule("spec.crew.size:true")})
+			Expect(err).To(MatchError(ContainSubstring(`the type of "spec.crew" is not a struct`)))
+		})
+	})
+
+	Context("removeGoDecls", func() {
+		const webhook = `package v1
+
+import "context"
+
+// +kubebuilder:webhook:path=/mutate,mutating=true,name=mcaptain-v1.kb.io
+
+// CaptainCustomDefaulter sets defaults.
+type CaptainCustomDefaulter struct{}
+
+// Default sets the defaults.
+func (d *CaptainCustomDefaulter) Default(_ context.Context) error { return nil }
+
+// +kubebuilder:webhook:path=/validate,mutating=false,name=vcaptain-v1.kb.io
+
+// CaptainCustomValidator validates.
+type CaptainCustomValidator struct{}
+`
+
+		It("should remove the type, its methods and its marker", func() {
+			content, err := removeGoDecls("captain_webhook.go", webhook, "CaptainCustomDefaulter", webhookMarker(true))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(content).NotTo(ContainSubstring("Default"))
+			Expect(content).NotTo(ContainSubstring("mutating=true"))
+			Expect(content).To(ContainSubstring("mutating=false"))
+			Expect(content).To(ContainSubstring("type CaptainCustomValidator struct{}"))
+			Expect(content).NotTo(ContainSubstring("\n\n\n"))
+		})
+	})
+
+	Context("removeChainCall", func() {
+		It("should remove the call and its custom path", func() {
+			const setup = `	return ctrl.NewWebhookManagedBy(mgr, &crewv1.Captain{}).
+		WithDefaulter(&CaptainCustomDefaulter{}).
+		WithDefaulterCustomPath("/mutate").
+		WithValidator(&CaptainCustomValidator{}).
+		Complete()
+`
+			Expect(removeChainCall(setup, "WithDefaulter(&CaptainCustomDefaulter{})", "WithDefaulterCustomPath(")).
+				To(Equal(`	return ctrl.NewWebhookManagedBy(mgr, &crewv1.Captain{}).
+		WithValidator(&CaptainCustomValidator{}).
+		Complete()
+`))
+		})
+	})
+
+	Context("removeBlock", func() {
+		It("should remove the block and the blank line before it", func() {
+			const test = `	AfterEach(func() {
+	})
+
+	Context("When creating Captain under Defaulting Webhook", func() {
+		It("defaults", func() {
+		})
+	})
+
+	Context("When creating or updating Captain under Validating Webhook", func() {
+	})
+`
+			Expect(removeBlock(test, `Context("When creating Captain under Defaulting Webhook"`)).To(Equal(`	AfterEach(func() {
+	})
+
+	Context("When creating or updating Captain under Validating Webhook", func() {
+	})
+`))
+		})
+	})
+
+	Context("renameController", func() {
+		const (
+			controller = `package %[1]s
+
+type CaptainReconciler struct{}
+
+func (r *CaptainReconciler) Named(string) *CaptainReconciler { return r }
+
+func (r *CaptainReconciler) SetupWithManager() *CaptainReconciler {
+	return r.Named(%[2]q)
+}
+`
+			main = `package main
+
+import (
+	%[1]s "test.io/project/internal/controller%[2]s"
+)
+
+func main() {
+	_ = &%[1]s.CaptainReconciler{}
+	setupLog("controller", %[3]q)
+}
+
+func setupLog(...string) {}
+`
+			controllerTest = `package controller
+
+var _ = &CaptainReconciler{}
+`
+		)
+
+		var fs machinery.Filesystem
+
+		rename := func(multiGroup bool) error {
+			cfg := cfgv3.New()
+			Expect(cfg.SetRepository("test.io/project")).To(Succeed())
+			if multiGroup {
+				Expect(cfg.SetMultiGroup()).To(Succeed())
+			}
+			res := resource.Resource{
+				GVK:        resource.GVK{Group: "crew", Domain: "test.io", Version: "v1", Kind: "Captain"},
+				Controller: true,
+			}
+			s := &editAPIScaffolder{
+				config:            cfg,
+				resource:          res,
+				controllerName:    "captain",
+				newControllerName: "captain-fleet",
+				fs:                fs,
+			}
+			return s.renameController()
+		}
+
+		readFile := func(path string) string {
+			content, err := afero.ReadFile(fs.FS, path)
+			Expect(err).NotTo(HaveOccurred())
+			return string(content)
+		}
+
+		BeforeEach(func() {
+			fs = machinery.Filesystem{FS: afero.NewMemMapFs()}
+		})
+
+		It("should rename the reconciler in the manager", func() {
+			Expect(afero.WriteFile(fs.FS, "internal/controller/captain_controller.go",
+				[]byte(fmt.Sprintf(controller, "controller", "captain")), 0o644)).To(Succeed())
+			Expect(afero.WriteFile(fs.FS, "internal/controller/captain_controller_test.go",
+				[]byte(controllerTest), 0o644)).To(Succeed())
+			Expect(afero.WriteFile(fs.FS, mainPath,
+				[]byte(fmt.Sprintf(main, "controller", "", "captain")), 0o644)).To(Succeed())
+			Expect(rename(false)).To(Succeed())
+
+			content := readFile("internal/controller/captain_fleet_controller.go")
+			Expect(content).To(ContainSubstring("type CaptainFleetReconciler struct{}"))
+			Expect(content).To(ContainSubstring(`Named("captain-fleet")`))
+			content = readFile(mainPath)
+			Expect(content).To(ContainSubstring("&controller.CaptainFleetReconciler{}"))
+			Expect(content).To(ContainSubstring(`"controller", "captain-fleet"`))
+
+			By("renaming the test of the controller with it")
+			exists, err := afero.Exists(fs.FS, "internal/controller/captain_controller_test.go")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exists).To(BeFalse())
+			Expect(readFile("internal/controller/captain_fleet_controller_test.go")).To(
+				ContainSubstring("&CaptainFleetReconciler{}"))
+		})
+
+		It("should fail without renaming anything when the new test file already exists", func() {
+			Expect(afero.WriteFile(fs.FS, "internal/controller/captain_controller.go",
+				[]byte(fmt.Sprintf(controller, "controller", "captain")), 0o644)).To(Succeed())
+			Expect(afero.WriteFile(fs.FS, "internal/controller/captain_controller_test.go",
+				[]byte(controllerTest), 0o644)).To(Succeed())
+			Expect(afero.WriteFile(fs.FS, "internal/controller/captain_fleet_controller_test.go",
+				[]byte(controllerTest), 0o644)).To(Succeed())
+
+			Expect(rename(false)).To(MatchError(ContainSubstring(
+				`"internal/controller/captain_fleet_controller_test.go" already exists`)))
+			exists, err := afero.Exists(fs.FS, "internal/controller/captain_controller.go")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exists).To(BeTrue())
+		})
+
+		It("should rename the reconciler imported from the package of the group in the multigroup layout", func() {
+			Expect(afero.WriteFile(fs.FS, "internal/controller/crew/captain_controller.go",
+				[]byte(fmt.Sprintf(controller, "crew", "crew-captain")), 0o644)).To(Succeed())
+			Expect(afero.WriteFile(fs.FS, mainPath,
+				[]byte(fmt.Sprintf(main, "crewcontroller", "/crew", "crew-captain")), 0o644)).To(Succeed())
+			Expect(rename(true)).To(Succeed())
+
+			content := readFile("internal/controller/crew/captain_fleet_controller.go")
+			Expect(content).To(ContainSubstring("type CaptainFleetReconciler struct{}"))
+			Expect(content).To(ContainSubstring(`Named("crew-captain-fleet")`))
+			content = readFile(mainPath)
+			Expect(content).To(ContainSubstring("&crewcontroller.CaptainFleetReconciler{}"))
+			Expect(content).To(ContainSubstring(`"controller", "crew-captain-fleet"`))
+		})
+	})
+
+	Context("UpdateAdmissionMarker", func() {
+		//nolint:lll
+		const webhook = `// TODO(user): change verbs to "verbs=create;update;delete" if you want to enable deletion validation.
+// +kubebuilder:webhook:path=/mutate-v1-captain,mutating=true,failurePolicy=fail,sideEffects=None,groups=crew.test.io,resources=captains,verbs=create;update,versions=v1,name=mcaptain-v1.kb.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-v1-captain,mutating=false,failurePolicy=fail,sideEffects=None,groups=crew.test.io,resources=captains,verbs=create;update;delete,versions=v1,name=vcaptain-v1.kb.io,admissionReviewVersions=v1
+`
+
+		It("should only rewrite the changed arguments of the named marker", func() {
+			content, err := webhooks.UpdateAdmissionMarker(webhook, "vcaptain-v1.kb.io", nil,
+				&resource.AdmissionConfig{FailurePolicy: "Ignore", TimeoutSeconds: 5})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(content).To(ContainSubstring("mutating=true,failurePolicy=fail,"))
+			// The verbs edited by hand are kept as they are not changed.
+			Expect(content).To(ContainSubstring("mutating=false,failurePolicy=ignore,sideEffects=None," +
+				"groups=crew.test.io,resources=captains,verbs=create;update;delete,versions=v1," +
+				"name=vcaptain-v1.kb.io,admissionReviewVersions=v1,timeoutSeconds=5\n"))
+		})
+
+		It("should remove the optional arguments that are unset", func() {
+			config := &resource.AdmissionConfig{ReinvocationPolicy: "IfNeeded", MatchPolicy: "Exact"}
+			content, err := webhooks.UpdateAdmissionMarker(webhook, "mcaptain-v1.kb.io", nil, config)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(content).To(ContainSubstring(
+				"admissionReviewVersions=v1,matchPolicy=Exact,reinvocationPolicy=IfNeeded\n"))
+
+			content, err = webhooks.UpdateAdmissionMarker(content, "mcaptain-v1.kb.io", config, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(content).To(Equal(webhook))
+		})
+
+		It("should fail when the marker cannot be found", func() {
+			_, err := webhooks.UpdateAdmissionMarker(webhook, "msailor-v1.kb.io", nil,
+				&resource.AdmissionConfig{FailurePolicy: "Ignore"})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
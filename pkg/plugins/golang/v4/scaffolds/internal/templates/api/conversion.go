@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	log "log/slog"
+	"path/filepath"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang"
+)
+
+var _ machinery.Template = &Conversion{}
+
+// Conversion scaffolds the file of a spoke version with the functions converting its kinds to and from
+// their hub versions, which is regenerated each time
+type Conversion struct {
+	machinery.TemplateMixin
+	machinery.MultiGroupMixin
+	machinery.BoilerplateMixin
+
+	Group        string
+	SpokeVersion string
+
+	// Hubs are the packages of the hub versions the kinds are converted to
+	Hubs []ConversionHub
+	// Functions are the generated conversion functions
+	Functions string
+	// Stashes is true when the functions stash some hub versions
+	Stashes bool
+}
+
+// ConversionHub is the package of a hub version imported by the conversion functions
+type ConversionHub struct {
+	Alias string
+	Path  string
+}
+
+// SetTemplateDefaults implements machinery.Template
+func (f *Conversion) SetTemplateDefaults() error {
+	if f.Path == "" {
+		if f.MultiGroup && f.Group != "" {
+			f.Path = filepath.Join("api", f.Group, f.SpokeVersion, golang.ConversionFileName)
+		} else {
+			f.Path = filepath.Join("api", f.SpokeVersion, golang.ConversionFileName)
+		}
+	}
+	log.Info(f.Path)
+
+	f.TemplateBody = conversionTemplate
+
+	f.IfExistsAction = machinery.OverwriteFile
+
+	return nil
+}
+
+const conversionTemplate = `//go:build !ignore_autogenerated
+
+{{ .Boilerplate }}
+
+// Code generated by kubebuilder. DO NOT EDIT.
+// Run "kubebuilder generate conversion" to regenerate it after changing the API types.
+
+package {{ .SpokeVersion }}
+
+import (
+	{{- if .Stashes }}
+	"encoding/json"
+	"fmt"
+	{{- end }}
+	"maps"
+	{{- if .Stashes }}
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	{{- end }}
+	{{- range .Hubs }}
+	{{ .Alias }} "{{ .Path }}"
+	{{- end }}
+)
+
+{{ .Functions }}
+`
@@ -0,0 +1,180 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	log "log/slog"
+	"path/filepath"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+)
+
+var _ machinery.Template = &ConversionTest{}
+
+// ConversionTest scaffolds the round-trip fuzz tests of the conversion of a spoke version to and from the hub
+type ConversionTest struct {
+	machinery.TemplateMixin
+	machinery.MultiGroupMixin
+	machinery.BoilerplateMixin
+	machinery.ResourceMixin
+
+	Force        bool
+	SpokeVersion string
+}
+
+// SetTemplateDefaults implements machinery.Template
+func (f *ConversionTest) SetTemplateDefaults() error {
+	if f.Path == "" {
+		if f.MultiGroup && f.Resource.Group != "" {
+			f.Path = filepath.Join("api", f.Resource.Group, f.SpokeVersion, "%[kind]_conversion_test.go")
+		} else {
+			f.Path = filepath.Join("api", f.SpokeVersion, "%[kind]_conversion_test.go")
+		}
+	}
+
+	f.Path = f.Resource.Replacer().Replace(f.Path)
+	log.Info("Creating conversion round-trip tests", "path", f.Path)
+
+	f.TemplateBody = conversionTestTemplate
+
+	if f.Force {
+		f.IfExistsAction = machinery.OverwriteFile
+	} else {
+		f.IfExistsAction = machinery.SkipFile
+	}
+
+	return nil
+}
+
+//nolint:lll
+const conversionTestTemplate = `{{ .Boilerplate }}
+
+package {{ .SpokeVersion }}
+
+import (
+	"math/rand"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/apitesting/fuzzer"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metafuzzer "k8s.io/apimachinery/pkg/apis/meta/fuzzer"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	runtimeserializer "k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/diff"
+	"sigs.k8s.io/randfill"
+
+	{{ .Resource.ImportAlias }} "{{ .Resource.Path }}"
+)
+
+// The round-trip tests fill a {{ .Resource.Kind }} with random values, convert it to the other version and back,
+// and check that nothing was lost. "go test" runs them with the seeds added below; run them with
+// "go test -fuzz=Fuzz{{ .Resource.Kind }}SpokeHubSpoke ./<this package>" to explore more values.
+
+// Fuzz{{ .Resource.Kind }}SpokeHubSpoke checks that converting a {{ .Resource.Kind }} ({{ .SpokeVersion }}) to the Hub version ({{ .Resource.Version }})
+// and back returns the same object.
+func Fuzz{{ .Resource.Kind }}SpokeHubSpoke(f *testing.F) {
+	for seed := range int64(10) {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, seed int64) {
+		spoke := &{{ .Resource.Kind }}{}
+		new{{ .Resource.Kind }}Filler(t, seed).Fill(spoke)
+
+		hub := &{{ .Resource.ImportAlias }}.{{ .Resource.Kind }}{}
+		if err := spoke.ConvertTo(hub); err != nil {
+			t.Fatalf("failed to convert to the Hub version: %v", err)
+		}
+		roundTrip := &{{ .Resource.Kind }}{}
+		if err := roundTrip.ConvertFrom(hub); err != nil {
+			t.Fatalf("failed to convert from the Hub version: %v", err)
+		}
+
+		remove{{ .Resource.Kind }}ConversionData(&spoke.ObjectMeta)
+		remove{{ .Resource.Kind }}ConversionData(&roundTrip.ObjectMeta)
+		if !apiequality.Semantic.DeepEqual(spoke, roundTrip) {
+			t.Errorf("the {{ .Resource.Kind }} changed after a round trip through the Hub version:\n%s",
+				diff.Diff(spoke, roundTrip))
+		}
+	})
+}
+
+// Fuzz{{ .Resource.Kind }}HubSpokeHub checks that converting a {{ .Resource.Kind }} Hub version ({{ .Resource.Version }}) to {{ .SpokeVersion }}
+// and back returns the same object, including the fields stashed in the annotations of {{ .SpokeVersion }}.
+func Fuzz{{ .Resource.Kind }}HubSpokeHub(f *testing.F) {
+	for seed := range int64(10) {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, seed int64) {
+		hub := &{{ .Resource.ImportAlias }}.{{ .Resource.Kind }}{}
+		new{{ .Resource.Kind }}Filler(t, seed).Fill(hub)
+
+		spoke := &{{ .Resource.Kind }}{}
+		if err := spoke.ConvertFrom(hub); err != nil {
+			t.Fatalf("failed to convert from the Hub version: %v", err)
+		}
+		roundTrip := &{{ .Resource.ImportAlias }}.{{ .Resource.Kind }}{}
+		if err := spoke.ConvertTo(roundTrip); err != nil {
+			t.Fatalf("failed to convert to the Hub version: %v", err)
+		}
+
+		remove{{ .Resource.Kind }}ConversionData(&hub.ObjectMeta)
+		remove{{ .Resource.Kind }}ConversionData(&roundTrip.ObjectMeta)
+		if !apiequality.Semantic.DeepEqual(hub, roundTrip) {
+			t.Errorf("the {{ .Resource.Kind }} changed after a round trip through {{ .SpokeVersion }}:\n%s",
+				diff.Diff(hub, roundTrip))
+		}
+	})
+}
+
+// new{{ .Resource.Kind }}Filler returns a filler of random values for the Kubernetes types of both versions of
+// {{ .Resource.Kind }}, determined by seed.
+func new{{ .Resource.Kind }}Filler(t *testing.T, seed int64) *randfill.Filler {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	if err := AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add {{ .SpokeVersion }} to the scheme: %v", err)
+	}
+	if err := {{ .Resource.ImportAlias }}.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add {{ .Resource.Version }} to the scheme: %v", err)
+	}
+
+	funcs := fuzzer.MergeFuzzerFuncs(metafuzzer.Funcs, {{ lower .Resource.Kind }}FuzzerFuncs)
+	return fuzzer.FuzzerFor(funcs, rand.NewSource(seed), runtimeserializer.NewCodecFactory(scheme))
+}
+
+// remove{{ .Resource.Kind }}ConversionData removes the fields of the Hub version stashed in the annotations of
+// {{ .SpokeVersion }}, which are not part of the objects compared.
+func remove{{ .Resource.Kind }}ConversionData(meta *metav1.ObjectMeta) {
+	delete(meta.Annotations, conversionDataAnnotation)
+	if len(meta.Annotations) == 0 {
+		meta.Annotations = nil
+	}
+}
+
+// {{ lower .Resource.Kind }}FuzzerFuncs returns the functions filling the types that must not be random.
+// TODO(user): Add a function for each type with values that cannot survive a round trip, for example:
+//
+//	func(j *MyType, c randfill.Continue) {
+//		c.FillNoCustom(j)
+//		j.Format = "default"
+//	},
+func {{ lower .Resource.Kind }}FuzzerFuncs(_ runtimeserializer.CodecFactory) []any {
+	return []any{}
+}
+`
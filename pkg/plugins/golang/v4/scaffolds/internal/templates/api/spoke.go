@@ -17,6 +17,7 @@ limitations under the License.
 package api
 
 import (
+	"fmt"
 	log "log/slog"
 	"path/filepath"
 
@@ -62,6 +63,16 @@ func (f *Spoke) SetTemplateDefaults() error {
 	return nil
 }
 
+// ConvertToHub returns the name of the generated function converting the spoke version to the hub version
+func (f *Spoke) ConvertToHub() string {
+	return fmt.Sprintf("Convert_%[1]s_%[2]s_To_%[3]s_%[2]s", f.SpokeVersion, f.Resource.Kind, f.Resource.Version)
+}
+
+// ConvertFromHub returns the name of the generated function converting the hub version to the spoke version
+func (f *Spoke) ConvertFromHub() string {
+	return fmt.Sprintf("Convert_%[1]s_%[2]s_To_%[3]s_%[2]s", f.Resource.Version, f.Resource.Kind, f.SpokeVersion)
+}
+
 //nolint:lll
 const spokeTemplate = `{{ .Boilerplate }}
 
@@ -70,8 +81,8 @@ package {{ .SpokeVersion }}
 import (
 	"log"
 
-    "sigs.k8s.io/controller-runtime/pkg/conversion"
-    {{ .Resource.ImportAlias }} "{{ .Resource.Path }}"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+	{{ .Resource.ImportAlias }} "{{ .Resource.Path }}"
 )
 
 // ConvertTo converts this {{ .Resource.Kind }} ({{ .SpokeVersion }}) to the Hub version ({{ .Resource.Version }}).
@@ -79,15 +90,17 @@ func (src *{{ .Resource.Kind }}) ConvertTo(dstRaw conversion.Hub) error {
 	dst := dstRaw.(*{{ .Resource.ImportAlias }}.{{ .Resource.Kind }})
 	log.Printf("ConvertTo: Converting {{ .Resource.Kind }} from Spoke version {{ .SpokeVersion }} to Hub version {{ .Resource.Version }};" +
 		"source: %s/%s, target: %s/%s", src.Namespace, src.Name, dst.Namespace, dst.Name)
-	
-	// TODO(user): Implement conversion logic from {{ .SpokeVersion }} to {{ .Resource.Version }}
-	// Example: Copying Spec fields
-	// dst.Spec.Size = src.Spec.Replicas
 
-	// Copy ObjectMeta to preserve name, namespace, labels, etc.
-	dst.ObjectMeta = src.ObjectMeta
+	// The fields with the same name and type in both versions, including ObjectMeta, are converted by the
+	// functions of zz_generated.conversion.go. Run "kubebuilder generate conversion" to regenerate them
+	// after changing the types.
+	// TODO(user): Implement the Convert_ functions flagged there, for the fields that do not map.
+	if err := {{ .ConvertToHub }}(src, dst); err != nil {
+		return err
+	}
 
-	return nil
+	// Restore the fields that only exist in the Hub version, when this object was converted from it
+	return restore{{ .Resource.Kind }}(src, dst)
 }
 
 // ConvertFrom converts the Hub version ({{ .Resource.Version }}) to this {{ .Resource.Kind }} ({{ .SpokeVersion }}).
@@ -96,13 +109,11 @@ func (dst *{{ .Resource.Kind }}) ConvertFrom(srcRaw conversion.Hub) error {
 	log.Printf("ConvertFrom: Converting {{ .Resource.Kind }} from Hub version {{ .Resource.Version }} to Spoke version {{ .SpokeVersion }};" +
 		"source: %s/%s, target: %s/%s", src.Namespace, src.Name, dst.Namespace, dst.Name)
 
-	// TODO(user): Implement conversion logic from {{ .Resource.Version }} to {{ .SpokeVersion }}
-	// Example: Copying Spec fields
-	// dst.Spec.Replicas = src.Spec.Size
-
-	// Copy ObjectMeta to preserve name, namespace, labels, etc.
-	dst.ObjectMeta = src.ObjectMeta
+	if err := {{ .ConvertFromHub }}(src, dst); err != nil {
+		return err
+	}
 
-	return nil
+	// Stash the fields that only exist in the Hub version, so that they are restored when converted back
+	return stash{{ .Resource.Kind }}(src, dst)
 }
 `
@@ -17,10 +17,15 @@ limitations under the License.
 package api
 
 import (
+	"fmt"
 	log "log/slog"
 	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
 
 	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang"
 )
 
 var _ machinery.Template = &Types{}
@@ -35,6 +40,22 @@ type Types struct {
 	machinery.ResourceMixin
 
 	Force bool
+
+	// ResourceMarkerArgs are the arguments of the +kubebuilder:resource marker, if any
+	ResourceMarkerArgs string
+
+	// PrintColumnMarkerArgs are the arguments of each +kubebuilder:printcolumn marker
+	PrintColumnMarkerArgs []string
+
+	// SpecFields and StatusFields are the fields declared on the command line
+	SpecFields   []golang.Field
+	StatusFields []golang.Field
+
+	// Schema are the types generated from the schema of a CRD, which replace the spec and status
+	Schema *golang.SchemaTypes
+
+	// Imports are the imports required by the types of the fields
+	Imports []string
 }
 
 // SetTemplateDefaults implements machinery.Template
@@ -52,6 +73,14 @@ func (f *Types) SetTemplateDefaults() error {
 
 	f.TemplateBody = typesTemplate
 
+	f.setMarkerArgs()
+
+	f.Imports = golang.TypeImports(append(slices.Clone(f.SpecFields), f.StatusFields...))
+	if f.Schema != nil {
+		f.TemplateBody = schemaTypesTemplate
+		f.Imports = f.Schema.Imports
+	}
+
 	if f.Force {
 		f.IfExistsAction = machinery.OverwriteFile
 	} else {
@@ -61,6 +90,35 @@ func (f *Types) SetTemplateDefaults() error {
 	return nil
 }
 
+// setMarkerArgs builds the arguments of the resource and printer column markers from the API settings
+func (f *Types) setMarkerArgs() {
+	api := f.Resource.API
+	if api == nil {
+		return
+	}
+
+	var args []string
+	if !f.Resource.IsRegularPlural() {
+		args = append(args, "path="+f.Resource.Plural)
+	}
+	if !api.Namespaced {
+		args = append(args, "scope=Cluster")
+	}
+	if len(api.ShortNames) != 0 {
+		args = append(args, "shortName="+strings.Join(api.ShortNames, ";"))
+	}
+	if len(api.Categories) != 0 {
+		args = append(args, "categories="+strings.Join(api.Categories, ";"))
+	}
+	f.ResourceMarkerArgs = strings.Join(args, ",")
+
+	f.PrintColumnMarkerArgs = nil
+	for _, column := range api.PrintColumns {
+		f.PrintColumnMarkerArgs = append(f.PrintColumnMarkerArgs, fmt.Sprintf("name=%s,type=%s,JSONPath=%s",
+			strconv.Quote(column.Name), strconv.Quote(column.Type), strconv.Quote(column.JSONPath)))
+	}
+}
+
 //nolint:lll
 const typesTemplate = `{{ .Boilerplate }}
 
@@ -68,10 +126,25 @@ package {{ .Resource.Version }}
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+{{- range .Imports }}
+	{{ . }}
+{{- end }}
 )
 
 // EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
 // NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+{{- if .Resource.API.Conditions }}
+
+// Condition types of {{ .Resource.Kind }}, managed in its status by the controller.
+const (
+	// {{ .Resource.Kind }}Available means that the {{ .Resource.Kind }} is fully functional.
+	{{ .Resource.Kind }}Available = "Available"
+	// {{ .Resource.Kind }}Progressing means that the {{ .Resource.Kind }} is being created or updated.
+	{{ .Resource.Kind }}Progressing = "Progressing"
+	// {{ .Resource.Kind }}Degraded means that the {{ .Resource.Kind }} failed to reach or maintain its desired state.
+	{{ .Resource.Kind }}Degraded = "Degraded"
+)
+{{- end }}
 
 // {{ .Resource.Kind }}Spec defines the desired state of {{ .Resource.Kind }}
 type {{ .Resource.Kind }}Spec struct {
@@ -80,9 +153,27 @@ type {{ .Resource.Kind }}Spec struct {
 	// The following markers will use OpenAPI v3 schema to validate the value
 	// More info: https://book.kubebuilder.io/reference/markers/crd-validation.html
 
+{{- if .SpecFields }}
+{{- range .SpecFields }}
+
+	// {{ .Name }} {{ .Description $.Resource.Kind }}
+	{{- range .Markers }}
+	// {{ . }}
+	{{- end }}
+	{{ .GoName }} {{ .GoType }} ` + "`" + `json:"{{ .JSONTag }}"` + "`" + `
+{{- end }}
+{{- else }}
+
 	// foo is an example field of {{ .Resource.Kind }}. Edit {{ lower .Resource.Kind }}_types.go to remove/update
 	// +optional	
 	Foo *string ` + "`" + `json:"foo,omitempty"` + "`" + `
+{{- end }}
+{{- if .Resource.API.ScaleSubresource }}
+
+	// replicas is the desired number of replicas, exposed through the scale subresource.
+	// +optional
+	Replicas *int32 ` + "`" + `json:"replicas,omitempty"` + "`" + `
+{{- end }}
 }
 
 // {{ .Resource.Kind }}Status defines the observed state of {{ .Resource.Kind }}.
@@ -106,16 +197,51 @@ type {{ .Resource.Kind }}Status struct {
 	// +listMapKey=type
 	// +optional
 	Conditions []metav1.Condition ` + "`" + `json:"conditions,omitempty"` + "`" + `
+{{- if .Resource.API.Conditions }}
+
+	// observedGeneration is the most recent generation of the {{ .Resource.Kind }} observed by the controller.
+	// +optional
+	ObservedGeneration int64 ` + "`" + `json:"observedGeneration,omitempty"` + "`" + `
+{{- end }}
+{{- range .StatusFields }}
+
+	// {{ .Name }} {{ .Description $.Resource.Kind }}
+	{{- range .Markers }}
+	// {{ . }}
+	{{- end }}
+	{{ .GoName }} {{ .GoType }} ` + "`" + `json:"{{ .JSONTag }}"` + "`" + `
+{{- end }}
+{{- if .Resource.API.ScaleSubresource }}
+
+	// replicas is the observed number of replicas, exposed through the scale subresource.
+	// +optional
+	Replicas int32 ` + "`" + `json:"replicas,omitempty"` + "`" + `
+
+	// selector is the label selector of the replicas in string form, exposed through the scale subresource.
+	// +optional
+	Selector string ` + "`" + `json:"selector,omitempty"` + "`" + `
+{{- end }}
 }
 
+{{ if .Resource.API.Clients -}}
+// +genclient
+{{ if not .Resource.API.Namespaced -}}
+// +genclient:nonNamespaced
+{{ end -}}
+{{ end -}}
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
-{{- if and (not .Resource.API.Namespaced) (not .Resource.IsRegularPlural) }}
-// +kubebuilder:resource:path={{ .Resource.Plural }},scope=Cluster
-{{- else if not .Resource.API.Namespaced }}
-// +kubebuilder:resource:scope=Cluster
-{{- else if not .Resource.IsRegularPlural }}
-// +kubebuilder:resource:path={{ .Resource.Plural }}
+{{- if .Resource.API.ScaleSubresource }}
+// +kubebuilder:subresource:scale:specpath=.spec.replicas,statuspath=.status.replicas,selectorpath=.status.selector
+{{- end }}
+{{- if .ResourceMarkerArgs }}
+// +kubebuilder:resource:{{ .ResourceMarkerArgs }}
+{{- end }}
+{{- range .PrintColumnMarkerArgs }}
+// +kubebuilder:printcolumn:{{ . }}
+{{- end }}
+{{- if .Resource.API.StorageVersion }}
+// +kubebuilder:storageversion
 {{- end }}
 
 // {{ .Resource.Kind }} is the Schema for the {{ .Resource.Plural }} API
@@ -148,3 +274,105 @@ func init() {
 	SchemeBuilder.Register(&{{ .Resource.Kind }}{}, &{{ .Resource.Kind }}List{})
 }
 `
+
+// schemaTypesTemplate scaffolds the types generated from the schema of a CRD
+//
+//nolint:lll
+const schemaTypesTemplate = `{{ .Boilerplate }}
+
+package {{ .Resource.Version }}
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+{{- range .Imports }}
+	{{ . }}
+{{- end }}
+)
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+// The types were generated from the OpenAPI v3 schema of the {{ .Resource.Plural }}.{{ .Resource.QualifiedGroup }} CRD.
+{{- range .Schema.Types }}
+{{ range .Description }}
+//{{ if . }} {{ . }}{{ end }}
+{{- end }}
+{{- range .Markers }}
+// {{ . }}
+{{- end }}
+{{- if .Underlying }}
+type {{ .Name }} {{ .Underlying }}
+{{- else }}
+type {{ .Name }} struct {
+{{- range $i, $field := .Fields }}
+{{- if $i }}
+{{ end }}
+{{- range .Description }}
+	//{{ if . }} {{ . }}{{ end }}
+{{- end }}
+{{- range .Markers }}
+	// {{ . }}
+{{- end }}
+	{{ .GoName }} {{ .GoType }} ` + "`" + `json:"{{ .JSONTag }}"` + "`" + `
+{{- end }}
+}
+{{- end }}
+{{- end }}
+
+{{ if .Resource.API.Clients -}}
+// +genclient
+{{ if not .Resource.API.Namespaced -}}
+// +genclient:nonNamespaced
+{{ end -}}
+{{ end -}}
+// +kubebuilder:object:root=true
+{{- range .Schema.Markers }}
+// {{ . }}
+{{- end }}
+{{- if .ResourceMarkerArgs }}
+// +kubebuilder:resource:{{ .ResourceMarkerArgs }}
+{{- end }}
+{{- range .PrintColumnMarkerArgs }}
+// +kubebuilder:printcolumn:{{ . }}
+{{- end }}
+{{- if .Resource.API.StorageVersion }}
+// +kubebuilder:storageversion
+{{- end }}
+
+{{- if .Schema.Description }}
+{{ range .Schema.Description }}
+//{{ if . }} {{ . }}{{ end }}
+{{- end }}
+{{- else }}
+
+// {{ .Resource.Kind }} is the Schema for the {{ .Resource.Plural }} API
+{{- end }}
+type {{ .Resource.Kind }} struct {
+	metav1.TypeMeta   ` + "`" + `json:",inline"` + "`" + `
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta ` + "`" + `json:"metadata,omitzero"` + "`" + `
+{{- range .Schema.Fields }}
+{{ range .Description }}
+	//{{ if . }} {{ . }}{{ end }}
+{{- end }}
+{{- range .Markers }}
+	// {{ . }}
+{{- end }}
+	{{ .GoName }} {{ .GoType }} ` + "`" + `json:"{{ .JSONTag }}"` + "`" + `
+{{- end }}
+}
+
+// +kubebuilder:object:root=true
+
+// {{ .Resource.Kind }}List contains a list of {{ .Resource.Kind }}
+type {{ .Resource.Kind }}List struct {
+	metav1.TypeMeta ` + "`" + `json:",inline"` + "`" + `
+	metav1.ListMeta ` + "`" + `json:"metadata,omitzero"` + "`" + `
+	Items           []{{ .Resource.Kind }} ` + "`" + `json:"items"` + "`" + `
+}
+
+func init() {
+	SchemeBuilder.Register(&{{ .Resource.Kind }}{}, &{{ .Resource.Kind }}List{})
+}
+`
@@ -39,6 +39,9 @@ type TypesUpdater struct {
 	machinery.TemplateMixin
 	machinery.MultiGroupMixin
 	machinery.ResourceMixin
+
+	// StorageVersion indicates whether to add the storage version marker without a conversion webhook
+	StorageVersion bool
 }
 
 // GetPath implements file.Builder
@@ -72,7 +75,8 @@ func (f *TypesUpdater) SetTemplateDefaults() error {
 	modified := false
 
 	// Check if we need to add storage version marker for conversion webhooks
-	if f.Resource.HasConversionWebhook() && !bytes.Contains(content, []byte("+kubebuilder:storageversion")) {
+	if (f.Resource.HasConversionWebhook() || f.StorageVersion) &&
+		!bytes.Contains(content, []byte("+kubebuilder:storageversion")) {
 		fileContent = f.addStorageVersionMarker(fileContent)
 		modified = true
 	}
@@ -0,0 +1,56 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"path/filepath"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+)
+
+var _ machinery.Template = &Doc{}
+
+// Doc scaffolds the file that documents the package holding the generated clients of the APIs
+type Doc struct {
+	machinery.TemplateMixin
+	machinery.BoilerplateMixin
+}
+
+// SetTemplateDefaults implements machinery.Template
+func (f *Doc) SetTemplateDefaults() error {
+	if f.Path == "" {
+		f.Path = filepath.Join("pkg", "client", "doc.go")
+	}
+
+	f.TemplateBody = docTemplate
+
+	f.IfExistsAction = machinery.SkipFile
+
+	return nil
+}
+
+const docTemplate = `{{ .Boilerplate }}
+
+// Package client holds the clients of the APIs generated for the consumers that do not use
+// controller-runtime, such as CLIs or other services. Its sub-packages are generated by
+// hack/update-codegen.sh with 'make generate-clients', and must not be edited:
+//   - clientset/versioned: the typed clientset of the APIs
+//   - listers: the listers reading the APIs from the caches of the informers
+//   - informers/externalversions: the shared informers watching the APIs
+//   - applyconfiguration: the apply configurations of the APIs, to use with server-side apply
+package client
+`
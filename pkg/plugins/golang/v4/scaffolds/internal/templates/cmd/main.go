@@ -69,6 +69,10 @@ type MainUpdater struct {
 	// If empty, the default name based on the resource kind will be used.
 	ControllerName string
 
+	// WebhookName is the name of the named webhook being wired.
+	// If empty, the default webhook of the resource will be wired.
+	WebhookName string
+
 	// Deprecated - The flag should be removed from go/v5
 	// IsLegacyPath indicates if webhooks should be scaffolded under the API.
 	// Webhooks are now decoupled from APIs based on controller-runtime updates and community feedback.
@@ -81,6 +85,14 @@ func (f *MainUpdater) ReconcilerName() string {
 	return resource.NormalizeReconcilerName(f.ControllerName, f.Resource.Kind)
 }
 
+// WebhookTypeName returns the name used by the setup function of the webhook being wired.
+func (f *MainUpdater) WebhookTypeName() string {
+	if f.WebhookName == "" {
+		return f.Resource.Kind
+	}
+	return resource.NormalizeWebhookTypeName(f.WebhookName, f.Resource.Kind)
+}
+
 // GetPath implements file.Builder
 func (*MainUpdater) GetPath() string {
 	return defaultMainPath
@@ -213,12 +225,16 @@ func (f *MainUpdater) GetCodeFragments() machinery.CodeFragmentsMap {
 			setup = append(setup, fmt.Sprintf(webhookSetupCodeFragmentLegacy,
 				f.Resource.ImportAlias(), f.Resource.Kind, f.Resource.Kind))
 		} else {
+			webhookName := f.Resource.Kind
+			if f.WebhookName != "" {
+				webhookName += "/" + f.WebhookName
+			}
 			if !f.MultiGroup || f.Resource.Group == "" {
 				setup = append(setup, fmt.Sprintf(webhookSetupCodeFragment,
-					"webhook"+f.Resource.Version, f.Resource.Kind, f.Resource.Kind))
+					"webhook"+f.Resource.Version, f.WebhookTypeName(), webhookName))
 			} else {
 				setup = append(setup, fmt.Sprintf(webhookSetupCodeFragment,
-					"webhook"+f.Resource.ImportAlias(), f.Resource.Kind, f.Resource.Kind))
+					"webhook"+f.Resource.ImportAlias(), f.WebhookTypeName(), webhookName))
 			}
 		}
 	}
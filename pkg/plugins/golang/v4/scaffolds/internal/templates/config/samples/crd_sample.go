@@ -0,0 +1,76 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package samples
+
+import (
+	log "log/slog"
+	"path/filepath"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang"
+)
+
+var _ machinery.Template = &CRDSample{}
+
+// CRDSample scaffolds the sample manifest for the CRD with the spec fields declared on the command line.
+// It overwrites the sample scaffolded by the kustomize plugin.
+type CRDSample struct {
+	machinery.TemplateMixin
+	machinery.ResourceMixin
+	machinery.ProjectNameMixin
+
+	// SpecFields are the fields set in the sample spec
+	SpecFields []golang.Field
+}
+
+// SamplePath returns the path of the sample manifest of the resource.
+func SamplePath(res resource.Resource) string {
+	path := filepath.Join("config", "samples", "%[version]_%[kind].yaml")
+	if res.Group != "" {
+		path = filepath.Join("config", "samples", "%[group]_%[version]_%[kind].yaml")
+	}
+	return res.Replacer().Replace(path)
+}
+
+// SetTemplateDefaults implements machinery.Template
+func (f *CRDSample) SetTemplateDefaults() error {
+	if f.Path == "" {
+		f.Path = SamplePath(*f.Resource)
+	}
+	log.Info(f.Path)
+
+	f.IfExistsAction = machinery.OverwriteFile
+
+	f.TemplateBody = crdSampleTemplate
+
+	return nil
+}
+
+const crdSampleTemplate = `apiVersion: {{ .Resource.QualifiedGroup }}/{{ .Resource.Version }}
+kind: {{ .Resource.Kind }}
+metadata:
+  labels:
+    app.kubernetes.io/name: {{ .ProjectName }}
+    app.kubernetes.io/managed-by: kustomize
+  name: {{ lower .Resource.Kind }}-sample
+spec:
+  # TODO(user): edit the sample values of the fields
+{{- range .SpecFields }}
+{{ .SampleYAML "  " -}}
+{{- end }}
+`
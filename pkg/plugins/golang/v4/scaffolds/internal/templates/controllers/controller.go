@@ -19,6 +19,8 @@ package controllers
 import (
 	log "log/slog"
 	"path/filepath"
+	"slices"
+	"strings"
 
 	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
 	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
@@ -36,6 +38,7 @@ type Controller struct {
 	machinery.ResourceMixin
 	machinery.ProjectNameMixin
 	machinery.NamespacedMixin
+	machinery.DomainMixin
 
 	ControllerRuntimeVersion string
 
@@ -44,6 +47,32 @@ type Controller struct {
 	// ControllerName is the specific name for this controller.
 	// If empty, a default name based on the resource kind will be used.
 	ControllerName string
+
+	// Conditions is true if the controller manages the status conditions of the resource
+	Conditions bool
+
+	// Finalizer is true if the controller manages a finalizer
+	Finalizer bool
+
+	// Owns are the resources owned by the controller
+	Owns []resource.Resource
+
+	// Watches are the other resources watched by the controller
+	Watches []Watch
+
+	// ReconcileTemplate is the template of the Reconcile method and of its helpers, defined by the style
+	// of the controller. If empty, the minimal style is used.
+	ReconcileTemplate string
+
+	// StyleImports are the additional imports required by the style of the controller
+	StyleImports []string
+}
+
+// Watch is a resource watched by the controller, whose events are mapped to reconcile requests by Mapper
+type Watch struct {
+	resource.Resource
+
+	Mapper string
 }
 
 // SetTemplateDefaults implements machinery.Template
@@ -66,7 +95,10 @@ func (f *Controller) SetTemplateDefaults() error {
 	f.Path = f.Resource.Replacer().Replace(f.Path)
 	log.Info(f.Path)
 
-	f.TemplateBody = controllerTemplate
+	if f.ReconcileTemplate == "" {
+		f.ReconcileTemplate = MinimalReconcileTemplate
+	}
+	f.TemplateBody = controllerHeaderTemplate + f.ReconcileTemplate + controllerFooterTemplate
 
 	if f.Force {
 		f.IfExistsAction = machinery.OverwriteFile
@@ -87,22 +119,100 @@ func (f *Controller) ControllerRuntimeName() string {
 	return resource.GetControllerName(f.ControllerName, f.Resource.Kind, f.Resource.Group, f.MultiGroup)
 }
 
+// RelatedImports returns the imports of the owned and watched resources, indexed by their alias,
+// which are not already imported for the reconciled resource.
+func (f *Controller) RelatedImports() map[string]string {
+	imports := make(map[string]string, len(f.Owns)+len(f.Watches))
+	related := slices.Clone(f.Owns)
+	for _, watch := range f.Watches {
+		related = append(related, watch.Resource)
+	}
+	for _, res := range related {
+		if res.Path != f.Resource.Path {
+			imports[res.ImportAlias()] = res.Path
+		}
+	}
+	return imports
+}
+
+// FinalizerConstName returns the name of the constant that holds the finalizer of the controller.
+func (f *Controller) FinalizerConstName() string {
+	return FinalizerConstName(f.ControllerName, f.Resource.Kind)
+}
+
+// IdentifierPrefix returns the prefix of the package-level identifiers declared for the controller,
+// derived from its reconciler so that several controllers of a package do not clash.
+func (f *Controller) IdentifierPrefix() string {
+	return identifierPrefix(f.ControllerName, f.Resource.Kind)
+}
+
+// FinalizerName returns the finalizer managed by the controller. It is qualified with the group of the
+// project APIs, or with the project domain for core types and external APIs, whose groups are not owned.
+func (f *Controller) FinalizerName() string {
+	qualifier := f.Resource.QualifiedGroup()
+	if (f.Resource.IsExternal() || f.Resource.Core) && f.Domain != "" {
+		qualifier = f.Domain
+	}
+
+	name := strings.ToLower(f.Resource.Kind)
+	if f.ControllerName != "" {
+		name = f.ControllerName
+	}
+
+	return qualifier + "/" + name + "-finalizer"
+}
+
+// FinalizerConstName returns the name of the constant that holds the finalizer of the named
+// controller, derived from its reconciler so that several controllers of a package do not clash.
+func FinalizerConstName(controllerName, kind string) string {
+	return identifierPrefix(controllerName, kind) + "Finalizer"
+}
+
+func identifierPrefix(controllerName, kind string) string {
+	name := strings.TrimSuffix(resource.NormalizeReconcilerName(controllerName, kind), "Reconciler")
+	return strings.ToLower(name[:1]) + name[1:]
+}
+
 //nolint:lll
-const controllerTemplate = `{{ .Boilerplate }}
+const controllerHeaderTemplate = `{{ .Boilerplate }}
 
 package {{ if and .MultiGroup .Resource.Group }}{{ .Resource.PackageName }}{{ else }}controller{{ end }}
 
 import (
 	"context"
+	{{- if .Conditions }}
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	{{- end }}
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	{{- if .Finalizer }}
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	{{- end }}
+	{{- if .Watches }}
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	{{- end }}
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	{{- if .Watches }}
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	{{- end }}
+	{{- range .StyleImports }}
+	{{ . }}
+	{{- end }}
+	{{- range $alias, $path := .RelatedImports }}
+	{{ $alias }} "{{ $path }}"
+	{{- end }}
 	{{ if not (isEmptyStr .Resource.Path) -}}
 	{{ .Resource.ImportAlias }} "{{ .Resource.Path }}"
 	{{- end }}
 )
 
+{{ if .Finalizer -}}
+// {{ .FinalizerConstName }} is added to the {{ .Resource.Kind }} objects so their cleanup runs before they are deleted
+const {{ .FinalizerConstName }} = "{{ .FinalizerName }}"
+
+{{ end -}}
 // {{ .ReconcilerName }} reconciles a {{ .Resource.Kind }} object
 type {{ .ReconcilerName }} struct {
 	client.Client
@@ -113,10 +223,22 @@ type {{ .ReconcilerName }} struct {
 // +kubebuilder:rbac:groups={{ .Resource.QualifiedGroup }},namespace={{ .ProjectName }}-system,resources={{ .Resource.Plural }},verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups={{ .Resource.QualifiedGroup }},namespace={{ .ProjectName }}-system,resources={{ .Resource.Plural }}/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups={{ .Resource.QualifiedGroup }},namespace={{ .ProjectName }}-system,resources={{ .Resource.Plural }}/finalizers,verbs=update
+{{- range .Owns }}
+// +kubebuilder:rbac:groups={{ .QualifiedGroup }},namespace={{ $.ProjectName }}-system,resources={{ .Plural }},verbs=get;list;watch;create;update;patch;delete
+{{- end }}
+{{- range .Watches }}
+// +kubebuilder:rbac:groups={{ .QualifiedGroup }},namespace={{ $.ProjectName }}-system,resources={{ .Plural }},verbs=get;list;watch
+{{- end }}
 {{- else -}}
 // +kubebuilder:rbac:groups={{ .Resource.QualifiedGroup }},resources={{ .Resource.Plural }},verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups={{ .Resource.QualifiedGroup }},resources={{ .Resource.Plural }}/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups={{ .Resource.QualifiedGroup }},resources={{ .Resource.Plural }}/finalizers,verbs=update
+{{- range .Owns }}
+// +kubebuilder:rbac:groups={{ .QualifiedGroup }},resources={{ .Plural }},verbs=get;list;watch;create;update;patch;delete
+{{- end }}
+{{- range .Watches }}
+// +kubebuilder:rbac:groups={{ .QualifiedGroup }},resources={{ .Plural }},verbs=get;list;watch
+{{- end }}
 {{- end }}
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
@@ -128,13 +250,40 @@ type {{ .ReconcilerName }} struct {
 //
 // For more details, check Reconcile and its Result here:
 // - https://pkg.go.dev/sigs.k8s.io/controller-runtime@{{ .ControllerRuntimeVersion }}/pkg/reconcile
-func (r *{{ .ReconcilerName }}) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	_ = logf.FromContext(ctx)
+`
+
+// controllerFooterTemplate holds the helpers of the reconciler, its setup and the templates
+// that the styles can use in their Reconcile method:
+//   - fetchResource gets the reconciled object in a variable named after its kind and runs its finalizer
+//   - markProgressing marks the object as progressing when a new generation is reconciled
+//   - updateConditions sets the status conditions of the object from reconcileErr
+//
+//nolint:lll
+const controllerFooterTemplate = `{{- if .Finalizer }}
 
-	// TODO(user): your logic here
+// finalize{{ .Resource.Kind }} cleans up what is managed for the {{ .Resource.Kind }} before it is deleted.
+// It must be idempotent, as it is retried until it succeeds.
+func (r *{{ .ReconcilerName }}) finalize{{ .Resource.Kind }}(ctx context.Context, {{ lower .Resource.Kind }} *{{ .Resource.ImportAlias }}.{{ .Resource.Kind }}) error {
+	// TODO(user): Clean up the external or cross-namespace resources managed for the {{ .Resource.Kind }}.
+	// Returning an error keeps the finalizer and retries the cleanup.
+	return nil
+}
+{{- end }}
+{{- if .Conditions }}
 
-	return ctrl.Result{}, nil
+// setCondition sets a status condition of the {{ .Resource.Kind }}, recording the generation it was observed for.
+func (r *{{ .ReconcilerName }}) setCondition({{ lower .Resource.Kind }} *{{ .Resource.ImportAlias }}.{{ .Resource.Kind }},
+	conditionType string, status metav1.ConditionStatus, reason, message string,
+) {
+	meta.SetStatusCondition(&{{ lower .Resource.Kind }}.Status.Conditions, metav1.Condition{
+		Type:               conditionType,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: {{ lower .Resource.Kind }}.Generation,
+	})
 }
+{{- end }}
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *{{ .ReconcilerName }}) SetupWithManager(mgr ctrl.Manager) error {
@@ -145,7 +294,98 @@ func (r *{{ .ReconcilerName }}) SetupWithManager(mgr ctrl.Manager) error {
 		// Uncomment the following line adding a pointer to an instance of the controlled resource as an argument
 		// For().
 		{{- end }}
+		{{- range .Owns }}
+		Owns(&{{ .ImportAlias }}.{{ .Kind }}{}).
+		{{- end }}
+		{{- range .Watches }}
+		Watches(
+			&{{ .ImportAlias }}.{{ .Kind }}{},
+			handler.EnqueueRequestsFromMapFunc(r.{{ .Mapper }}),
+		).
+		{{- end }}
 		Named("{{ .ControllerRuntimeName }}").
 		Complete(r)
 }
+{{- range .Watches }}
+
+// {{ .Mapper }} maps the events of a {{ .Kind }} to reconcile requests for the {{ $.Resource.Kind }} objects that depend on it.
+func (r *{{ $.ReconcilerName }}) {{ .Mapper }}(ctx context.Context, obj client.Object) []reconcile.Request {
+	// TODO(user): Return a request for each {{ $.Resource.Kind }} that depends on the {{ .Kind }}, for example
+	// by listing the {{ $.Resource.Kind }} objects that reference it with r.List and a field index.
+	// More info: https://book.kubebuilder.io/reference/watching-resources
+	return nil
+}
+{{- end }}
+{{- define "fetchResource" }}
+	log := logf.FromContext(ctx)
+
+	{{ lower .Resource.Kind }} := &{{ .Resource.ImportAlias }}.{{ .Resource.Kind }}{}
+	if err := r.Get(ctx, req.NamespacedName, {{ lower .Resource.Kind }}); err != nil {
+		// The resource may have been deleted after the reconcile request was queued
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+{{- if .Finalizer }}
+
+	// Run the cleanup when the resource is being deleted, the finalizer is only removed once it succeeds
+	// More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/finalizers
+	if !{{ lower .Resource.Kind }}.DeletionTimestamp.IsZero() {
+		if controllerutil.ContainsFinalizer({{ lower .Resource.Kind }}, {{ .FinalizerConstName }}) {
+			if err := r.finalize{{ .Resource.Kind }}(ctx, {{ lower .Resource.Kind }}); err != nil {
+				log.Error(err, "Failed to finalize the {{ .Resource.Kind }}")
+				// Returning the error requeues the request, so the cleanup is retried
+				return ctrl.Result{}, err
+			}
+
+			controllerutil.RemoveFinalizer({{ lower .Resource.Kind }}, {{ .FinalizerConstName }})
+			if err := r.Update(ctx, {{ lower .Resource.Kind }}); err != nil {
+				log.Error(err, "Failed to remove the finalizer from the {{ .Resource.Kind }}")
+				return ctrl.Result{}, err
+			}
+		}
+		return ctrl.Result{}, nil
+	}
+
+	// Add the finalizer, so the cleanup runs before the resource is deleted
+	if controllerutil.AddFinalizer({{ lower .Resource.Kind }}, {{ .FinalizerConstName }}) {
+		if err := r.Update(ctx, {{ lower .Resource.Kind }}); err != nil {
+			log.Error(err, "Failed to add the finalizer to the {{ .Resource.Kind }}")
+			return ctrl.Result{}, err
+		}
+	}
+{{- end }}
+{{- end }}
+{{- define "markProgressing" }}
+
+	// Mark the resource as progressing while a new generation is reconciled
+	if {{ lower .Resource.Kind }}.Status.ObservedGeneration != {{ lower .Resource.Kind }}.Generation {
+		r.setCondition({{ lower .Resource.Kind }}, {{ .Resource.ImportAlias }}.{{ .Resource.Kind }}Progressing, metav1.ConditionTrue,
+			"Reconciling", "Reconciling the new generation of the {{ .Resource.Kind }}")
+		if err := r.Status().Update(ctx, {{ lower .Resource.Kind }}); err != nil {
+			log.Error(err, "Failed to update the {{ .Resource.Kind }} status")
+			return ctrl.Result{}, err
+		}
+	}
+{{- end }}
+{{- define "updateConditions" }}
+
+	if reconcileErr != nil {
+		message := reconcileErr.Error()
+		r.setCondition({{ lower .Resource.Kind }}, {{ .Resource.ImportAlias }}.{{ .Resource.Kind }}Available, metav1.ConditionFalse, "ReconcileFailed", message)
+		r.setCondition({{ lower .Resource.Kind }}, {{ .Resource.ImportAlias }}.{{ .Resource.Kind }}Progressing, metav1.ConditionFalse, "ReconcileFailed", message)
+		r.setCondition({{ lower .Resource.Kind }}, {{ .Resource.ImportAlias }}.{{ .Resource.Kind }}Degraded, metav1.ConditionTrue, "ReconcileFailed", message)
+	} else {
+		r.setCondition({{ lower .Resource.Kind }}, {{ .Resource.ImportAlias }}.{{ .Resource.Kind }}Available, metav1.ConditionTrue,
+			"Reconciled", "The {{ .Resource.Kind }} is available")
+		r.setCondition({{ lower .Resource.Kind }}, {{ .Resource.ImportAlias }}.{{ .Resource.Kind }}Progressing, metav1.ConditionFalse,
+			"Reconciled", "The {{ .Resource.Kind }} is up to date")
+		r.setCondition({{ lower .Resource.Kind }}, {{ .Resource.ImportAlias }}.{{ .Resource.Kind }}Degraded, metav1.ConditionFalse,
+			"Reconciled", "The {{ .Resource.Kind }} reached its desired state")
+	}
+	{{ lower .Resource.Kind }}.Status.ObservedGeneration = {{ lower .Resource.Kind }}.Generation
+
+	if err := r.Status().Update(ctx, {{ lower .Resource.Kind }}); err != nil {
+		log.Error(err, "Failed to update the {{ .Resource.Kind }} status")
+		return ctrl.Result{}, err
+	}
+{{- end }}
 `
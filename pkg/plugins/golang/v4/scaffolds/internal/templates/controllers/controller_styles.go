@@ -0,0 +1,167 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+// The templates of the Reconcile method of the controller styles. They are executed with the data of the
+// Controller template and can use the templates defined in its footer, see controllerFooterTemplate.
+
+// MinimalReconcileTemplate is the Reconcile method of the minimal style, a skeleton to implement.
+// It manages the finalizer and the status conditions of the resource when they are enabled, which makes
+// it the Reconcile method of the status-driven style as well.
+const MinimalReconcileTemplate = `func (r *{{ .ReconcilerName }}) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+{{- if or .Conditions .Finalizer }}
+{{- template "fetchResource" . }}
+{{- if .Conditions }}
+{{- template "markProgressing" . }}
+
+	// TODO(user): your logic here. Set reconcileErr when the desired state cannot be reached,
+	// so the {{ .Resource.Kind }} is marked as degraded and the request is retried.
+	var reconcileErr error
+{{- template "updateConditions" . }}
+
+	return ctrl.Result{}, reconcileErr
+{{- else }}
+
+	// TODO(user): your logic here
+
+	return ctrl.Result{}, nil
+{{- end }}
+{{- else }}
+	_ = logf.FromContext(ctx)
+
+	// TODO(user): your logic here
+
+	return ctrl.Result{}, nil
+{{- end }}
+}
+`
+
+// ServerSideApplyReconcileTemplate is the Reconcile method of the server-side-apply style, which builds
+// the desired state of the objects managed for the resource and applies it with field ownership.
+//
+//nolint:lll
+const ServerSideApplyReconcileTemplate = `func (r *{{ .ReconcilerName }}) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+{{- template "fetchResource" . }}
+{{- if .Conditions }}
+{{- template "markProgressing" . }}
+
+	// The {{ .Resource.Kind }} is marked as degraded and the request is retried when the desired state cannot be applied
+	reconcileErr := r.applyDesiredObjects(ctx, {{ lower .Resource.Kind }})
+{{- template "updateConditions" . }}
+
+	return ctrl.Result{}, reconcileErr
+{{- else }}
+
+	if err := r.applyDesiredObjects(ctx, {{ lower .Resource.Kind }}); err != nil {
+		log.Error(err, "Failed to apply the desired state of the {{ .Resource.Kind }}")
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+{{- end }}
+}
+
+// {{ .IdentifierPrefix }}FieldOwner is the field manager of the objects applied for the {{ .Resource.Kind }} objects
+const {{ .IdentifierPrefix }}FieldOwner = "{{ .ProjectName }}-{{ .ControllerRuntimeName }}"
+
+// applyDesiredObjects applies the desired state of the objects managed for the {{ .Resource.Kind }} with server-side apply.
+// The controller owns the fields it sets, so the fields that it stops setting are removed from the objects.
+// More info: https://kubernetes.io/docs/reference/using-api/server-side-apply
+func (r *{{ .ReconcilerName }}) applyDesiredObjects(ctx context.Context, {{ lower .Resource.Kind }} *{{ .Resource.ImportAlias }}.{{ .Resource.Kind }}) error {
+	objects, err := r.desiredObjects({{ lower .Resource.Kind }})
+	if err != nil {
+		return fmt.Errorf("failed to build the desired objects: %w", err)
+	}
+
+	for _, obj := range objects {
+		if err := r.Apply(ctx, obj, client.FieldOwner({{ .IdentifierPrefix }}FieldOwner), client.ForceOwnership); err != nil {
+			return fmt.Errorf("failed to apply the desired objects: %w", err)
+		}
+	}
+	return nil
+}
+
+// desiredObjects returns the desired state of the objects managed for the {{ .Resource.Kind }}.
+func (r *{{ .ReconcilerName }}) desiredObjects({{ lower .Resource.Kind }} *{{ .Resource.ImportAlias }}.{{ .Resource.Kind }}) ([]runtime.ApplyConfiguration, error) {
+	// TODO(user): Build the objects with the apply configurations of their types, setting only the fields
+	// managed by the controller, e.g. with appsv1.Deployment(name, namespace) of the package
+	// k8s.io/client-go/applyconfigurations/apps/v1. Set the {{ .Resource.Kind }} as their controller with
+	// WithOwnerReferences, so they are deleted with it.
+	return nil, nil
+}
+`
+
+// PhasedReconcileTemplate is the Reconcile method of the phased style, which runs a chain of sub-reconcilers.
+//
+//nolint:lll
+const PhasedReconcileTemplate = `func (r *{{ .ReconcilerName }}) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+{{- template "fetchResource" . }}
+{{- if .Conditions }}
+{{- template "markProgressing" . }}
+{{- end }}
+
+	// The reconciliation is a chain of phases, each one reconciling a part of the desired state.
+	// The chain stops at the first phase that fails or returns a result to requeue the request.
+	phases := []func(context.Context, *{{ .Resource.ImportAlias }}.{{ .Resource.Kind }}) (ctrl.Result, error){
+		r.reconcileDependencies,
+		r.reconcileWorkload,
+	}
+{{- if .Conditions }}
+
+	var result ctrl.Result
+	var reconcileErr error
+	for _, phase := range phases {
+		if result, reconcileErr = phase(ctx, {{ lower .Resource.Kind }}); reconcileErr != nil || !result.IsZero() {
+			break
+		}
+	}
+{{- template "updateConditions" . }}
+
+	if reconcileErr != nil {
+		return ctrl.Result{}, reconcileErr
+	}
+	return result, nil
+{{- else }}
+
+	for _, phase := range phases {
+		result, err := phase(ctx, {{ lower .Resource.Kind }})
+		if err != nil {
+			log.Error(err, "Failed to reconcile the {{ .Resource.Kind }}")
+			return ctrl.Result{}, err
+		}
+		if !result.IsZero() {
+			return result, nil
+		}
+	}
+
+	return ctrl.Result{}, nil
+{{- end }}
+}
+
+// reconcileDependencies is the first phase of the reconciliation of the {{ .Resource.Kind }}.
+// It returns a non-zero result to requeue the request, e.g. while a dependency is not ready.
+func (r *{{ .ReconcilerName }}) reconcileDependencies(ctx context.Context, {{ lower .Resource.Kind }} *{{ .Resource.ImportAlias }}.{{ .Resource.Kind }}) (ctrl.Result, error) {
+	// TODO(user): Check the objects the {{ .Resource.Kind }} depends on, such as the Secrets it references.
+	return ctrl.Result{}, nil
+}
+
+// reconcileWorkload is the second phase of the reconciliation of the {{ .Resource.Kind }}.
+func (r *{{ .ReconcilerName }}) reconcileWorkload(ctx context.Context, {{ lower .Resource.Kind }} *{{ .Resource.ImportAlias }}.{{ .Resource.Kind }}) (ctrl.Result, error) {
+	// TODO(user): Create or update the objects that run the workload of the {{ .Resource.Kind }}.
+	return ctrl.Result{}, nil
+}
+`
@@ -19,8 +19,11 @@ package controllers
 import (
 	log "log/slog"
 	"path/filepath"
+	"strings"
 
 	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang"
 )
 
 var _ machinery.Template = &ControllerTest{}
@@ -37,15 +40,39 @@ type ControllerTest struct {
 	Force bool
 
 	DoAPI bool
+
+	// Conditions is true if the controller manages the status conditions of the resource
+	Conditions bool
+
+	// Finalizer is true if the controller adds a finalizer to the resource and cleans it up on deletion
+	Finalizer bool
+
+	// ControllerName is the name of the controller under test, empty for the default one
+	ControllerName string
+
+	// SpecFields are the fields declared on the command line, the required ones are set in the test resource
+	SpecFields []golang.Field
+
+	// RequiredSpecFields, StdImports and Imports are computed from SpecFields
+	RequiredSpecFields []golang.Field
+	StdImports         []string
+	Imports            []string
 }
 
 // SetTemplateDefaults implements machinery.Template
 func (f *ControllerTest) SetTemplateDefaults() error {
 	if f.Path == "" {
+		fileName := "%[kind]_controller_test.go"
+		if f.Finalizer && f.ControllerName != "" {
+			// Additional controllers share the test file of the kind, unless they manage a finalizer
+			// whose deletion flow needs to be covered by tests of their own
+			fileName = resource.NormalizeFileName(f.ControllerName) + "_controller_test.go"
+		}
+
 		if f.MultiGroup && f.Resource.Group != "" {
-			f.Path = filepath.Join("internal", "controller", "%[group]", "%[kind]_controller_test.go")
+			f.Path = filepath.Join("internal", "controller", "%[group]", fileName)
 		} else {
-			f.Path = filepath.Join("internal", "controller", "%[kind]_controller_test.go")
+			f.Path = filepath.Join("internal", "controller", fileName)
 		}
 	}
 
@@ -54,6 +81,21 @@ func (f *ControllerTest) SetTemplateDefaults() error {
 
 	f.TemplateBody = controllerTestTemplate
 
+	f.RequiredSpecFields = nil
+	for _, field := range f.SpecFields {
+		if field.Required {
+			f.RequiredSpecFields = append(f.RequiredSpecFields, field)
+		}
+	}
+	f.StdImports, f.Imports = nil, nil
+	for _, imp := range golang.ValueImports(f.RequiredSpecFields) {
+		if strings.Contains(imp, ".") {
+			f.Imports = append(f.Imports, imp)
+		} else {
+			f.StdImports = append(f.StdImports, imp)
+		}
+	}
+
 	if f.Force {
 		f.IfExistsAction = machinery.OverwriteFile
 	}
@@ -61,6 +103,16 @@ func (f *ControllerTest) SetTemplateDefaults() error {
 	return nil
 }
 
+// ReconcilerName returns the name of the reconciler type under test
+func (f *ControllerTest) ReconcilerName() string {
+	return resource.NormalizeReconcilerName(f.ControllerName, f.Resource.Kind)
+}
+
+// FinalizerConstName returns the name of the constant that holds the finalizer of the controller
+func (f *ControllerTest) FinalizerConstName() string {
+	return FinalizerConstName(f.ControllerName, f.Resource.Kind)
+}
+
 const controllerTestTemplate = `{{ .Boilerplate }}
 
 {{if and .MultiGroup .Resource.Group }}
@@ -72,16 +124,28 @@ package controller
 import (
 	{{ if .DoAPI -}}
 	"context"
+	{{- range .StdImports }}
+	{{ . }}
+	{{- end }}
 	{{- end }}
 	. "github.com/onsi/ginkgo/v2"
 	{{ if .DoAPI -}}
 
 	. "github.com/onsi/gomega"
 	"k8s.io/apimachinery/pkg/api/errors"
+	{{- if .Conditions }}
+	"k8s.io/apimachinery/pkg/api/meta"
+	{{- end }}
 	"k8s.io/apimachinery/pkg/types"
+	{{- if .Finalizer }}
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	{{- end }}
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	{{- range .Imports }}
+	{{ . }}
+	{{- end }}
 	{{ if not (isEmptyStr .Resource.Path) -}}
 	{{ .Resource.ImportAlias }} "{{ .Resource.Path }}"
 	{{- end }}
@@ -110,6 +174,16 @@ var _ = Describe("{{ .Resource.Kind }} Controller", func() {
 						Name:      resourceName,
 						Namespace: "default",
 					},
+					{{- if .RequiredSpecFields }}
+					Spec: {{ .Resource.ImportAlias }}.{{ .Resource.Kind }}Spec{
+						{{- range .RequiredSpecFields }}
+						{{- if .HasPattern }}
+						// TODO(user): Set a value matching the pattern of {{ .Name }}.
+						{{- end }}
+						{{ .GoName }}: {{ .GoValue }},
+						{{- end }}
+					},
+					{{- end }}
 					// TODO(user): Specify other spec details if needed.
 				}
 				Expect(k8sClient.Create(ctx, resource)).To(Succeed())
@@ -120,16 +194,33 @@ var _ = Describe("{{ .Resource.Kind }} Controller", func() {
 			// TODO(user): Cleanup logic after each test, like removing the resource instance.
 			resource := &{{ .Resource.ImportAlias }}.{{ .Resource.Kind }}{}
 			err := k8sClient.Get(ctx, typeNamespacedName, resource)
+			{{- if .Finalizer }}
+			if errors.IsNotFound(err) {
+				return
+			}
+			{{- end }}
 			Expect(err).NotTo(HaveOccurred())
 
 			By("Cleanup the specific resource instance {{ .Resource.Kind }}")
 			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+			{{- if .Finalizer }}
+
+			By("Reconciling the deleted resource to remove its finalizer")
+			controllerReconciler := &{{ .ReconcilerName }}{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			{{- end }}
 		})
 		{{- end }}
 		It("should successfully reconcile the resource", func() {
 			{{ if .DoAPI -}}
 			By("Reconciling the created resource")
-			controllerReconciler := &{{ .Resource.Kind }}Reconciler{
+			controllerReconciler := &{{ .ReconcilerName }}{
 				Client: k8sClient,
 				Scheme: k8sClient.Scheme(),
 			}
@@ -138,10 +229,50 @@ var _ = Describe("{{ .Resource.Kind }} Controller", func() {
 				NamespacedName: typeNamespacedName,
 			})
 			Expect(err).NotTo(HaveOccurred())
+			{{- if .Conditions }}
+
+			By("Checking the status conditions of the reconciled resource")
+			Expect(k8sClient.Get(ctx, typeNamespacedName, {{ lower .Resource.Kind }})).To(Succeed())
+			conditions := {{ lower .Resource.Kind }}.Status.Conditions
+			Expect(meta.IsStatusConditionTrue(conditions, {{ .Resource.ImportAlias }}.{{ .Resource.Kind }}Available)).To(BeTrue())
+			Expect(meta.IsStatusConditionFalse(conditions, {{ .Resource.ImportAlias }}.{{ .Resource.Kind }}Progressing)).To(BeTrue())
+			Expect(meta.IsStatusConditionFalse(conditions, {{ .Resource.ImportAlias }}.{{ .Resource.Kind }}Degraded)).To(BeTrue())
+			Expect({{ lower .Resource.Kind }}.Status.ObservedGeneration).To(Equal({{ lower .Resource.Kind }}.Generation))
+			{{- end }}
 			{{- end }}
 			// TODO(user): Add more specific assertions depending on your controller's reconciliation logic.
 			// Example: If you expect a certain status condition after reconciliation, verify it here.
 		})
+		{{- if and .DoAPI .Finalizer }}
+
+		It("should run the cleanup and remove the finalizer when the resource is deleted", func() {
+			controllerReconciler := &{{ .ReconcilerName }}{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+
+			By("Reconciling the created resource to add the finalizer")
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, {{ lower .Resource.Kind }})).To(Succeed())
+			Expect(controllerutil.ContainsFinalizer({{ lower .Resource.Kind }}, {{ .FinalizerConstName }})).To(BeTrue())
+
+			By("Deleting the resource, which is kept until the finalizer is removed")
+			Expect(k8sClient.Delete(ctx, {{ lower .Resource.Kind }})).To(Succeed())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, {{ lower .Resource.Kind }})).To(Succeed())
+			Expect({{ lower .Resource.Kind }}.DeletionTimestamp).NotTo(BeNil())
+
+			By("Reconciling the deleted resource to run the cleanup")
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			err = k8sClient.Get(ctx, typeNamespacedName, {{ lower .Resource.Kind }})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+		{{- end }}
 	})
 })
 `
@@ -0,0 +1,125 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hack
+
+import (
+	"path/filepath"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+)
+
+// DefaultUpdateCodegenPath is the default path to the script generating the clients of the APIs
+var DefaultUpdateCodegenPath = filepath.Join("hack", "update-codegen.sh")
+
+var _ machinery.Template = &UpdateCodegen{}
+
+// UpdateCodegen scaffolds the script that generates the typed clientset, listers, informers and apply
+// configurations of the APIs. It is scaffolded again for every API created with clients, as it lists them all.
+type UpdateCodegen struct {
+	machinery.TemplateMixin
+	machinery.RepositoryMixin
+
+	// Packages are the Go packages of the API versions whose clients are generated
+	Packages []string
+}
+
+// SetTemplateDefaults implements machinery.Template
+func (f *UpdateCodegen) SetTemplateDefaults() error {
+	if f.Path == "" {
+		f.Path = DefaultUpdateCodegenPath
+	}
+
+	f.TemplateBody = updateCodegenTemplate
+
+	f.IfExistsAction = machinery.OverwriteFile
+
+	return nil
+}
+
+const updateCodegenTemplate = `#!/usr/bin/env bash
+
+# This script generates the typed clientset, listers, informers and apply configurations of the APIs
+# in pkg/client with k8s.io/code-generator. Run it with 'make generate-clients', which installs the generators.
+#
+# It is scaffolded again by 'kubebuilder create api' for every API created with '--clients', listing
+# all the API versions that are generated with clients in the PROJECT file. Changes to it are overwritten.
+
+set -o errexit
+set -o nounset
+set -o pipefail
+
+cd "$(dirname "${BASH_SOURCE[0]}")/.."
+
+CLIENT_GEN="${CLIENT_GEN:-bin/client-gen}"
+LISTER_GEN="${LISTER_GEN:-bin/lister-gen}"
+INFORMER_GEN="${INFORMER_GEN:-bin/informer-gen}"
+APPLYCONFIGURATION_GEN="${APPLYCONFIGURATION_GEN:-bin/applyconfiguration-gen}"
+
+MODULE="{{ .Repo }}"
+OUTPUT_DIR="pkg/client"
+OUTPUT_PKG="${MODULE}/${OUTPUT_DIR}"
+BOILERPLATE="hack/boilerplate.go.txt"
+
+# API_PACKAGES are the Go packages of the API versions whose clients are generated
+API_PACKAGES=(
+{{- range .Packages }}
+  "{{ . }}"
+{{- end }}
+)
+
+# Remove the generated code first, so that the clients of the removed API versions are deleted too
+rm -rf "${OUTPUT_DIR}/applyconfiguration" "${OUTPUT_DIR}/clientset" "${OUTPUT_DIR}/listers" "${OUTPUT_DIR}/informers"
+
+echo "Generating the apply configurations in ${OUTPUT_DIR}/applyconfiguration"
+"${APPLYCONFIGURATION_GEN}" \
+  --go-header-file "${BOILERPLATE}" \
+  --output-dir "${OUTPUT_DIR}/applyconfiguration" \
+  --output-pkg "${OUTPUT_PKG}/applyconfiguration" \
+  "${API_PACKAGES[@]}"
+
+# client-gen takes the packages relative to the module, ending with their group and version directories
+CLIENT_INPUTS=()
+for pkg in "${API_PACKAGES[@]}"; do
+  CLIENT_INPUTS+=(--input "${pkg#"${MODULE}/"}")
+done
+
+echo "Generating the clientset in ${OUTPUT_DIR}/clientset"
+"${CLIENT_GEN}" \
+  --go-header-file "${BOILERPLATE}" \
+  --output-dir "${OUTPUT_DIR}/clientset" \
+  --output-pkg "${OUTPUT_PKG}/clientset" \
+  --clientset-name versioned \
+  --input-base "${MODULE}" \
+  --apply-configuration-package "${OUTPUT_PKG}/applyconfiguration" \
+  "${CLIENT_INPUTS[@]}"
+
+echo "Generating the listers in ${OUTPUT_DIR}/listers"
+"${LISTER_GEN}" \
+  --go-header-file "${BOILERPLATE}" \
+  --output-dir "${OUTPUT_DIR}/listers" \
+  --output-pkg "${OUTPUT_PKG}/listers" \
+  "${API_PACKAGES[@]}"
+
+echo "Generating the informers in ${OUTPUT_DIR}/informers"
+"${INFORMER_GEN}" \
+  --go-header-file "${BOILERPLATE}" \
+  --output-dir "${OUTPUT_DIR}/informers" \
+  --output-pkg "${OUTPUT_PKG}/informers" \
+  --versioned-clientset-package "${OUTPUT_PKG}/clientset/versioned" \
+  --listers-package "${OUTPUT_PKG}/listers" \
+  "${API_PACKAGES[@]}"
+`
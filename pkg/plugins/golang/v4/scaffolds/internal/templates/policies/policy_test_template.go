@@ -0,0 +1,170 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policies
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+)
+
+var _ machinery.Template = &PolicyTest{}
+
+// PolicyTest scaffolds the file that tests the admission policy of a resource
+type PolicyTest struct {
+	machinery.TemplateMixin
+	machinery.BoilerplateMixin
+	machinery.ResourceMixin
+
+	// Type is the type of the tested policy
+	Type resource.PolicyType
+
+	// ManifestName is the name of the policy manifests, without the _policy.yaml and _binding.yaml suffixes
+	ManifestName string
+
+	// APIVersion is the apiVersion of the objects of the resource
+	APIVersion string
+
+	// Namespaced is true if the objects of the resource are namespaced
+	Namespaced bool
+
+	Force bool
+}
+
+// SetTemplateDefaults implements machinery.Template
+func (f *PolicyTest) SetTemplateDefaults() error {
+	if f.Path == "" {
+		f.Path = filepath.Join("internal", "policy", fmt.Sprintf("%s_test.go", f.ManifestName))
+	}
+
+	f.APIVersion = f.Resource.Version
+	if group := f.Resource.QualifiedGroup(); !f.Resource.Core || group != "core" {
+		f.APIVersion = fmt.Sprintf("%s/%s", group, f.Resource.Version)
+	}
+	// The scope of core types is not tracked, most of them are namespaced.
+	f.Namespaced = !f.Resource.HasAPI() || f.Resource.API.Namespaced
+
+	switch f.Type {
+	case resource.MutatingPolicy:
+		f.TemplateBody = mutatingPolicyTestTemplate
+	default:
+		f.TemplateBody = validatingPolicyTestTemplate
+	}
+
+	if f.Force {
+		f.IfExistsAction = machinery.OverwriteFile
+	} else {
+		f.IfExistsAction = machinery.Error
+	}
+
+	return nil
+}
+
+// ManifestName returns the base name of the manifests of the policy of the provided type,
+// following the naming of the samples, e.g. crew_v1_captain_validating.
+func ManifestName(res resource.Resource, policyType resource.PolicyType) string {
+	if res.Group != "" {
+		return res.Replacer().Replace(fmt.Sprintf("%%[group]_%%[version]_%%[kind]_%s", policyType))
+	}
+	return res.Replacer().Replace(fmt.Sprintf("%%[version]_%%[kind]_%s", policyType))
+}
+
+//nolint:lll
+const policyTestObjectTemplate = `
+	BeforeEach(func() {
+		By("applying the policy and its binding")
+		applyManifest(filepath.Join("..", "..", "config", "policies", "{{ .ManifestName }}_policy.yaml"))
+		applyManifest(filepath.Join("..", "..", "config", "policies", "{{ .ManifestName }}_binding.yaml"))
+
+		obj = &unstructured.Unstructured{}
+		obj.SetAPIVersion("{{ .APIVersion }}")
+		obj.SetKind("{{ .Resource.Kind }}")
+		obj.SetName("test-{{ lower .Resource.Kind }}")
+		{{- if .Namespaced }}
+		obj.SetNamespace("default")
+		{{- end }}
+		// TODO(user): Set the fields required by the API so that the object is valid, e.g.
+		// Expect(unstructured.SetNestedField(obj.Object, "value", "spec", "field")).To(Succeed())
+	})
+`
+
+//nolint:lll
+const validatingPolicyTestTemplate = `{{ .Boilerplate }}
+
+package policy
+
+import (
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("{{ .Resource.Kind }} ValidatingAdmissionPolicy", func() {
+	var obj *unstructured.Unstructured
+` + policyTestObjectTemplate + `
+	// TODO(user): Update the following tests to match the validations of the policy.
+	It("Should deny the objects labeled with example.kubebuilder.io/reject", func() {
+		obj.SetLabels(map[string]string{"example.kubebuilder.io/reject": "true"})
+
+		// The policy is enforced once the API server has loaded it, which happens asynchronously.
+		Eventually(func(g Gomega) {
+			err := k8sClient.Create(ctx, obj.DeepCopy(), client.DryRunAll)
+			g.Expect(err).To(MatchError(ContainSubstring("example.kubebuilder.io/reject")))
+		}).Should(Succeed())
+	})
+
+	It("Should admit the other objects", func() {
+		Expect(k8sClient.Create(ctx, obj.DeepCopy(), client.DryRunAll)).To(Succeed())
+	})
+})
+`
+
+//nolint:lll
+const mutatingPolicyTestTemplate = `{{ .Boilerplate }}
+
+package policy
+
+import (
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("{{ .Resource.Kind }} MutatingAdmissionPolicy", func() {
+	var obj *unstructured.Unstructured
+` + policyTestObjectTemplate + `
+	// TODO(user): Update the following test to match the mutations of the policy.
+	It("Should label the created objects with example.kubebuilder.io/mutated", func() {
+		// The policy is enforced once the API server has loaded it, which happens asynchronously.
+		Eventually(func(g Gomega) {
+			created := obj.DeepCopy()
+			g.Expect(k8sClient.Create(ctx, created, client.DryRunAll)).To(Succeed())
+			g.Expect(created.GetLabels()).To(HaveKeyWithValue("example.kubebuilder.io/mutated", "true"))
+		}).Should(Succeed())
+	})
+})
+`
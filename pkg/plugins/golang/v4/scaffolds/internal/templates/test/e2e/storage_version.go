@@ -0,0 +1,101 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package e2e
+
+import (
+	"bytes"
+	"fmt"
+	log "log/slog"
+	"os"
+	"path/filepath"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+)
+
+var _ machinery.Inserter = &StorageVersionTestUpdater{}
+
+const storageVersionChecksMarker = "e2e-storage-version-checks"
+
+// StorageVersionTestUpdater updates e2e_test.go to check that the stored objects of the resource
+// are migrated to the storage version of its CRD
+type StorageVersionTestUpdater struct {
+	machinery.ResourceMixin
+}
+
+// GetPath implements file.Builder
+func (*StorageVersionTestUpdater) GetPath() string {
+	return filepath.Join("test", "e2e", "e2e_test.go")
+}
+
+// GetIfExistsAction implements file.Builder
+func (*StorageVersionTestUpdater) GetIfExistsAction() machinery.IfExistsAction {
+	return machinery.OverwriteFile // Ensures only the marker is replaced
+}
+
+// GetMarkers implements file.Inserter
+func (f *StorageVersionTestUpdater) GetMarkers() []machinery.Marker {
+	marker := machinery.NewMarkerFor(f.GetPath(), storageVersionChecksMarker)
+
+	// Tests scaffolded before the storage version checks only have the marker of the webhook checks
+	content, err := os.ReadFile(f.GetPath())
+	if err == nil && !bytes.Contains(content, []byte(marker.String())) {
+		marker = machinery.NewMarkerFor(f.GetPath(), webhookChecksMarker)
+	}
+	return []machinery.Marker{marker}
+}
+
+// GetCodeFragments implements file.Inserter
+func (f *StorageVersionTestUpdater) GetCodeFragments() machinery.CodeFragmentsMap {
+	content, err := os.ReadFile(f.GetPath())
+	if err != nil {
+		log.Warn("Unable to read file, skipping the storage version test code injection",
+			"file", f.GetPath(), "error", err)
+		return nil
+	}
+
+	marker := f.GetMarkers()[0]
+	if !bytes.Contains(content, []byte(marker.String())) {
+		log.Warn("Marker not found in file, skipping the storage version test code injection",
+			"marker", marker.String(),
+			"file_path", f.GetPath())
+		return nil
+	}
+
+	crdName := f.Resource.Plural + "." + f.Resource.QualifiedGroup()
+	return machinery.CodeFragmentsMap{
+		marker: []string{fmt.Sprintf(storageVersionChecksFragment, f.Resource.Kind, crdName)},
+	}
+}
+
+const storageVersionChecksFragment = `It("should migrate the stored %[1]s objects to the storage version", func() {
+	By("running the storage version migration")
+	cmd := exec.Command("make", "migrate-storage")
+	_, err := utils.Run(cmd)
+	Expect(err).NotTo(HaveOccurred(), "Failed to migrate the stored objects")
+
+	By("checking that %[1]s objects are only stored in the storage version")
+	crd := "customresourcedefinitions.apiextensions.k8s.io/%[2]s"
+	cmd = exec.Command("kubectl", "get", crd, "-o", "jsonpath={.spec.versions[?(@.storage==true)].name}")
+	storageVersion, err := utils.Run(cmd)
+	Expect(err).NotTo(HaveOccurred())
+	cmd = exec.Command("kubectl", "get", crd, "-o", "jsonpath={.status.storedVersions}")
+	storedVersions, err := utils.Run(cmd)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(storedVersions).To(Equal("[\"" + storageVersion + "\"]"))
+})
+
+`
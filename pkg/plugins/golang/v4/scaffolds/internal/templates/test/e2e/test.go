@@ -120,12 +120,12 @@ func (f *WebhookTestUpdater) GetCodeFragments() machinery.CodeFragmentsMap {
 			var fragments []string
 			fragments = append(fragments, webhookChecksFragment)
 
-			if f.Resource != nil && f.Resource.HasDefaultingWebhook() {
+			if f.Resource != nil && f.Resource.HasMutatingWebhook() {
 				mutatingWebhookCode := fmt.Sprintf(mutatingWebhookChecksFragment, f.ProjectName)
 				fragments = append(fragments, mutatingWebhookCode)
 			}
 
-			if f.Resource != nil && f.Resource.HasValidationWebhook() {
+			if f.Resource != nil && f.Resource.HasValidatingWebhook() {
 				validatingWebhookCode := fmt.Sprintf(validatingWebhookChecksFragment, f.ProjectName)
 				fragments = append(fragments, validatingWebhookCode)
 			}
@@ -159,12 +159,12 @@ func (f *WebhookTestUpdater) GetCodeFragments() machinery.CodeFragmentsMap {
 				fragments = append(fragments, fmt.Sprintf(webhookEndpointsReadinessFragment, webhookServiceName))
 
 				// Add mutating webhook configuration check if defaulting webhooks exist
-				if f.Resource != nil && f.Resource.HasDefaultingWebhook() {
+				if f.Resource != nil && f.Resource.HasMutatingWebhook() {
 					fragments = append(fragments, fmt.Sprintf(mutatingWebhookReadinessFragment, f.ProjectName))
 				}
 
 				// Add validating webhook configuration check if validation webhooks exist
-				if f.Resource != nil && f.Resource.HasValidationWebhook() {
+				if f.Resource != nil && f.Resource.HasValidatingWebhook() {
 					fragments = append(fragments, fmt.Sprintf(validatingWebhookReadinessFragment, f.ProjectName))
 				}
 
@@ -547,6 +547,8 @@ var _ = Describe("Manager", Ordered, func() {
 
 		// +kubebuilder:scaffold:e2e-webhooks-checks
 
+		// +kubebuilder:scaffold:e2e-storage-version-checks
+
 		// TODO: Customize the e2e test suite with scenarios specific to your project.
 		// Consider applying sample/CR(s) and check their status and/or verifying
 		// the reconciliation by using the metrics, i.e.:
@@ -0,0 +1,177 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+)
+
+const (
+	defaultFailurePolicy = "fail"
+	defaultSideEffects   = "None"
+	defaultVerbs         = "create;update"
+)
+
+var webhookMarkerRegexp = regexp.MustCompile(`(?m)^(\s*// \+kubebuilder:webhook:)(.*)$`)
+
+// AdmissionMarker renders the admission arguments of the +kubebuilder:webhook marker of a webhook.
+// The arguments that are not configured keep the values historically scaffolded.
+type AdmissionMarker struct {
+	Config *resource.AdmissionConfig
+}
+
+// FailurePolicy returns the value of the failurePolicy argument.
+func (m AdmissionMarker) FailurePolicy() string {
+	if m.Config == nil || m.Config.FailurePolicy == "" {
+		return defaultFailurePolicy
+	}
+	return strings.ToLower(m.Config.FailurePolicy)
+}
+
+// SideEffects returns the value of the sideEffects argument.
+func (m AdmissionMarker) SideEffects() string {
+	if m.Config == nil || m.Config.SideEffects == "" {
+		return defaultSideEffects
+	}
+	return m.Config.SideEffects
+}
+
+// Verbs returns the value of the verbs argument.
+func (m AdmissionMarker) Verbs() string {
+	if m.Config == nil || len(m.Config.Operations) == 0 {
+		return defaultVerbs
+	}
+	return strings.ToLower(strings.Join(m.Config.Operations, ";"))
+}
+
+// Extra returns the optional arguments of the marker, each one prefixed by a comma.
+func (m AdmissionMarker) Extra() string {
+	var extra strings.Builder
+	for _, arg := range m.optionalArgs() {
+		if arg.value != "" {
+			extra.WriteString("," + arg.key + "=" + arg.value)
+		}
+	}
+	return extra.String()
+}
+
+type markerArg struct {
+	key, value string
+}
+
+// args returns the admission arguments of the marker, optional ones with an empty value when not set.
+func (m AdmissionMarker) args() []markerArg {
+	return append([]markerArg{
+		{"failurePolicy", m.FailurePolicy()},
+		{"sideEffects", m.SideEffects()},
+		{"verbs", m.Verbs()},
+	}, m.optionalArgs()...)
+}
+
+func (m AdmissionMarker) optionalArgs() []markerArg {
+	args := []markerArg{{key: "timeoutSeconds"}, {key: "matchPolicy"}, {key: "reinvocationPolicy"}}
+	if m.Config != nil {
+		if m.Config.TimeoutSeconds != 0 {
+			args[0].value = strconv.Itoa(m.Config.TimeoutSeconds)
+		}
+		args[1].value = m.Config.MatchPolicy
+		args[2].value = m.Config.ReinvocationPolicy
+	}
+	return args
+}
+
+// UpdateAdmissionMarker updates in place the +kubebuilder:webhook marker named name found in content
+// from the previous admission configuration to the edited one. Only the arguments whose value changed
+// are rewritten, so the ones edited by hand are kept.
+func UpdateAdmissionMarker(content, name string, previous, edited *resource.AdmissionConfig) (string, error) {
+	changes := make(map[string]string)
+	editedArgs := AdmissionMarker{Config: edited}.args()
+	for i, arg := range (AdmissionMarker{Config: previous}).args() {
+		if arg.value != editedArgs[i].value {
+			changes[arg.key] = editedArgs[i].value
+		}
+	}
+	if len(changes) == 0 {
+		return content, nil
+	}
+
+	for _, match := range webhookMarkerRegexp.FindAllStringSubmatchIndex(content, -1) {
+		args := splitMarkerArgs(content[match[4]:match[5]])
+		if !containsArg(args, "name="+name) {
+			continue
+		}
+
+		updated := make([]string, 0, len(args)+len(changes))
+		for _, arg := range args {
+			key, _, _ := strings.Cut(arg, "=")
+			value, changed := changes[key]
+			if !changed {
+				updated = append(updated, arg)
+				continue
+			}
+			delete(changes, key)
+			if value != "" {
+				updated = append(updated, key+"="+value)
+			}
+		}
+		// The arguments that were not in the marker are appended in the usual order.
+		for _, arg := range editedArgs {
+			if value, ok := changes[arg.key]; ok && value != "" {
+				updated = append(updated, arg.key+"="+value)
+			}
+		}
+
+		return content[:match[4]] + strings.Join(updated, ",") + content[match[5]:], nil
+	}
+
+	return "", fmt.Errorf("unable to find the +kubebuilder:webhook marker with name=%s", name)
+}
+
+// splitMarkerArgs splits the arguments of a marker on the commas found outside braces,
+// as in webhookVersions={v1,v1beta1}.
+func splitMarkerArgs(args string) []string {
+	var result []string
+	depth, start := 0, 0
+	for i, char := range args {
+		switch char {
+		case '{':
+			depth++
+		case '}':
+			depth--
+		case ',':
+			if depth == 0 {
+				result = append(result, args[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(result, args[start:])
+}
+
+func containsArg(args []string, arg string) bool {
+	for _, a := range args {
+		if strings.TrimSpace(a) == arg {
+			return true
+		}
+	}
+	return false
+}
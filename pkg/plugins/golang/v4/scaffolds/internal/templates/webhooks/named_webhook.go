@@ -0,0 +1,345 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"fmt"
+	log "log/slog"
+	"path/filepath"
+	"strings"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+)
+
+var (
+	_ machinery.Template = &NamedWebhook{}
+	_ machinery.Template = &NamedWebhookTest{}
+)
+
+// NamedWebhook scaffolds the file that defines a named defaulting or validating webhook for a resource
+type NamedWebhook struct {
+	machinery.TemplateMixin
+	machinery.MultiGroupMixin
+	machinery.BoilerplateMixin
+	machinery.ResourceMixin
+
+	// Webhook is the named webhook to scaffold
+	Webhook resource.NamedWebhook
+
+	// TypeName is the prefix of the webhook types and setup function, e.g. CaptainCrossObject
+	TypeName string
+
+	// WebhookPath is the path the webhook is registered on
+	WebhookPath string
+
+	// Define value for AdmissionReviewVersions marker
+	AdmissionReviewVersions string
+
+	// Marker renders the admission arguments of the webhook marker
+	Marker AdmissionMarker
+
+	Force bool
+}
+
+// SetTemplateDefaults implements machinery.Template
+func (f *NamedWebhook) SetTemplateDefaults() error {
+	if f.Path == "" {
+		f.Path = namedWebhookPath(f.MultiGroup, f.Resource, f.Webhook.Name, "webhook.go")
+	}
+	f.Path = f.Resource.Replacer().Replace(f.Path)
+	log.Info(f.Path)
+
+	f.TypeName = resource.NormalizeWebhookTypeName(f.Webhook.Name, f.Resource.Kind)
+	f.WebhookPath = NamedWebhookPath(f.Resource, f.Webhook)
+	f.AdmissionReviewVersions = "v1"
+	f.Marker = AdmissionMarker{Config: f.Webhook.Admission}
+
+	if f.Webhook.Type == resource.DefaultingWebhook {
+		f.TemplateBody = namedWebhookTemplate + namedDefaultingWebhookTemplate
+	} else {
+		f.TemplateBody = namedWebhookTemplate + namedValidatingWebhookTemplate
+	}
+
+	if f.Force {
+		f.IfExistsAction = machinery.OverwriteFile
+	} else {
+		f.IfExistsAction = machinery.Error
+	}
+
+	return nil
+}
+
+// IsDefaulting returns true if the named webhook is a defaulting webhook
+func (f *NamedWebhook) IsDefaulting() bool {
+	return f.Webhook.Type == resource.DefaultingWebhook
+}
+
+// NamedWebhookPath returns the path a named webhook is registered on. Named webhooks are always registered
+// on their own path, so they do not conflict with the default webhooks of the resource, e.g.
+// /validate-crew-testproject-org-v1-captain-cross-object.
+func NamedWebhookPath(res *resource.Resource, webhook resource.NamedWebhook) string {
+	if webhook.Path != "" {
+		return webhook.Path
+	}
+
+	prefix := "/validate-"
+	if webhook.Type == resource.DefaultingWebhook {
+		prefix = "/mutate-"
+	}
+	group := strings.ReplaceAll(res.QualifiedGroup(), ".", "-")
+	if res.Core && res.QualifiedGroup() == "core" {
+		group = ""
+	}
+	return fmt.Sprintf("%s%s-%s-%s-%s", prefix, group, res.Version, strings.ToLower(res.Kind), webhook.Name)
+}
+
+// namedWebhookPath returns the path of a file of a named webhook, e.g. internal/webhook/v1/captain_cross_object_webhook.go
+func namedWebhookPath(multiGroup bool, res *resource.Resource, name, suffix string) string {
+	fileName := fmt.Sprintf("%%[kind]_%s_%s", resource.NormalizeFileName(name), suffix)
+	if multiGroup && res.Group != "" {
+		return filepath.Join("internal", "webhook", "%[group]", "%[version]", fileName)
+	}
+	return filepath.Join("internal", "webhook", "%[version]", fileName)
+}
+
+const (
+	namedWebhookTemplate = `{{ .Boilerplate }}
+
+package {{ .Resource.Version }}
+
+import (
+	"context"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	{{- if not .IsDefaulting }}
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+	{{- end }}
+	{{ if not (isEmptyStr .Resource.Path) -}}
+	{{ .Resource.ImportAlias }} "{{ .Resource.Path }}"
+	{{- end }}
+)
+
+// Setup{{ .TypeName }}WebhookWithManager registers the {{ .Webhook.Name }} {{ .Webhook.Type }} webhook
+// for {{ .Resource.Kind }} in the manager.
+func Setup{{ .TypeName }}WebhookWithManager(mgr ctrl.Manager) error {
+	{{- if not (isEmptyStr .Resource.ImportAlias) }}
+	return ctrl.NewWebhookManagedBy(mgr, &{{ .Resource.ImportAlias }}.{{ .Resource.Kind }}{}).
+	{{- else }}
+	return ctrl.NewWebhookManagedBy(mgr, &{{ .Resource.Kind }}{}).
+	{{- end }}
+		{{- if .IsDefaulting }}
+		WithDefaulter(&{{ .TypeName }}CustomDefaulter{}).
+		WithDefaulterCustomPath("{{ .WebhookPath }}").
+		{{- else }}
+		WithValidator(&{{ .TypeName }}CustomValidator{}).
+		WithValidatorCustomPath("{{ .WebhookPath }}").
+		{{- end }}
+		Complete()
+}
+
+// TODO(user): EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+`
+
+	//nolint:lll
+	namedDefaultingWebhookTemplate = `
+// NOTE: This webhook is deployed independently of the other webhooks of {{ .Resource.Kind }}, so its marker
+// can use its own failurePolicy, timeoutSeconds or matchPolicy.
+// +kubebuilder:webhook:path={{ .WebhookPath }},mutating=true,failurePolicy={{ .Marker.FailurePolicy }},sideEffects={{ .Marker.SideEffects }},groups={{ if and .Resource.Core (eq .Resource.QualifiedGroup "core") }}""{{ else }}{{ .Resource.QualifiedGroup }}{{ end }},resources={{ .Resource.Plural }},verbs={{ .Marker.Verbs }},versions={{ .Resource.Version }},name=m{{ lower .Resource.Kind }}-{{ .Webhook.Name }}-{{ .Resource.Version }}.kb.io,admissionReviewVersions={{ .AdmissionReviewVersions }}{{ .Marker.Extra }}
+
+// {{ .TypeName }}CustomDefaulter struct is responsible for setting default values on the custom resource of the
+// Kind {{ .Resource.Kind }} when those are created or updated.
+//
+// NOTE: The +kubebuilder:object:generate=false marker prevents controller-gen from generating DeepCopy methods,
+// as it is used only for temporary operations and does not need to be deeply copied.
+type {{ .TypeName }}CustomDefaulter struct {
+	// TODO(user): Add more fields as needed for defaulting
+}
+
+// Default implements webhook.CustomDefaulter so a webhook will be registered for the Kind {{ .Resource.Kind }}.
+func (d *{{ .TypeName }}CustomDefaulter) Default(ctx context.Context, obj *{{ .Resource.ImportAlias }}.{{ .Resource.Kind }}) error {
+	logf.FromContext(ctx).Info("Defaulting for {{ .Resource.Kind }}", "webhook", "{{ .Webhook.Name }}", "name", obj.GetName())
+
+	// TODO(user): fill in your defaulting logic.
+
+	return nil
+}
+`
+
+	//nolint:lll
+	namedValidatingWebhookTemplate = `
+// TODO(user): change verbs to "verbs=create;update;delete" if you want to enable deletion validation.
+// NOTE: This webhook is deployed independently of the other webhooks of {{ .Resource.Kind }}, so its marker
+// can use its own failurePolicy, timeoutSeconds or matchPolicy.
+// +kubebuilder:webhook:path={{ .WebhookPath }},mutating=false,failurePolicy={{ .Marker.FailurePolicy }},sideEffects={{ .Marker.SideEffects }},groups={{ if and .Resource.Core (eq .Resource.QualifiedGroup "core") }}""{{ else }}{{ .Resource.QualifiedGroup }}{{ end }},resources={{ .Resource.Plural }},verbs={{ .Marker.Verbs }},versions={{ .Resource.Version }},name=v{{ lower .Resource.Kind }}-{{ .Webhook.Name }}-{{ .Resource.Version }}.kb.io,admissionReviewVersions={{ .AdmissionReviewVersions }}{{ .Marker.Extra }}
+
+// {{ .TypeName }}CustomValidator struct is responsible for validating the {{ .Resource.Kind }} resource
+// when it is created, updated, or deleted.
+//
+// NOTE: The +kubebuilder:object:generate=false marker prevents controller-gen from generating DeepCopy methods,
+// as this struct is used only for temporary operations and does not need to be deeply copied.
+type {{ .TypeName }}CustomValidator struct {
+	// TODO(user): Add more fields as needed for validation
+}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type {{ .Resource.Kind }}.
+func (v *{{ .TypeName }}CustomValidator) ValidateCreate(ctx context.Context, obj *{{ .Resource.ImportAlias }}.{{ .Resource.Kind }}) (admission.Warnings, error) {
+	logf.FromContext(ctx).Info("Validation for {{ .Resource.Kind }} upon creation", "webhook", "{{ .Webhook.Name }}", "name", obj.GetName())
+
+	// TODO(user): fill in your validation logic upon object creation.
+
+	return nil, nil
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type {{ .Resource.Kind }}.
+func (v *{{ .TypeName }}CustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj *{{ .Resource.ImportAlias }}.{{ .Resource.Kind }}) (admission.Warnings, error) {
+	logf.FromContext(ctx).Info("Validation for {{ .Resource.Kind }} upon update", "webhook", "{{ .Webhook.Name }}", "name", newObj.GetName())
+
+	// TODO(user): fill in your validation logic upon object update.
+
+	return nil, nil
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type {{ .Resource.Kind }}.
+func (v *{{ .TypeName }}CustomValidator) ValidateDelete(ctx context.Context, obj *{{ .Resource.ImportAlias }}.{{ .Resource.Kind }}) (admission.Warnings, error) {
+	logf.FromContext(ctx).Info("Validation for {{ .Resource.Kind }} upon deletion", "webhook", "{{ .Webhook.Name }}", "name", obj.GetName())
+
+	// TODO(user): fill in your validation logic upon object deletion.
+
+	return nil, nil
+}
+`
+)
+
+// NamedWebhookTest scaffolds the file that sets up the unit tests of a named webhook
+type NamedWebhookTest struct {
+	machinery.TemplateMixin
+	machinery.MultiGroupMixin
+	machinery.BoilerplateMixin
+	machinery.ResourceMixin
+
+	// Webhook is the named webhook to scaffold the tests for
+	Webhook resource.NamedWebhook
+
+	// TypeName is the prefix of the webhook types, e.g. CaptainCrossObject
+	TypeName string
+
+	Force bool
+}
+
+// SetTemplateDefaults implements machinery.Template
+func (f *NamedWebhookTest) SetTemplateDefaults() error {
+	if f.Path == "" {
+		f.Path = namedWebhookPath(f.MultiGroup, f.Resource, f.Webhook.Name, "webhook_test.go")
+	}
+	f.Path = f.Resource.Replacer().Replace(f.Path)
+	log.Info(f.Path)
+
+	f.TypeName = resource.NormalizeWebhookTypeName(f.Webhook.Name, f.Resource.Kind)
+	f.TemplateBody = namedWebhookTestTemplate
+
+	if f.Force {
+		f.IfExistsAction = machinery.OverwriteFile
+	}
+
+	return nil
+}
+
+// IsDefaulting returns true if the named webhook is a defaulting webhook
+func (f *NamedWebhookTest) IsDefaulting() bool {
+	return f.Webhook.Type == resource.DefaultingWebhook
+}
+
+const namedWebhookTestTemplate = `{{ .Boilerplate }}
+
+package {{ .Resource.Version }}
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	{{ if not (isEmptyStr .Resource.Path) -}}
+	{{ .Resource.ImportAlias }} "{{ .Resource.Path }}"
+	{{- end }}
+	// TODO (user): Add any additional imports if needed
+)
+
+var _ = Describe("{{ .Resource.Kind }} {{ .Webhook.Name }} Webhook", func() {
+	var (
+		obj *{{ .Resource.ImportAlias }}.{{ .Resource.Kind }}
+		{{- if .IsDefaulting }}
+		defaulter {{ .TypeName }}CustomDefaulter
+		{{- else }}
+		oldObj *{{ .Resource.ImportAlias }}.{{ .Resource.Kind }}
+		validator {{ .TypeName }}CustomValidator
+		{{- end }}
+	)
+
+	BeforeEach(func() {
+		obj = &{{ .Resource.ImportAlias }}.{{ .Resource.Kind }}{}
+		{{- if .IsDefaulting }}
+		defaulter = {{ .TypeName }}CustomDefaulter{}
+		Expect(defaulter).NotTo(BeNil(), "Expected defaulter to be initialized")
+		{{- else }}
+		oldObj = &{{ .Resource.ImportAlias }}.{{ .Resource.Kind }}{}
+		validator = {{ .TypeName }}CustomValidator{}
+		Expect(validator).NotTo(BeNil(), "Expected validator to be initialized")
+		Expect(oldObj).NotTo(BeNil(), "Expected oldObj to be initialized")
+		{{- end }}
+		Expect(obj).NotTo(BeNil(), "Expected obj to be initialized")
+	})
+
+	AfterEach(func() {
+		// TODO (user): Add any teardown logic common to all tests
+	})
+
+	{{- if .IsDefaulting }}
+
+	Context("When creating {{ .Resource.Kind }} under the {{ .Webhook.Name }} Defaulting Webhook", func() {
+		// TODO (user): Add logic for defaulting webhooks
+		// Example:
+		// It("Should apply defaults when a required field is empty", func() {
+		//     By("simulating a scenario where defaults should be applied")
+		//     obj.SomeFieldWithDefault = ""
+		//     By("calling the Default method to apply defaults")
+		//     defaulter.Default(ctx, obj)
+		//     By("checking that the default values are set")
+		//     Expect(obj.SomeFieldWithDefault).To(Equal("default_value"))
+		// })
+	})
+	{{- else }}
+
+	Context("When creating or updating {{ .Resource.Kind }} under the {{ .Webhook.Name }} Validating Webhook", func() {
+		// TODO (user): Add logic for validating webhooks
+		// Example:
+		// It("Should deny creation if a required field is missing", func() {
+		//     By("simulating an invalid creation scenario")
+		//     obj.SomeRequiredField = ""
+		//     Expect(validator.ValidateCreate(ctx, obj)).Error().To(HaveOccurred())
+		// })
+		//
+		// It("Should validate updates correctly", func() {
+		//     By("simulating a valid update scenario")
+		//     oldObj.SomeRequiredField = "updated_value"
+		//     obj.SomeRequiredField = "updated_value"
+		//     Expect(validator.ValidateUpdate(ctx, oldObj, obj)).To(BeNil())
+		// })
+	})
+	{{- end }}
+})
+`
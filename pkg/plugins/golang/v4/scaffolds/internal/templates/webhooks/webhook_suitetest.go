@@ -22,6 +22,7 @@ import (
 	"path/filepath"
 
 	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
 )
 
 var (
@@ -46,6 +47,10 @@ type WebhookSuite struct {
 	// BaseDirectoryRelativePath define the Path for the base directory when it is multigroup
 	BaseDirectoryRelativePath string
 
+	// WebhookName is the name of the named webhook to set up in the suite.
+	// If empty, the default webhook of the resource will be set up.
+	WebhookName string
+
 	// Deprecated - The flag should be removed from go/v5
 	// IsLegacyPath indicates if webhooks should be scaffolded under the API.
 	// Webhooks are now decoupled from APIs based on controller-runtime updates and community feedback.
@@ -160,7 +165,11 @@ func (f *WebhookSuite) GetCodeFragments() machinery.CodeFragmentsMap {
 		addWebhookManager = append(addWebhookManager, fmt.Sprintf(addWebhookManagerCodeFragmentLegacy, f.Resource.Kind))
 	} else {
 		imports = append(imports, fmt.Sprintf(apiImportCodeFragment, f.Resource.ImportAlias(), f.Resource.Path))
-		addWebhookManager = append(addWebhookManager, fmt.Sprintf(addWebhookManagerCodeFragment, f.Resource.Kind))
+		typeName := f.Resource.Kind
+		if f.WebhookName != "" {
+			typeName = resource.NormalizeWebhookTypeName(f.WebhookName, f.Resource.Kind)
+		}
+		addWebhookManager = append(addWebhookManager, fmt.Sprintf(addWebhookManagerCodeFragment, typeName))
 	}
 
 	// Only store code fragments in the map if the slices are non-empty
@@ -266,6 +266,7 @@ func (f *WebhookUpdater) generateDefaultingWebhookCode() string {
 	var code strings.Builder
 
 	// Webhook marker
+	marker := AdmissionMarker{Config: f.Resource.Webhooks.DefaultingAdmission}
 	defaultingPath := f.Resource.Webhooks.DefaultingPath
 	if defaultingPath == "" {
 		if f.Resource.Core && f.Resource.QualifiedGroup() == coreGroup {
@@ -280,7 +281,7 @@ func (f *WebhookUpdater) generateDefaultingWebhookCode() string {
 	//nolint:lll
 	code.WriteString(fmt.Sprintf(
 		`
-// +kubebuilder:webhook:path=%s,mutating=true,failurePolicy=fail,sideEffects=None,groups=%s,resources=%s,verbs=create;update,versions=%s,name=m%s-%s.kb.io,admissionReviewVersions=%s
+// +kubebuilder:webhook:path=%s,mutating=true,failurePolicy=%s,sideEffects=%s,groups=%s,resources=%s,verbs=%s,versions=%s,name=m%s-%s.kb.io,admissionReviewVersions=%s%s
 
 // %sCustomDefaulter struct is responsible for setting default values on the custom resource of the
 // Kind %s when those are created or updated.
@@ -292,9 +293,9 @@ type %sCustomDefaulter struct {
 }
 
 `,
-		defaultingPath, f.getGroupValue(), f.Resource.Plural, f.Resource.Version,
-		strings.ToLower(f.Resource.Kind), f.Resource.Version,
-		f.AdmissionReviewVersions,
+		defaultingPath, marker.FailurePolicy(), marker.SideEffects(), f.getGroupValue(), f.Resource.Plural,
+		marker.Verbs(), f.Resource.Version, strings.ToLower(f.Resource.Kind), f.Resource.Version,
+		f.AdmissionReviewVersions, marker.Extra(),
 		f.Resource.Kind, f.Resource.Kind, f.Resource.Kind))
 
 	// Default method
@@ -321,6 +322,7 @@ func (f *WebhookUpdater) generateValidationWebhookCode() string {
 	var code strings.Builder
 
 	// Webhook marker
+	marker := AdmissionMarker{Config: f.Resource.Webhooks.ValidationAdmission}
 	validationPath := f.Resource.Webhooks.ValidationPath
 	if validationPath == "" {
 		if f.Resource.Core && f.Resource.QualifiedGroup() == coreGroup {
@@ -338,7 +340,7 @@ func (f *WebhookUpdater) generateValidationWebhookCode() string {
 `)
 	//nolint:lll
 	code.WriteString(fmt.Sprintf(
-		`// +kubebuilder:webhook:path=%s,mutating=false,failurePolicy=fail,sideEffects=None,groups=%s,resources=%s,verbs=create;update,versions=%s,name=v%s-%s.kb.io,admissionReviewVersions=%s
+		`// +kubebuilder:webhook:path=%s,mutating=false,failurePolicy=%s,sideEffects=%s,groups=%s,resources=%s,verbs=%s,versions=%s,name=v%s-%s.kb.io,admissionReviewVersions=%s%s
 
 // %sCustomValidator struct is responsible for validating the %s resource
 // when it is created, updated, or deleted.
@@ -350,8 +352,9 @@ type %sCustomValidator struct{
 }
 
 `,
-		validationPath, f.getGroupValue(), f.Resource.Plural, f.Resource.Version,
-		strings.ToLower(f.Resource.Kind), f.Resource.Version, f.AdmissionReviewVersions,
+		validationPath, marker.FailurePolicy(), marker.SideEffects(), f.getGroupValue(), f.Resource.Plural,
+		marker.Verbs(), f.Resource.Version, strings.ToLower(f.Resource.Kind), f.Resource.Version,
+		f.AdmissionReviewVersions, marker.Extra(),
 		f.Resource.Kind, f.Resource.Kind, f.Resource.Kind))
 
 	// Validation methods
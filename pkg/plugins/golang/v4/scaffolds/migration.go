@@ -0,0 +1,117 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	"errors"
+	"fmt"
+	log "log/slog"
+	"os"
+	"strings"
+
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds/internal/templates/test/e2e"
+)
+
+var _ plugins.Scaffolder = &migrationScaffolder{}
+
+// migrateStorageTarget is the Makefile target that runs the jobs of config/migration
+const migrateStorageTarget = `.PHONY: migrate-storage
+migrate-storage: kustomize ## Migrate the objects stored in the K8s cluster specified in ~/.kube/config to the storage version of their CRDs.
+	"$(KUBECTL)" delete jobs --all-namespaces -l app.kubernetes.io/component=storage-version-migration --ignore-not-found
+	"$(KUSTOMIZE)" build config/migration | "$(KUBECTL)" apply -f -
+	"$(KUBECTL)" wait jobs --all-namespaces -l app.kubernetes.io/component=storage-version-migration \
+		--for=condition=Complete --timeout=5m
+
+`
+
+// migrateStorageTargetAnchor is the line of the Makefile before which the migrate-storage target is added
+const migrateStorageTargetAnchor = "##@ Dependencies\n"
+
+type migrationScaffolder struct {
+	config config.Config
+	// resource is the storage version of the kind whose stored objects are migrated
+	resource resource.Resource
+
+	// fs is the filesystem that will be used by the scaffolder
+	fs machinery.Filesystem
+}
+
+// NewMigrationScaffolder returns a new Scaffolder that adds the Makefile target migrating the stored objects
+// to the storage version of their CRDs, and the e2e test checking that the objects of the kind are migrated.
+func NewMigrationScaffolder(cfg config.Config, res resource.Resource) plugins.Scaffolder {
+	return &migrationScaffolder{
+		config:   cfg,
+		resource: res,
+	}
+}
+
+// InjectFS implements cmdutil.Scaffolder
+func (s *migrationScaffolder) InjectFS(fs machinery.Filesystem) {
+	s.fs = fs
+}
+
+// Scaffold implements cmdutil.Scaffolder
+func (s *migrationScaffolder) Scaffold() error {
+	log.Info("Updating the project for the storage version migration...", "kind", s.resource.Kind)
+
+	scaffold := machinery.NewScaffold(s.fs,
+		machinery.WithConfig(s.config),
+		machinery.WithResource(&s.resource),
+	)
+
+	if err := scaffold.Execute(&e2e.StorageVersionTestUpdater{}); err != nil {
+		return fmt.Errorf("error updating the e2e tests: %w", err)
+	}
+
+	return s.addMigrateStorageTarget()
+}
+
+// addMigrateStorageTarget adds the migrate-storage target to the deployment targets of the Makefile.
+// Makefiles whose layout was changed by hand are left untouched, and the target needs to be added manually.
+func (s *migrationScaffolder) addMigrateStorageTarget() error {
+	content, err := afero.ReadFile(s.fs.FS, makefilePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("error reading %q: %w", makefilePath, err)
+	}
+
+	makefile := string(content)
+	if strings.Contains(makefile, "\nmigrate-storage:") {
+		return nil
+	}
+	if !strings.Contains(makefile, migrateStorageTargetAnchor) {
+		log.Warn("unable to add the migrate-storage target to the Makefile, as its layout was changed. "+
+			"Add a target applying the jobs of config/migration", "file_path", makefilePath)
+		return nil
+	}
+
+	makefile = strings.Replace(makefile, migrateStorageTargetAnchor, migrateStorageTarget+migrateStorageTargetAnchor, 1)
+	if err = afero.WriteFile(s.fs.FS, makefilePath, []byte(makefile), machinery.DefaultFilePermission); err != nil {
+		return fmt.Errorf("error writing %q: %w", makefilePath, err)
+	}
+	log.Info("added the migrate-storage target", "file", makefilePath)
+
+	return nil
+}
@@ -0,0 +1,78 @@
+//go:build integration
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	"strings"
+
+	"github.com/spf13/afero"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+)
+
+var _ = Describe("migrationScaffolder", func() {
+	Context("addMigrateStorageTarget", func() {
+		const makefile = `.PHONY: undeploy
+undeploy: kustomize ## Undeploy controller from the K8s cluster specified in ~/.kube/config.
+	"$(KUSTOMIZE)" build config/default | "$(KUBECTL)" delete --ignore-not-found=$(ignore-not-found) -f -
+
+##@ Dependencies
+
+KUBECTL ?= kubectl
+`
+
+		var s *migrationScaffolder
+
+		BeforeEach(func() {
+			s = &migrationScaffolder{fs: machinery.Filesystem{FS: afero.NewMemMapFs()}}
+		})
+
+		It("should add the migrate-storage target to the deployment targets", func() {
+			Expect(afero.WriteFile(s.fs.FS, makefilePath, []byte(makefile), 0o644)).To(Succeed())
+			Expect(s.addMigrateStorageTarget()).To(Succeed())
+
+			content, err := afero.ReadFile(s.fs.FS, makefilePath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(ContainSubstring(
+				"-f -\n\n.PHONY: migrate-storage\nmigrate-storage: kustomize ##"))
+			Expect(string(content)).To(ContainSubstring(
+				"\"$(KUSTOMIZE)\" build config/migration | \"$(KUBECTL)\" apply -f -\n"))
+			Expect(string(content)).To(ContainSubstring("--for=condition=Complete --timeout=5m\n\n##@ Dependencies\n"))
+
+			By("adding the target only once")
+			Expect(s.addMigrateStorageTarget()).To(Succeed())
+			again, err := afero.ReadFile(s.fs.FS, makefilePath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(again).To(Equal(content))
+		})
+
+		It("should leave the Makefiles whose layout was changed untouched", func() {
+			changed := strings.Replace(makefile, "##@ Dependencies\n", "", 1)
+			Expect(afero.WriteFile(s.fs.FS, makefilePath, []byte(changed), 0o644)).To(Succeed())
+			Expect(s.addMigrateStorageTarget()).To(Succeed())
+
+			content, err := afero.ReadFile(s.fs.FS, makefilePath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(Equal(changed))
+		})
+	})
+})
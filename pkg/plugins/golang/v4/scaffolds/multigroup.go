@@ -0,0 +1,879 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	log "log/slog"
+	"maps"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/afero"
+	"golang.org/x/tools/go/ast/astutil"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds/internal/templates/controllers"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds/internal/templates/webhooks"
+)
+
+const (
+	webhooksDir    = "internal/webhook"
+	controllersDir = "internal/controller"
+
+	controllerSuiteFileName = "suite_test.go"
+	webhookSuiteFileName    = "webhook_suite_test.go"
+)
+
+// webhookSuiteSetup matches the setup of a webhook in the webhook test suite, with the name of its function.
+var webhookSuiteSetup = regexp.MustCompile(`(?m)^[ \t]*err = (Setup\w+WebhookWithManager)\(mgr\)\n` +
+	`[ \t]*Expect\(err\)\.NotTo\(HaveOccurred\(\)\)\n\n?`)
+
+// packageMove is the move of the files of the resources from a package directory to another one.
+type packageMove struct {
+	from, to string
+	// files are the files of the resources moved from the package, under from
+	files []string
+	// suite is the name of the test suite file of the package, if any
+	suite string
+	// packageName is the name of the package in the new directory, if it changes
+	packageName string
+	// alias is the name the scaffolds import the new package with
+	alias string
+}
+
+// layoutMigration moves the Go packages of the project when the multigroup layout is enabled or disabled,
+// e.g. api/v1 to api/crew/v1, and rewrites the imports and the package names across the module.
+type layoutMigration struct {
+	config config.Config
+	fs     machinery.Filesystem
+
+	// moves maps the new path of the moved files to their current path. The test suite of a package split
+	// by the new layout is copied to every new package, so that several files may have the same source.
+	moves map[string]string
+	// removed holds the current path of the files removed once moved
+	removed map[string]bool
+	// packageNames maps the directories of the moved files to the name of their package, when it changes
+	packageNames map[string]string
+	// decls maps the import path of the packages whose files move to the import path of their declarations,
+	// by name. The empty name stands for all the declarations of a package moved as a whole.
+	decls map[string]map[string]string
+	// aliases maps the import path of the new packages to the name the scaffolds import them with
+	aliases map[string]string
+	// controllerNames maps the runtime names of the moved controllers to their new name
+	controllerNames map[string]string
+	// suiteDirs are the directories of the test suites whose setup may have to be updated
+	suiteDirs map[string]bool
+	// resources are the resources whose API package moves, with their new path
+	resources []resource.Resource
+}
+
+// newLayoutMigration plans the move of the Go packages from the previous layout to the layout of the
+// project configuration. It fails, without changing any file, when the files cannot be moved.
+func newLayoutMigration(cfg config.Config, fs machinery.Filesystem) (*layoutMigration, error) {
+	m := &layoutMigration{
+		config:          cfg,
+		fs:              fs,
+		moves:           make(map[string]string),
+		removed:         make(map[string]bool),
+		packageNames:    make(map[string]string),
+		decls:           make(map[string]map[string]string),
+		aliases:         make(map[string]string),
+		controllerNames: make(map[string]string),
+		suiteDirs:       make(map[string]bool),
+	}
+
+	resources, err := cfg.GetResources()
+	if err != nil {
+		return nil, fmt.Errorf("error getting resources: %w", err)
+	}
+
+	if !cfg.IsMultiGroup() {
+		var groups []string
+		for _, res := range resources {
+			if res.HasAPI() && !res.IsExternal() && !slices.Contains(groups, res.Group) {
+				groups = append(groups, res.Group)
+			}
+		}
+		if len(groups) > 1 {
+			slices.Sort(groups)
+			return nil, fmt.Errorf("unable to disable the multigroup layout, the APIs of the project belong "+
+				"to several groups (%s)", strings.Join(groups, ", "))
+		}
+	}
+
+	moves := make(map[string]map[string]*packageMove)
+	// staying holds the directories of the resources without group, which are the same in both layouts
+	staying := make(map[string]bool)
+	for _, res := range resources {
+		if res.Group == "" {
+			for _, dir := range []string{
+				layoutDir("api", res, false), layoutDir(webhooksDir, res, false), layoutDir(controllersDir, res, false),
+			} {
+				staying[dir] = true
+			}
+			continue
+		}
+		if err = m.planResource(res, moves); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, from := range slices.Sorted(maps.Keys(moves)) {
+		targets := moves[from]
+		if err = m.planPackage(from, targets, staying[from]); err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// layoutDir returns the directory of the packages of the resource under base in the single-group or
+// in the multigroup layout. The controllers are not organized by version.
+func layoutDir(base string, res resource.Resource, multiGroup bool) string {
+	dir := filepath.FromSlash(base)
+	if multiGroup && res.Group != "" {
+		dir = filepath.Join(dir, res.Group)
+	}
+	if base == controllersDir {
+		return dir
+	}
+	return filepath.Join(dir, res.Version)
+}
+
+// planResource adds the moves of the API, of the webhooks and of the controllers of the resource.
+func (m *layoutMigration) planResource(res resource.Resource, moves map[string]map[string]*packageMove) error {
+	multiGroup := m.config.IsMultiGroup()
+	repo := m.config.GetRepository()
+
+	add := func(move packageMove) {
+		if moves[move.from] == nil {
+			moves[move.from] = make(map[string]*packageMove)
+		}
+		if existing, found := moves[move.from][move.to]; found {
+			existing.files = append(existing.files, move.files...)
+			return
+		}
+		moves[move.from][move.to] = &move
+	}
+
+	previousPath := resource.APIPackagePath(repo, res.Group, res.Version, !multiGroup)
+	if res.HasAPI() && !res.IsExternal() && res.Path == previousPath {
+		add(packageMove{
+			from:  layoutDir("api", res, !multiGroup),
+			to:    layoutDir("api", res, multiGroup),
+			alias: res.ImportAlias(),
+		})
+		res.Path = resource.APIPackagePath(repo, res.Group, res.Version, multiGroup)
+		m.resources = append(m.resources, res)
+	}
+
+	if res.Webhooks != nil && !res.Webhooks.IsEmpty() {
+		from := layoutDir(webhooksDir, res, !multiGroup)
+		alias := "webhook" + res.Version
+		if multiGroup {
+			alias = "webhook" + res.ImportAlias()
+		}
+		// The webhooks of the resource, including the named ones, are in the <kind>_*webhook.go files.
+		var files []string
+		for _, pattern := range []string{"_*webhook.go", "_*webhook_test.go"} {
+			matches, err := afero.Glob(m.fs.FS, filepath.Join(from, strings.ToLower(res.Kind)+pattern))
+			if err != nil {
+				return fmt.Errorf("error listing the webhooks of %s: %w", res.Kind, err)
+			}
+			files = append(files, matches...)
+		}
+		if len(files) != 0 {
+			add(packageMove{
+				from:  from,
+				to:    layoutDir(webhooksDir, res, multiGroup),
+				files: files,
+				suite: webhookSuiteFileName,
+				alias: alias,
+			})
+		}
+	}
+
+	from := layoutDir(controllersDir, res, !multiGroup)
+	var files []string
+	for _, name := range res.GetControllerNames() {
+		for _, suffix := range []string{"_controller.go", "_controller_test.go"} {
+			file := filepath.Join(from, resource.NormalizeFileName(name)+suffix)
+			if exists, err := afero.Exists(m.fs.FS, file); err != nil {
+				return fmt.Errorf("error checking controller file %q: %w", file, err)
+			} else if exists {
+				files = append(files, file)
+			}
+		}
+		m.controllerNames[resource.GetControllerName(name, res.Kind, res.Group, !multiGroup)] =
+			resource.GetControllerName(name, res.Kind, res.Group, multiGroup)
+	}
+	if len(files) != 0 {
+		move := packageMove{
+			from:        from,
+			to:          layoutDir(controllersDir, res, multiGroup),
+			files:       files,
+			suite:       controllerSuiteFileName,
+			packageName: "controller",
+		}
+		if multiGroup {
+			move.packageName = res.PackageName()
+			move.alias = res.PackageName() + "controller"
+		}
+		add(move)
+	}
+
+	return nil
+}
+
+// planPackage plans the moves of the files of the package in from. The package moves as a whole when all
+// its resources move to the same package, with the files that do not belong to any of them, e.g. helpers.
+// Otherwise, the package is split and its test suite is copied to the new packages.
+func (m *layoutMigration) planPackage(from string, targets map[string]*packageMove, staying bool) error {
+	repo := m.config.GetRepository()
+	fromImport := path.Join(repo, filepath.ToSlash(from))
+	m.decls[fromImport] = make(map[string]string)
+
+	if len(targets) == 1 && !staying {
+		move := slices.Collect(maps.Values(targets))[0]
+		entries, err := afero.ReadDir(m.fs.FS, from)
+		if err != nil {
+			return fmt.Errorf("error listing %q: %w", from, err)
+		}
+		for _, entry := range entries {
+			if entry.IsDir() {
+				continue
+			}
+			file := filepath.Join(from, entry.Name())
+			if entry.Name() == move.suite {
+				// The package already has a test suite when it is merged with another one.
+				if err = m.moveSuite(file, move.to); err != nil {
+					return err
+				}
+				m.removed[file] = true
+				continue
+			}
+			if err = m.move(file, filepath.Join(move.to, entry.Name())); err != nil {
+				return err
+			}
+		}
+		m.decls[fromImport][""] = path.Join(repo, filepath.ToSlash(move.to))
+		m.planTarget(move)
+		return nil
+	}
+
+	moved := make(map[string]bool)
+	for _, to := range slices.Sorted(maps.Keys(targets)) {
+		move := targets[to]
+		toImport := path.Join(repo, filepath.ToSlash(to))
+		for _, file := range move.files {
+			if err := m.move(file, filepath.Join(to, filepath.Base(file))); err != nil {
+				return err
+			}
+			moved[file] = true
+
+			names, err := m.declNames(file)
+			if err != nil {
+				return err
+			}
+			for _, name := range names {
+				m.decls[fromImport][name] = toImport
+			}
+		}
+		if move.suite != "" {
+			if err := m.moveSuite(filepath.Join(from, move.suite), to); err != nil {
+				return err
+			}
+		}
+		m.planTarget(move)
+	}
+
+	// The test suite is removed with the last files of the package.
+	suite := ""
+	for _, move := range targets {
+		suite = move.suite
+	}
+	goFiles, err := afero.Glob(m.fs.FS, filepath.Join(from, "*.go"))
+	if err != nil {
+		return fmt.Errorf("error listing %q: %w", from, err)
+	}
+	remaining := slices.DeleteFunc(goFiles, func(file string) bool {
+		return moved[file] || filepath.Base(file) == suite
+	})
+	if len(remaining) == 0 {
+		if suite != "" {
+			m.removed[filepath.Join(from, suite)] = true
+		}
+	} else {
+		m.suiteDirs[from] = true
+		log.Warn("Some files could not be moved to the new layout, as the resources of their package "+
+			"are moved to different packages", "files", remaining)
+	}
+
+	return nil
+}
+
+// planTarget records the package name, the import alias and the test suite of the package moved to.
+func (m *layoutMigration) planTarget(move *packageMove) {
+	toImport := path.Join(m.config.GetRepository(), filepath.ToSlash(move.to))
+	if move.packageName != "" {
+		m.packageNames[move.to] = move.packageName
+	}
+	if move.alias != "" {
+		m.aliases[toImport] = move.alias
+	}
+	if move.suite != "" {
+		m.suiteDirs[move.to] = true
+	}
+}
+
+// move plans the move of the file, which fails when the new path is already used.
+func (m *layoutMigration) move(from, to string) error {
+	if source, found := m.moves[to]; found {
+		return fmt.Errorf("unable to move %q and %q to %q", source, from, to)
+	}
+	if exists, err := afero.Exists(m.fs.FS, to); err != nil {
+		return fmt.Errorf("error checking %q: %w", to, err)
+	} else if exists {
+		return fmt.Errorf("unable to move %q, %q already exists", from, to)
+	}
+
+	m.moves[to] = from
+	m.removed[from] = true
+	return nil
+}
+
+// moveSuite plans the copy of the test suite to the directory, unless the directory already has one.
+func (m *layoutMigration) moveSuite(suite, dir string) error {
+	to := filepath.Join(dir, filepath.Base(suite))
+	if _, found := m.moves[to]; found {
+		return nil
+	}
+	if exists, err := afero.Exists(m.fs.FS, to); err != nil {
+		return fmt.Errorf("error checking %q: %w", to, err)
+	} else if exists {
+		return nil
+	}
+	if exists, err := afero.Exists(m.fs.FS, suite); err != nil {
+		return fmt.Errorf("error checking %q: %w", suite, err)
+	} else if exists {
+		m.moves[to] = suite
+	}
+	return nil
+}
+
+// declNames returns the names of the top-level declarations of the Go file, which other packages may use.
+func (m *layoutMigration) declNames(file string) ([]string, error) {
+	if !strings.HasSuffix(file, ".go") || strings.HasSuffix(file, "_test.go") {
+		return nil, nil
+	}
+
+	content, err := afero.ReadFile(m.fs.FS, file)
+	if err != nil {
+		return nil, fmt.Errorf("error reading %q: %w", file, err)
+	}
+	parsed, err := parser.ParseFile(token.NewFileSet(), file, content, parser.SkipObjectResolution)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing %q: %w", file, err)
+	}
+
+	var names []string
+	for _, decl := range parsed.Decls {
+		switch d := decl.(type) {
+		case *ast.FuncDecl:
+			if d.Recv == nil {
+				names = append(names, d.Name.Name)
+			}
+		case *ast.GenDecl:
+			for _, spec := range d.Specs {
+				switch s := spec.(type) {
+				case *ast.TypeSpec:
+					names = append(names, s.Name.Name)
+				case *ast.ValueSpec:
+					for _, name := range s.Names {
+						names = append(names, name.Name)
+					}
+				}
+			}
+		}
+	}
+	return names, nil
+}
+
+// apply moves the files, rewrites the Go files of the module that use the moved packages, updates the
+// test suites and the path of the moved APIs in the project configuration.
+func (m *layoutMigration) apply() error {
+	for _, to := range slices.Sorted(maps.Keys(m.moves)) {
+		from := m.moves[to]
+		content, err := afero.ReadFile(m.fs.FS, from)
+		if err != nil {
+			return fmt.Errorf("error reading %q: %w", from, err)
+		}
+		if strings.HasSuffix(from, ".go") {
+			if content, err = m.rewriteGoFile(from, to, content); err != nil {
+				return err
+			}
+		}
+		if err = m.fs.FS.MkdirAll(filepath.Dir(to), 0o755); err != nil {
+			return fmt.Errorf("error creating %q: %w", filepath.Dir(to), err)
+		}
+		if err = afero.WriteFile(m.fs.FS, to, content, machinery.DefaultFilePermission); err != nil {
+			return fmt.Errorf("error writing %q: %w", to, err)
+		}
+	}
+
+	for _, file := range slices.Sorted(maps.Keys(m.removed)) {
+		if err := m.fs.FS.Remove(file); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("error removing %q: %w", file, err)
+		}
+		m.removeEmptyDirs(filepath.Dir(file))
+	}
+
+	if err := m.rewriteModule(); err != nil {
+		return err
+	}
+
+	for _, res := range m.resources {
+		if err := m.config.ReplaceResource(res); err != nil {
+			return fmt.Errorf("error updating the path of %s: %w", res.Kind, err)
+		}
+	}
+
+	return m.updateSuites()
+}
+
+// removeEmptyDirs removes the directory and its parents as long as they are empty.
+func (m *layoutMigration) removeEmptyDirs(dir string) {
+	for dir != "." && dir != string(filepath.Separator) {
+		if empty, err := afero.IsEmpty(m.fs.FS, dir); err != nil || !empty {
+			return
+		}
+		if err := m.fs.FS.Remove(dir); err != nil {
+			return
+		}
+		dir = filepath.Dir(dir)
+	}
+}
+
+// rewriteModule rewrites in place the Go files of the module that use the moved packages.
+func (m *layoutMigration) rewriteModule() error {
+	moved := make(map[string]bool, len(m.moves))
+	for to := range m.moves {
+		moved[to] = true
+	}
+
+	return afero.Walk(m.fs.FS, ".", func(file string, info fs.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			name := info.Name()
+			if file != "." && (strings.HasPrefix(name, ".") || name == "bin" || name == "vendor" || name == "testdata") {
+				return filepath.SkipDir
+			}
+			// Nested modules do not import the packages of the project with the same path.
+			if exists, _ := afero.Exists(m.fs.FS, filepath.Join(file, "go.mod")); exists && file != "." {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(file, ".go") || moved[file] {
+			return nil
+		}
+
+		content, err := afero.ReadFile(m.fs.FS, file)
+		if err != nil {
+			return fmt.Errorf("error reading %q: %w", file, err)
+		}
+		if !m.usesMovedPackages(content) {
+			return nil
+		}
+		rewritten, err := m.rewriteGoFile(file, file, content)
+		if err != nil {
+			return err
+		}
+		if bytes.Equal(rewritten, content) {
+			return nil
+		}
+		if err = afero.WriteFile(m.fs.FS, file, rewritten, machinery.DefaultFilePermission); err != nil {
+			return fmt.Errorf("error writing %q: %w", file, err)
+		}
+		return nil
+	})
+}
+
+// usesMovedPackages returns true if the Go source imports a package whose files move.
+func (m *layoutMigration) usesMovedPackages(content []byte) bool {
+	for importPath := range m.decls {
+		if bytes.Contains(content, []byte(strconv.Quote(importPath))) {
+			return true
+		}
+	}
+	return false
+}
+
+// rewriteGoFile returns the Go source moved from a path to another one, or rewritten in place when both
+// paths are the same. The references to the moved declarations use their new package, the package name is
+// updated, and so are the paths relative to the directory of the file and the names of the controllers.
+func (m *layoutMigration) rewriteGoFile(from, to string, content []byte) ([]byte, error) {
+	source := string(content)
+	for oldName, newName := range m.controllerNames {
+		source = strings.ReplaceAll(source, fmt.Sprintf("Named(%q)", oldName), fmt.Sprintf("Named(%q)", newName))
+		source = strings.ReplaceAll(source,
+			fmt.Sprintf(`"controller", %q)`, oldName), fmt.Sprintf(`"controller", %q)`, newName))
+	}
+	changed := source != string(content)
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, from, source, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing %q: %w", from, err)
+	}
+
+	if from != to {
+		if name, found := m.packageNames[filepath.Dir(to)]; found {
+			if strings.HasSuffix(file.Name.Name, "_test") {
+				name += "_test"
+			}
+			changed = changed || file.Name.Name != name
+			file.Name.Name = name
+		}
+		changed = moveRelativePaths(file, dirDepth(from), dirDepth(to)) || changed
+	}
+	rewritten, added := m.rewriteImports(fset, file,
+		path.Join(m.config.GetRepository(), filepath.ToSlash(filepath.Dir(to))))
+	if !changed && !rewritten {
+		return content, nil
+	}
+
+	var buf bytes.Buffer
+	if err = format.Node(&buf, fset, file); err != nil {
+		return nil, fmt.Errorf("error printing %q: %w", to, err)
+	}
+	return formatGoFile(to, addImports(buf.String(), added))
+}
+
+// rewriteImports updates the references to the moved declarations, which are imported from their new
+// package, and the imports of the file. The imports no longer used are replaced in place by the first of
+// their new packages, and the imports to add after them are returned, by import path, as the printer does
+// not keep the comments in place when adding imports. The references to the package of the file itself,
+// found in the importPath directory, are no longer qualified.
+func (m *layoutMigration) rewriteImports(fset *token.FileSet, file *ast.File,
+	importPath string,
+) (bool, map[string][]string) {
+	type oldImport struct {
+		spec    *ast.ImportSpec
+		path    string
+		used    bool
+		targets []string
+	}
+	var imports []*oldImport
+	byName := make(map[string]*oldImport)
+	for _, spec := range file.Imports {
+		importedPath, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			continue
+		}
+		if _, found := m.decls[importedPath]; !found {
+			continue
+		}
+		name := path.Base(importedPath)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		if name != "_" && name != "." {
+			byName[name] = &oldImport{spec: spec, path: importedPath}
+			imports = append(imports, byName[name])
+		}
+	}
+	if len(imports) == 0 {
+		return false, nil
+	}
+
+	names := make(map[string]string)
+	astutil.Apply(file, func(c *astutil.Cursor) bool {
+		sel, ok := c.Node().(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		x, ok := sel.X.(*ast.Ident)
+		if !ok || x.Obj != nil {
+			return true
+		}
+		imp, found := byName[x.Name]
+		if !found {
+			return true
+		}
+
+		target, found := m.decls[imp.path][sel.Sel.Name]
+		if !found {
+			target, found = m.decls[imp.path][""]
+		}
+		if !found {
+			imp.used = true
+			return true
+		}
+		if target == importPath {
+			c.Replace(sel.Sel)
+			return false
+		}
+		if _, found = names[target]; !found {
+			names[target] = m.importName(file, target)
+		}
+		if !slices.Contains(imp.targets, target) {
+			imp.targets = append(imp.targets, target)
+		}
+		x.Name = names[target]
+		return true
+	}, nil)
+
+	// The packages already imported by the file are not imported again.
+	imported := make(map[string]bool)
+	for _, spec := range file.Imports {
+		if importedPath, err := strconv.Unquote(spec.Path.Value); err == nil {
+			imported[importedPath] = true
+		}
+	}
+
+	changed := false
+	added := make(map[string][]string)
+	for _, imp := range imports {
+		if len(imp.targets) == 0 && imp.used {
+			continue
+		}
+		changed = true
+
+		anchor := imp.path
+		if !imp.used {
+			// The import is replaced by its first new package not imported yet, or removed otherwise.
+			i := slices.IndexFunc(imp.targets, func(target string) bool { return !imported[target] })
+			if i == -1 {
+				name := ""
+				if imp.spec.Name != nil {
+					name = imp.spec.Name.Name
+				}
+				astutil.DeleteNamedImport(fset, file, name, imp.path)
+				continue
+			}
+			anchor = imp.targets[i]
+			imp.spec.Path.Value = strconv.Quote(anchor)
+			imp.spec.Name = nil
+			if name := names[anchor]; name != path.Base(anchor) {
+				imp.spec.Name = &ast.Ident{NamePos: imp.spec.Path.Pos(), Name: name}
+			}
+			imported[anchor] = true
+		}
+		for _, target := range imp.targets {
+			if imported[target] {
+				continue
+			}
+			spec := strconv.Quote(target)
+			if name := names[target]; name != path.Base(target) {
+				spec = name + " " + spec
+			}
+			added[anchor] = append(added[anchor], spec)
+			imported[target] = true
+		}
+	}
+
+	return changed, added
+}
+
+// addImports adds the imports after the import of their anchor path in the Go source.
+func addImports(source string, added map[string][]string) string {
+	for _, anchor := range slices.Sorted(maps.Keys(added)) {
+		quoted := strconv.Quote(anchor) + "\n"
+		i := strings.Index(source, quoted)
+		if i == -1 {
+			continue
+		}
+		i += len(quoted)
+		source = source[:i] + strings.Join(added[anchor], "\n") + "\n" + source[i:]
+	}
+	return source
+}
+
+// importName returns the name of the package in the file, where it may already be imported, or otherwise
+// the name the scaffolds import it with.
+func (m *layoutMigration) importName(file *ast.File, importPath string) string {
+	for _, spec := range file.Imports {
+		if spec.Path.Value != strconv.Quote(importPath) {
+			continue
+		}
+		if spec.Name != nil && spec.Name.Name != "_" && spec.Name.Name != "." {
+			return spec.Name.Name
+		}
+		if spec.Name == nil {
+			return path.Base(importPath)
+		}
+	}
+	if alias, found := m.aliases[importPath]; found {
+		return alias
+	}
+	return path.Base(importPath)
+}
+
+// dirDepth returns the number of directories between the directory of the file and the project root.
+func dirDepth(file string) int {
+	return len(strings.Split(filepath.ToSlash(filepath.Dir(file)), "/"))
+}
+
+// moveRelativePaths updates the `filepath.Join("..", ...)` calls reaching the project root from a
+// directory of the depth from, e.g. to find the CRDs in the test suites, to reach it from the depth to.
+func moveRelativePaths(file *ast.File, from, to int) bool {
+	changed := false
+	ast.Inspect(file, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		fun, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || fun.Sel.Name != "Join" {
+			return true
+		}
+		if pkg, ok := fun.X.(*ast.Ident); !ok || pkg.Name != "filepath" {
+			return true
+		}
+
+		parents := 0
+		for _, arg := range call.Args {
+			if lit, ok := arg.(*ast.BasicLit); !ok || lit.Value != `".."` {
+				break
+			}
+			parents++
+		}
+		if parents != from || from == to {
+			return true
+		}
+
+		args := make([]ast.Expr, 0, len(call.Args)-from+to)
+		for range to {
+			args = append(args, &ast.BasicLit{ValuePos: call.Args[0].Pos(), Kind: token.STRING, Value: `".."`})
+		}
+		call.Args = append(args, call.Args[from:]...)
+		changed = true
+		return true
+	})
+	return changed
+}
+
+// updateSuites updates the setup of the test suites of the moved packages: the scheme of the APIs of the
+// resources of the package is registered and, in the webhook test suites, only the webhooks of the
+// package are set up.
+func (m *layoutMigration) updateSuites() error {
+	for _, dir := range slices.Sorted(maps.Keys(m.suiteDirs)) {
+		suite := filepath.Join(dir, webhookSuiteFileName)
+		if exists, err := afero.Exists(m.fs.FS, suite); err != nil || !exists {
+			continue
+		}
+		setups, err := m.declNamesIn(dir)
+		if err != nil {
+			return err
+		}
+		if err = (&editAPIScaffolder{fs: m.fs}).editGoFile(suite, func(content string) (string, error) {
+			return webhookSuiteSetup.ReplaceAllStringFunc(content, func(setup string) string {
+				if slices.Contains(setups, webhookSuiteSetup.FindStringSubmatch(setup)[1]) {
+					return setup
+				}
+				return ""
+			}), nil
+		}); err != nil {
+			return fmt.Errorf("error updating the webhook test suite %q: %w", suite, err)
+		}
+	}
+
+	resources, err := m.config.GetResources()
+	if err != nil {
+		return fmt.Errorf("error getting resources: %w", err)
+	}
+	multiGroup := m.config.IsMultiGroup()
+	for _, res := range resources {
+		var builders []machinery.Builder
+
+		dir := layoutDir(controllersDir, res, multiGroup)
+		if m.suiteDirs[dir] && len(res.GetControllerNames()) != 0 && m.hasFile(dir, controllerSuiteFileName) {
+			builders = append(builders, &controllers.SuiteTest{})
+		}
+
+		dir = layoutDir(webhooksDir, res, multiGroup)
+		if m.suiteDirs[dir] && m.hasFile(dir, webhookSuiteFileName) {
+			if m.hasFile(dir, strings.ToLower(res.Kind)+"_webhook.go") {
+				builders = append(builders, &webhooks.WebhookSuite{})
+			}
+			if res.Webhooks != nil {
+				for _, webhook := range res.Webhooks.Named {
+					if m.hasFile(dir, fmt.Sprintf("%s_%s_webhook.go", strings.ToLower(res.Kind),
+						resource.NormalizeFileName(webhook.Name))) {
+						builders = append(builders, &webhooks.WebhookSuite{WebhookName: webhook.Name})
+					}
+				}
+			}
+		}
+
+		if len(builders) == 0 {
+			continue
+		}
+		scaffold, err := (&editAPIScaffolder{config: m.config, resource: res, fs: m.fs}).newScaffold()
+		if err != nil {
+			return err
+		}
+		if err = scaffold.Execute(builders...); err != nil {
+			return fmt.Errorf("error updating the test suites of %s: %w", res.Kind, err)
+		}
+	}
+
+	return nil
+}
+
+// declNamesIn returns the names of the top-level declarations of the Go files of the directory.
+func (m *layoutMigration) declNamesIn(dir string) ([]string, error) {
+	files, err := afero.Glob(m.fs.FS, filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, fmt.Errorf("error listing %q: %w", dir, err)
+	}
+
+	var names []string
+	for _, file := range files {
+		fileNames, err := m.declNames(file)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, fileNames...)
+	}
+	return names, nil
+}
+
+// hasFile returns true if the directory has a file with the name.
+func (m *layoutMigration) hasFile(dir, name string) bool {
+	exists, err := afero.Exists(m.fs.FS, filepath.Join(dir, name))
+	return err == nil && exists
+}
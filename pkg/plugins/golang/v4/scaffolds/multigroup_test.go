@@ -0,0 +1,161 @@
+//go:build integration
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	"github.com/spf13/afero"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	cfgv3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+)
+
+var _ = Describe("layoutMigration", func() {
+	const (
+		typesV1 = `package v1
+
+type Captain struct{}
+`
+		controller = `package controller
+
+import (
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	crewv1 "test.io/project/api/v1"
+)
+
+type CaptainReconciler struct{}
+
+func (r *CaptainReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&crewv1.Captain{}).
+		Named("captain").
+		Complete(r)
+}
+`
+		suite = `package controller
+
+import (
+	"path/filepath"
+
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+)
+
+var testEnv = &envtest.Environment{
+	CRDDirectoryPaths: []string{filepath.Join("..", "..", "config", "crd", "bases")},
+}
+`
+		main = `package main
+
+import (
+	crewv1 "test.io/project/api/v1"
+	"test.io/project/internal/controller"
+	// +kubebuilder:scaffold:imports
+)
+
+var _ = crewv1.Captain{}
+
+var _ = controller.CaptainReconciler{}
+`
+	)
+
+	var (
+		cfg config.Config
+		fs  machinery.Filesystem
+	)
+
+	addResource := func(group string) {
+		Expect(cfg.AddResource(resource.Resource{
+			GVK:        resource.GVK{Group: group, Domain: "test.io", Version: "v1", Kind: "Captain"},
+			Plural:     "captains",
+			Path:       "test.io/project/api/v1",
+			API:        &resource.API{CRDVersion: "v1", Namespaced: true},
+			Controller: true,
+		})).To(Succeed())
+	}
+
+	readFile := func(path string) string {
+		content, err := afero.ReadFile(fs.FS, path)
+		Expect(err).NotTo(HaveOccurred())
+		return string(content)
+	}
+
+	BeforeEach(func() {
+		cfg = cfgv3.New()
+		Expect(cfg.SetRepository("test.io/project")).To(Succeed())
+
+		fs = machinery.Filesystem{FS: afero.NewMemMapFs()}
+		for path, content := range map[string]string{
+			"api/v1/captain_types.go":                   typesV1,
+			"internal/controller/captain_controller.go": controller,
+			"internal/controller/suite_test.go":         suite,
+			"cmd/main.go":                               main,
+		} {
+			Expect(afero.WriteFile(fs.FS, path, []byte(content), 0o644)).To(Succeed())
+		}
+	})
+
+	It("should move the packages to the multigroup layout", func() {
+		addResource("crew")
+		Expect(cfg.SetMultiGroup()).To(Succeed())
+
+		migration, err := newLayoutMigration(cfg, fs)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(migration.apply()).To(Succeed())
+
+		for _, path := range []string{"api/v1", "internal/controller/captain_controller.go"} {
+			exists, existsErr := afero.Exists(fs.FS, path)
+			Expect(existsErr).NotTo(HaveOccurred())
+			Expect(exists).To(BeFalse(), path)
+		}
+		Expect(readFile("api/crew/v1/captain_types.go")).To(Equal(typesV1))
+
+		moved := readFile("internal/controller/crew/captain_controller.go")
+		Expect(moved).To(HavePrefix("package crew\n"))
+		Expect(moved).To(ContainSubstring(`crewv1 "test.io/project/api/crew/v1"`))
+		Expect(moved).To(ContainSubstring(`Named("crew-captain")`))
+
+		movedSuite := readFile("internal/controller/crew/suite_test.go")
+		Expect(movedSuite).To(HavePrefix("package crew\n"))
+		Expect(movedSuite).To(ContainSubstring(`filepath.Join("..", "..", "..", "config", "crd", "bases")`))
+
+		rewritten := readFile("cmd/main.go")
+		Expect(rewritten).To(ContainSubstring(
+			"\tcrewv1 \"test.io/project/api/crew/v1\"\n" +
+				"\tcrewcontroller \"test.io/project/internal/controller/crew\"\n" +
+				"\t// +kubebuilder:scaffold:imports\n"))
+		Expect(rewritten).To(ContainSubstring("var _ = crewcontroller.CaptainReconciler{}"))
+
+		res, err := cfg.GetResource(resource.GVK{Group: "crew", Domain: "test.io", Version: "v1", Kind: "Captain"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.Path).To(Equal("test.io/project/api/crew/v1"))
+	})
+
+	It("should fail to disable the multigroup layout when the APIs belong to several groups", func() {
+		addResource("crew")
+		addResource("ship")
+
+		_, err := newLayoutMigration(cfg, fs)
+		Expect(err).To(MatchError(ContainSubstring("belong to several groups (crew, ship)")))
+	})
+})
@@ -0,0 +1,102 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	"errors"
+	"fmt"
+	log "log/slog"
+
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds/internal/templates/hack"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds/internal/templates/policies"
+)
+
+var _ plugins.Scaffolder = &policyScaffolder{}
+
+type policyScaffolder struct {
+	config     config.Config
+	resource   resource.Resource
+	policyType resource.PolicyType
+
+	// fs is the filesystem that will be used by the scaffolder
+	fs machinery.Filesystem
+
+	// force indicates whether to scaffold the policy test even if it exists or not
+	force bool
+}
+
+// NewPolicyScaffolder returns a new Scaffolder for admission policy creation operations
+func NewPolicyScaffolder(cfg config.Config, res resource.Resource, policyType resource.PolicyType,
+	force bool,
+) plugins.Scaffolder {
+	return &policyScaffolder{
+		config:     cfg,
+		resource:   res,
+		policyType: policyType,
+		force:      force,
+	}
+}
+
+// InjectFS implements cmdutil.Scaffolder
+func (s *policyScaffolder) InjectFS(fs machinery.Filesystem) {
+	s.fs = fs
+}
+
+// Scaffold implements cmdutil.Scaffolder
+func (s *policyScaffolder) Scaffold() error {
+	log.Info("Writing policy tests for you to edit...")
+
+	// Load the boilerplate
+	boilerplate, err := afero.ReadFile(s.fs.FS, hack.DefaultBoilerplatePath)
+	if err != nil {
+		if !errors.Is(err, afero.ErrFileNotFound) {
+			return fmt.Errorf("error scaffolding policy: failed to load boilerplate: %w", err)
+		}
+		log.Warn("unable to find boilerplate file", "file_path", hack.DefaultBoilerplatePath)
+		boilerplate = []byte("")
+	}
+
+	// Initialize the machinery.Scaffold that will write the files to disk
+	scaffold := machinery.NewScaffold(s.fs,
+		machinery.WithConfig(s.config),
+		machinery.WithBoilerplate(string(boilerplate)),
+		machinery.WithResource(&s.resource),
+	)
+
+	if err = s.config.UpdateResource(s.resource); err != nil {
+		return fmt.Errorf("error updating resource: %w", err)
+	}
+
+	if err = scaffold.Execute(
+		&policies.SuiteTest{},
+		&policies.PolicyTest{
+			Type:         s.policyType,
+			ManifestName: policies.ManifestName(s.resource, s.policyType),
+			Force:        s.force,
+		},
+	); err != nil {
+		return fmt.Errorf("error scaffolding policy tests: %w", err)
+	}
+
+	return nil
+}
@@ -133,7 +133,14 @@ func (s *webhookScaffolder) Scaffold() error {
 	}
 
 	// Scaffold or update webhook test file (for all webhook types)
-	if err = s.scaffoldWebhookTestFile(scaffold, webhookTestFileExists); err != nil {
+	if doDefaulting || doValidation || doConversion {
+		if err = s.scaffoldWebhookTestFile(scaffold, webhookTestFileExists); err != nil {
+			return err
+		}
+	}
+
+	// Scaffold the named webhooks, each one in its own file
+	if err = s.scaffoldNamedWebhooks(scaffold); err != nil {
 		return err
 	}
 
@@ -141,7 +148,7 @@ func (s *webhookScaffolder) Scaffold() error {
 	// WireWebhook controls webhook service readiness checks (for defaulting/validation)
 	// But conversion webhooks still need CA injection tests (handled inside updater)
 	if err = scaffold.Execute(
-		&e2e.WebhookTestUpdater{WireWebhook: doDefaulting || doValidation},
+		&e2e.WebhookTestUpdater{WireWebhook: doDefaulting || doValidation || s.resource.HasNamedWebhooks()},
 	); err != nil {
 		return fmt.Errorf("error updating e2e tests: %w", err)
 	}
@@ -158,13 +165,21 @@ func (s *webhookScaffolder) Scaffold() error {
 
 		for _, spoke := range s.resource.Webhooks.Spoke {
 			log.Info("Scaffolding for spoke version", "version", spoke)
-			if err = scaffold.Execute(&api.Spoke{Force: s.force, SpokeVersion: spoke}); err != nil {
+			if err = scaffold.Execute(
+				&api.Spoke{Force: s.force, SpokeVersion: spoke},
+				&api.ConversionTest{Force: s.force, SpokeVersion: spoke},
+			); err != nil {
 				return fmt.Errorf("failed to scaffold spoke %s: %w", spoke, err)
 			}
 		}
 
+		if err = scaffoldConversions(s.config, s.fs, scaffold, s.resource.Group, s.resource.Webhooks.Spoke); err != nil {
+			log.Warn("unable to generate the conversion functions, run \"kubebuilder generate conversion\" "+
+				"once the API packages can be loaded", "error", err)
+		}
+
 		log.Info(`Webhook server has been set up for you.
-You need to implement the conversion.Hub and conversion.Convertible interfaces for your CRD types.`)
+Implement the conversion of the fields flagged, if any, in zz_generated.conversion.go of the spoke versions.`)
 	}
 
 	// Scaffold webhook suite test for all webhook types
@@ -229,6 +244,43 @@ func (s *webhookScaffolder) getWebhookTestFilePath() string {
 	return path
 }
 
+// getNamedWebhookFilePath returns the path to the file of a named webhook
+func (s *webhookScaffolder) getNamedWebhookFilePath(name string) string {
+	fileName := fmt.Sprintf("%s_%s_webhook.go", strings.ToLower(s.resource.Kind), resource.NormalizeFileName(name))
+	if s.config.IsMultiGroup() && s.resource.Group != "" {
+		return fmt.Sprintf("internal/webhook/%s/%s/%s", s.resource.Group, s.resource.Version, fileName)
+	}
+	return fmt.Sprintf("internal/webhook/%s/%s", s.resource.Version, fileName)
+}
+
+// scaffoldNamedWebhooks creates the files of the named webhooks that were not scaffolded yet
+// and wires them in main.go and in the webhook test suite
+func (s *webhookScaffolder) scaffoldNamedWebhooks(scaffold *machinery.Scaffold) error {
+	if !s.resource.HasNamedWebhooks() {
+		return nil
+	}
+	if s.isLegacy {
+		return errors.New("named webhooks cannot be scaffolded in the legacy path")
+	}
+
+	for _, webhook := range s.resource.Webhooks.Named {
+		if _, statErr := s.fs.FS.Stat(s.getNamedWebhookFilePath(webhook.Name)); statErr == nil && !s.force {
+			continue
+		}
+
+		if err := scaffold.Execute(
+			&webhooks.NamedWebhook{Webhook: webhook, Force: s.force},
+			&webhooks.NamedWebhookTest{Webhook: webhook, Force: s.force},
+			&cmd.MainUpdater{WireWebhook: true, WebhookName: webhook.Name},
+			&webhooks.WebhookSuite{WebhookName: webhook.Name},
+		); err != nil {
+			return fmt.Errorf("error scaffolding webhook %q: %w", webhook.Name, err)
+		}
+	}
+
+	return nil
+}
+
 // scaffoldWebhookFile creates or updates the webhook implementation file
 func (s *webhookScaffolder) scaffoldWebhookFile(scaffold *machinery.Scaffold, fileExists bool) error {
 	if !fileExists || s.force {
@@ -20,6 +20,7 @@ package scaffolds
 
 import (
 	"os"
+	"os/exec"
 	"path/filepath"
 	"strings"
 
@@ -547,6 +548,12 @@ var _ = Describe("Webhook Incremental Scaffolding", func() {
 			_, err = os.Stat(spokeFile)
 			Expect(err).NotTo(HaveOccurred(), "Spoke file should exist")
 
+			By("verifying the round-trip tests of the spoke were created")
+			roundTripContent, err := os.ReadFile(filepath.Join(kbc.Dir, "api/v2/cronjob_conversion_test.go"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(roundTripContent)).To(ContainSubstring("func FuzzCronJobSpokeHubSpoke(f *testing.F)"))
+			Expect(string(roundTripContent)).To(ContainSubstring("func FuzzCronJobHubSpokeHub(f *testing.F)"))
+
 			By("verifying storage version marker was added")
 			typesFile := filepath.Join(kbc.Dir, "api/v1/cronjob_types.go")
 			typesContent, err := os.ReadFile(typesFile)
@@ -554,5 +561,72 @@ var _ = Describe("Webhook Incremental Scaffolding", func() {
 
 			Expect(string(typesContent)).To(ContainSubstring("// +kubebuilder:storageversion"))
 		})
+
+		It("should generate the conversion functions and regenerate them after the types change", func() {
+			By("creating the hub and spoke APIs with a conversion webhook")
+			for _, version := range []string{"v1", "v2"} {
+				err := kbc.CreateAPI(
+					"--group", "batch",
+					"--version", version,
+					"--kind", "CronJob",
+					"--resource", "--controller=false",
+					"--make=false",
+				)
+				Expect(err).NotTo(HaveOccurred())
+			}
+			err := kbc.CreateWebhook(
+				"--group", "batch",
+				"--version", "v1",
+				"--kind", "CronJob",
+				"--conversion",
+				"--spoke", "v2",
+				"--make=false",
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("verifying the identical fields are converted")
+			generatedFile := filepath.Join(kbc.Dir, "api/v2/zz_generated.conversion.go")
+			content, err := os.ReadFile(generatedFile)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(ContainSubstring(
+				"func Convert_v2_CronJob_To_v1_CronJob(in *CronJob, out *batchv1.CronJob) error {"))
+			Expect(string(content)).To(ContainSubstring(
+				"func Convert_v1_CronJobSpec_To_v2_CronJobSpec(in *batchv1.CronJobSpec, out *CronJobSpec) error {"))
+			Expect(string(content)).To(ContainSubstring("out.Foo = in.Foo"))
+			Expect(string(content)).NotTo(ContainSubstring("WARNING"))
+
+			By("verifying the spoke calls the generated functions")
+			content, err = os.ReadFile(filepath.Join(kbc.Dir, "api/v2/cronjob_conversion.go"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(ContainSubstring("Convert_v2_CronJob_To_v1_CronJob(src, dst)"))
+			Expect(string(content)).To(ContainSubstring("return restoreCronJob(src, dst)"))
+			Expect(string(content)).To(ContainSubstring("Convert_v1_CronJob_To_v2_CronJob(src, dst)"))
+			Expect(string(content)).To(ContainSubstring("return stashCronJob(src, dst)"))
+
+			By("adding a field to the hub version")
+			typesFile := filepath.Join(kbc.Dir, "api/v1/cronjob_types.go")
+			content, err = os.ReadFile(typesFile)
+			Expect(err).NotTo(HaveOccurred())
+			foo := "Foo *string `json:\"foo,omitempty\"`"
+			Expect(string(content)).To(ContainSubstring(foo))
+			content = []byte(strings.Replace(string(content), foo,
+				foo+"\n\tSchedule string `json:\"schedule,omitempty\"`", 1))
+			Expect(os.WriteFile(typesFile, content, 0o644)).To(Succeed())
+
+			By("regenerating the conversion functions")
+			cmd := exec.Command(kbc.BinaryName, "generate", "conversion")
+			_, err = kbc.Run(cmd)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("verifying the new field is flagged and stashed")
+			content, err = os.ReadFile(generatedFile)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(ContainSubstring(
+				"// WARNING: out.Schedule requires manual conversion: it does not exist in CronJobSpec"))
+			Expect(string(content)).To(ContainSubstring(
+				"// TODO(user): Implement Convert_v2_CronJobSpec_To_v1_CronJobSpec"))
+			Expect(string(content)).NotTo(ContainSubstring("func Convert_v2_CronJobSpec_To_v1_CronJobSpec("))
+			Expect(string(content)).To(ContainSubstring("dst.Spec.Schedule = stashed.Spec.Schedule"))
+		})
 	})
 })
@@ -53,14 +53,22 @@ type createWebhookSubcommand struct {
 
 	// runMake indicates whether to run make or not after scaffolding APIs
 	runMake bool
+
+	// admission holds the flags that configure the admission of the webhooks
+	admission admissionFlags
 }
 
 func (p *createWebhookSubcommand) UpdateMetadata(cliMeta plugin.CLIMetadata, subcmdMeta *plugin.SubcommandMetadata) {
 	p.commandName = cliMeta.CommandName
 
-	subcmdMeta.Description = `Scaffold a webhook for an API resource. You can choose to scaffold defaulting,
+	subcmdMeta.Description = fmt.Sprintf(`Scaffold a webhook for an API resource. You can choose to scaffold defaulting,
 validating and/or conversion webhooks.
-`
+
+The conversion functions between the hub version and the spoke versions are generated from their Go types
+in the zz_generated.conversion.go file of each spoke version. The fields that do not map are flagged there.
+Run "%[1]s generate conversion" to regenerate them after changing the types.
+Fuzz tests checking that the objects survive a round trip through the hub version are scaffolded next to them.
+`, cliMeta.CommandName)
 	subcmdMeta.Examples = fmt.Sprintf(`  # Create defaulting and validating webhooks for Group: ship, Version: v1beta1
   # and Kind: Frigate
   %[1]s create webhook --group ship --version v1beta1 --kind Frigate --defaulting --programmatic-validation
@@ -83,6 +91,16 @@ validating and/or conversion webhooks.
   %[1]s create webhook --group ship --version v1beta1 --kind Frigate \
     --defaulting --programmatic-validation \
     --defaulting-path=/custom-mutate --validation-path=/custom-validate
+
+  # Create a named validation webhook, deployed independently of the other webhooks of the Kind Frigate
+  %[1]s create webhook --group ship --version v1beta1 --kind Frigate \
+    --programmatic-validation --name cross-object
+
+  # Create a validation webhook that ignores failures, times out after 5 seconds,
+  # is also called upon deletion and only for the objects of namespaces labeled env=prod
+  %[1]s create webhook --group ship --version v1beta1 --kind Frigate --programmatic-validation \
+    --failure-policy Ignore --timeout-seconds 5 --operations CREATE,UPDATE,DELETE \
+    --namespace-selector env=prod
 `, cliMeta.CommandName)
 }
 
@@ -110,6 +128,10 @@ func (p *createWebhookSubcommand) BindFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&p.options.ValidationPath, "validation-path", "",
 		"Custom path for the validation webhook (only valid with --programmatic-validation)")
 
+	fs.StringVar(&p.options.WebhookName, "name", "",
+		"name of the webhook, to scaffold several defaulting or validating webhooks for the same resource "+
+			"(only valid with either --defaulting or --programmatic-validation)")
+
 	// TODO: remove for go/v5
 	fs.BoolVar(&p.isLegacyPath, "legacy", false,
 		"[DEPRECATED] Attempts to create resource under the API directory (legacy path). "+
@@ -128,6 +150,8 @@ func (p *createWebhookSubcommand) BindFlags(fs *pflag.FlagSet) {
 
 	fs.BoolVar(&p.force, "force", false,
 		"attempt to create resource even if it already exists")
+
+	p.admission.bindFlags(fs)
 }
 
 func (p *createWebhookSubcommand) InjectConfig(c config.Config) error {
@@ -158,6 +182,10 @@ func (p *createWebhookSubcommand) InjectResource(res *resource.Resource) error {
 		return fmt.Errorf("--validation-path can only be used with --programmatic-validation")
 	}
 
+	if err := p.validateWebhookName(); err != nil {
+		return err
+	}
+
 	// Validate that --external-api-module requires --external-api-path
 	if len(p.options.ExternalAPIModule) != 0 && len(p.options.ExternalAPIPath) == 0 {
 		return errors.New("'--external-api-module' requires '--external-api-path' to be specified")
@@ -165,11 +193,16 @@ func (p *createWebhookSubcommand) InjectResource(res *resource.Resource) error {
 
 	p.options.UpdateResource(p.resource, p.config)
 
+	if err := p.applyAdmission(); err != nil {
+		return err
+	}
+
 	if err := p.resource.Validate(); err != nil {
 		return fmt.Errorf("error validating resource: %w", err)
 	}
 
-	if !p.resource.HasDefaultingWebhook() && !p.resource.HasValidationWebhook() && !p.resource.HasConversionWebhook() {
+	if !p.resource.HasDefaultingWebhook() && !p.resource.HasValidationWebhook() &&
+		!p.resource.HasConversionWebhook() && !p.resource.HasNamedWebhooks() {
 		return fmt.Errorf("%s create webhook requires at least one of --defaulting,"+
 			" --programmatic-validation and --conversion to be true", p.commandName)
 	}
@@ -181,6 +214,11 @@ func (p *createWebhookSubcommand) InjectResource(res *resource.Resource) error {
 		if !p.resource.External && !p.resource.Core {
 			return fmt.Errorf("%s create webhook requires a previously created API ", p.commandName)
 		}
+	} else if p.options.WebhookName != "" {
+		// Named webhooks are scaffolded in their own file, so the other webhooks are left untouched
+		if res.Webhooks != nil && res.Webhooks.Named.HasWebhook(p.options.WebhookName) && !p.force {
+			return fmt.Errorf("webhook %q already exists for this resource", p.options.WebhookName)
+		}
 	} else if res.Webhooks != nil && !res.Webhooks.IsEmpty() && !p.force {
 		// Check if user is trying to add a webhook type that already exists
 		if p.resource.HasDefaultingWebhook() && res.Webhooks.Defaulting {
@@ -202,6 +240,70 @@ func (p *createWebhookSubcommand) InjectResource(res *resource.Resource) error {
 	return nil
 }
 
+// validateWebhookName checks that --name is used to scaffold a single defaulting or validating webhook
+func (p *createWebhookSubcommand) validateWebhookName() error {
+	if p.options.WebhookName == "" {
+		return nil
+	}
+
+	if p.options.DoDefaulting == p.options.DoValidation {
+		return errors.New("--name requires either --defaulting or --programmatic-validation")
+	}
+	if p.options.DoConversion {
+		return errors.New("--name cannot be used with --conversion, a resource has a single conversion webhook")
+	}
+	if p.isLegacyPath {
+		return errors.New("--name cannot be used with --legacy")
+	}
+
+	webhook := resource.NamedWebhook{Name: p.options.WebhookName, Type: resource.ValidationWebhook}
+	if err := webhook.Validate(); err != nil {
+		return fmt.Errorf("invalid --name: %w", err)
+	}
+
+	return nil
+}
+
+// applyAdmission sets the admission configuration of the defaulting and validating webhooks being created
+func (p *createWebhookSubcommand) applyAdmission() error {
+	if !p.admission.isSet() {
+		return nil
+	}
+
+	if !p.options.DoDefaulting && !p.options.DoValidation {
+		return errors.New("the admission flags require --defaulting or --programmatic-validation")
+	}
+	if err := p.admission.validateTargets(p.options.DoDefaulting); err != nil {
+		return err
+	}
+
+	webhooks := p.resource.Webhooks
+	var err error
+	if p.options.WebhookName != "" {
+		for i := range webhooks.Named {
+			if webhooks.Named[i].Name == p.options.WebhookName {
+				if webhooks.Named[i].Admission, err = p.admission.apply(nil, p.options.DoDefaulting); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+
+	if p.options.DoDefaulting {
+		if webhooks.DefaultingAdmission, err = p.admission.apply(nil, true); err != nil {
+			return err
+		}
+	}
+	if p.options.DoValidation {
+		if webhooks.ValidationAdmission, err = p.admission.apply(nil, false); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (p *createWebhookSubcommand) Scaffold(fs machinery.Filesystem) error {
 	scaffolder := scaffolds.NewWebhookScaffolder(p.config, *p.resource, p.force, p.isLegacyPath)
 	scaffolder.InjectFS(fs)
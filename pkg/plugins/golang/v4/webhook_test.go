@@ -19,6 +19,7 @@ package v4
 import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/spf13/pflag"
 
 	"sigs.k8s.io/kubebuilder/v4/pkg/config"
 	cfgv3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
@@ -82,6 +83,123 @@ var _ = Describe("createWebhookSubcommand", func() {
 		Expect(err.Error()).To(ContainSubstring("requires '--external-api-path'"))
 	})
 
+	It("should require a single webhook type with --name", func() {
+		subCmd.options.WebhookName = "cross-object"
+		subCmd.options.DoDefaulting = true
+		subCmd.options.DoValidation = true
+
+		err := subCmd.InjectResource(res)
+
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("--name requires either --defaulting or --programmatic-validation"))
+	})
+
+	It("should reject --name with --conversion", func() {
+		subCmd.options.WebhookName = "cross-object"
+		subCmd.options.DoValidation = true
+		subCmd.options.DoConversion = true
+
+		err := subCmd.InjectResource(res)
+
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("--name cannot be used with --conversion"))
+	})
+
+	It("should reject an invalid webhook name", func() {
+		subCmd.options.WebhookName = "Cross_Object"
+		subCmd.options.DoValidation = true
+
+		err := subCmd.InjectResource(res)
+
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("invalid --name"))
+	})
+
+	It("should reject a named webhook that already exists", func() {
+		existing := *res
+		existing.API = &resource.API{CRDVersion: "v1", Namespaced: true}
+		existing.Webhooks = &resource.Webhooks{
+			WebhookVersion: "v1",
+			Named:          resource.NamedWebhooks{{Name: "cross-object", Type: resource.ValidationWebhook}},
+		}
+		Expect(cfg.AddResource(existing)).To(Succeed())
+		Expect(subCmd.InjectConfig(cfg)).To(Succeed())
+
+		subCmd.options.WebhookName = "cross-object"
+		subCmd.options.DoValidation = true
+
+		err := subCmd.InjectResource(res)
+
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(`webhook "cross-object" already exists`))
+	})
+
+	Context("admission flags", func() {
+		parse := func(args ...string) {
+			fs := pflag.NewFlagSet("create-webhook", pflag.ContinueOnError)
+			subCmd.BindFlags(fs)
+			Expect(fs.Parse(args)).To(Succeed())
+			Expect(subCmd.InjectConfig(cfg)).To(Succeed())
+		}
+
+		BeforeEach(func() {
+			existing := *res
+			existing.API = &resource.API{CRDVersion: "v1", Namespaced: true}
+			Expect(cfg.AddResource(existing)).To(Succeed())
+		})
+
+		It("should set the admission configuration of the created webhooks", func() {
+			parse("--defaulting", "--programmatic-validation", "--failure-policy", "Ignore",
+				"--reinvocation-policy", "IfNeeded", "--operations", "create,delete", "--object-selector", "app=captain")
+
+			Expect(subCmd.InjectResource(res)).To(Succeed())
+			Expect(res.Webhooks.DefaultingAdmission).To(Equal(&resource.AdmissionConfig{
+				FailurePolicy:      "Ignore",
+				ReinvocationPolicy: "IfNeeded",
+				Operations:         []string{"CREATE", "DELETE"},
+				ObjectSelector:     map[string]string{"app": "captain"},
+			}))
+			Expect(res.Webhooks.ValidationAdmission).To(Equal(&resource.AdmissionConfig{
+				FailurePolicy:  "Ignore",
+				Operations:     []string{"CREATE", "DELETE"},
+				ObjectSelector: map[string]string{"app": "captain"},
+			}))
+		})
+
+		It("should set the admission configuration of a named webhook", func() {
+			parse("--programmatic-validation", "--name", "cross-object", "--timeout-seconds", "5")
+
+			Expect(subCmd.InjectResource(res)).To(Succeed())
+			Expect(res.Webhooks.Named).To(HaveLen(1))
+			Expect(res.Webhooks.Named[0].Admission).To(Equal(&resource.AdmissionConfig{TimeoutSeconds: 5}))
+			Expect(res.Webhooks.ValidationAdmission).To(BeNil())
+		})
+
+		It("should reject the admission flags with only a conversion webhook", func() {
+			parse("--conversion", "--failure-policy", "Ignore")
+
+			err := subCmd.InjectResource(res)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("require --defaulting or --programmatic-validation"))
+		})
+
+		It("should reject a reinvocation policy for a validating webhook", func() {
+			parse("--programmatic-validation", "--reinvocation-policy", "IfNeeded")
+
+			err := subCmd.InjectResource(res)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("--reinvocation-policy can only be used with defaulting webhooks"))
+		})
+
+		It("should reject an invalid failure policy", func() {
+			parse("--programmatic-validation", "--failure-policy", "Sometimes")
+
+			err := subCmd.InjectResource(res)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`invalid failure policy "Sometimes"`))
+		})
+	})
+
 	Context("isValidVersion", func() {
 		BeforeEach(func() {
 			res = &resource.Resource{
@@ -0,0 +1,112 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package golang
+
+import (
+	"fmt"
+	"go/token"
+	"path"
+	"strings"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+)
+
+// ParseOwnedResource parses a resource owned by a controller, in the <group>/<version>/<Kind> format.
+// The group is either the group of a resource of the project, qualified or not, or a Kubernetes
+// built-in group, such as apps or core.
+func ParseOwnedResource(c config.Config, value string) (resource.GVK, error) {
+	parts := strings.Split(value, "/")
+	if len(parts) != 3 {
+		return resource.GVK{}, fmt.Errorf("invalid resource %q: must be in the <group>/<version>/<Kind> format", value)
+	}
+	group, version, kind := parts[0], parts[1], parts[2]
+
+	gvk, found := lookupGVK(c, group, version, kind)
+	if !found {
+		return resource.GVK{}, fmt.Errorf("unknown resource %q: must be a Kubernetes built-in resource or a "+
+			"resource of the project, external APIs can be added with 'create api --external-api-path'", value)
+	}
+	if err := gvk.Validate(); err != nil {
+		return resource.GVK{}, fmt.Errorf("invalid resource %q: %w", value, err)
+	}
+
+	return gvk, nil
+}
+
+// ParseWatchedResource parses a resource watched by the controller of the given Kind, in the
+// <group>/<version>/<Kind>[:<mapper>] format. The mapper is the function that maps the events of the watched
+// resource to reconcile requests, it defaults to map<Kind>To<reconciled Kind>.
+func ParseWatchedResource(c config.Config, value, reconciledKind string) (resource.Watch, error) {
+	gvkValue, mapper, _ := strings.Cut(value, ":")
+
+	gvk, err := ParseOwnedResource(c, gvkValue)
+	if err != nil {
+		return resource.Watch{}, err
+	}
+
+	if mapper == "" {
+		mapper = fmt.Sprintf("map%sTo%s", gvk.Kind, reconciledKind)
+	}
+	if !token.IsIdentifier(mapper) {
+		return resource.Watch{}, fmt.Errorf("invalid mapper %q of the watched resource %q: "+
+			"must be a valid Go identifier", mapper, gvkValue)
+	}
+
+	return resource.Watch{GVK: gvk, Mapper: mapper}, nil
+}
+
+// RelatedResource returns the resource owned or watched by a controller with the Go package that defines it,
+// looking it up in the project resources first and in the Kubernetes built-in resources otherwise.
+func RelatedResource(c config.Config, gvk resource.GVK) (resource.Resource, error) {
+	if res, err := c.GetResource(gvk); err == nil && res.Path != "" {
+		return res, nil
+	}
+
+	if domain, found := coreGroups[gvk.Group]; found && domain == gvk.Domain {
+		return resource.Resource{
+			GVK:    gvk,
+			Plural: resource.RegularPlural(gvk.Kind),
+			Path:   path.Join("k8s.io", "api", gvk.Group, gvk.Version),
+			Core:   true,
+		}, nil
+	}
+
+	return resource.Resource{}, fmt.Errorf("unknown resource %+v: its Go package cannot be found", gvk)
+}
+
+// lookupGVK returns the GVK of the resource of the project or the Kubernetes built-in resource
+// whose group matches the provided one, with or without its domain.
+func lookupGVK(c config.Config, group, version, kind string) (resource.GVK, bool) {
+	resources, err := c.GetResources()
+	if err == nil {
+		for _, res := range resources {
+			if res.Path != "" && res.Version == version && res.Kind == kind &&
+				(res.Group == group || res.QualifiedGroup() == group) {
+				return res.GVK, true
+			}
+		}
+	}
+
+	for coreGroup, domain := range coreGroups {
+		if group == coreGroup || (domain != "" && group == coreGroup+"."+domain) {
+			return resource.GVK{Group: coreGroup, Domain: domain, Version: version, Kind: kind}, true
+		}
+	}
+
+	return resource.GVK{}, false
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package golang
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	cfgv3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+)
+
+var _ = Describe("Watched resources", func() {
+	var (
+		cfg     config.Config
+		captain resource.GVK
+	)
+
+	BeforeEach(func() {
+		cfg = cfgv3.New()
+		Expect(cfg.SetRepository("test.io/crew")).To(Succeed())
+
+		captain = resource.GVK{Group: "crew", Domain: "test.io", Version: "v1", Kind: "Captain"}
+		Expect(cfg.AddResource(resource.Resource{
+			GVK:    captain,
+			Plural: "captains",
+			Path:   "test.io/crew/api/v1",
+			API:    &resource.API{CRDVersion: "v1", Namespaced: true},
+		})).To(Succeed())
+	})
+
+	Context("ParseOwnedResource", func() {
+		DescribeTable("should resolve the group of the resource",
+			func(value string, gvk resource.GVK) {
+				Expect(ParseOwnedResource(cfg, value)).To(Equal(gvk))
+			},
+			Entry("built-in group", "apps/v1/Deployment", resource.GVK{Group: "apps", Version: "v1", Kind: "Deployment"}),
+			Entry("core group", "core/v1/Service", resource.GVK{Group: "core", Version: "v1", Kind: "Service"}),
+			Entry("qualified built-in group", "networking.k8s.io/v1/Ingress",
+				resource.GVK{Group: "networking", Domain: "k8s.io", Version: "v1", Kind: "Ingress"}),
+			Entry("project group", "crew/v1/Captain",
+				resource.GVK{Group: "crew", Domain: "test.io", Version: "v1", Kind: "Captain"}),
+			Entry("qualified project group", "crew.test.io/v1/Captain",
+				resource.GVK{Group: "crew", Domain: "test.io", Version: "v1", Kind: "Captain"}),
+		)
+
+		DescribeTable("should fail for invalid resources",
+			func(value string) {
+				_, err := ParseOwnedResource(cfg, value)
+				Expect(err).To(HaveOccurred())
+			},
+			Entry("missing kind", "apps/v1"),
+			Entry("unknown group", "example.com/v1/Widget"),
+			Entry("unknown project version", "crew/v2/Captain"),
+			Entry("lowercase kind", "apps/v1/deployment"),
+		)
+	})
+
+	Context("ParseWatchedResource", func() {
+		It("should default the mapper", func() {
+			watch, err := ParseWatchedResource(cfg, "core/v1/Secret", "Captain")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(watch.Mapper).To(Equal("mapSecretToCaptain"))
+		})
+
+		It("should parse the mapper", func() {
+			watch, err := ParseWatchedResource(cfg, "core/v1/Secret:findCaptainsForSecret", "Captain")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(watch.GVK).To(Equal(resource.GVK{Group: "core", Version: "v1", Kind: "Secret"}))
+			Expect(watch.Mapper).To(Equal("findCaptainsForSecret"))
+		})
+
+		It("should fail for an invalid mapper", func() {
+			_, err := ParseWatchedResource(cfg, "core/v1/Secret:find-captains", "Captain")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("RelatedResource", func() {
+		It("should return the Go package of the project resources", func() {
+			res, err := RelatedResource(cfg, captain)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.Path).To(Equal("test.io/crew/api/v1"))
+			Expect(res.Plural).To(Equal("captains"))
+		})
+
+		It("should return the Go package of the built-in resources", func() {
+			res, err := RelatedResource(cfg, resource.GVK{Group: "apps", Version: "v1", Kind: "Deployment"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.Path).To(Equal("k8s.io/api/apps/v1"))
+			Expect(res.Plural).To(Equal("deployments"))
+			Expect(res.ImportAlias()).To(Equal("appsv1"))
+		})
+
+		It("should fail for unknown resources", func() {
+			_, err := RelatedResource(cfg, resource.GVK{Group: "ship", Domain: "test.io", Version: "v1", Kind: "Frigate"})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
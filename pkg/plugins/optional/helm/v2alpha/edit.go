@@ -387,7 +387,7 @@ func hasWebhooksWith(c config.Config) bool {
 	}
 
 	for _, res := range resources {
-		if res.HasDefaultingWebhook() || res.HasValidationWebhook() || res.HasConversionWebhook() {
+		if res.HasMutatingWebhook() || res.HasValidatingWebhook() || res.HasConversionWebhook() {
 			return true
 		}
 	}
@@ -104,6 +104,8 @@ func (s *editKustomizeScaffolder) Scaffold() error {
 
 	// Analyze resources to determine chart features
 	hasWebhooks := len(resources.WebhookConfigurations) > 0 || len(resources.Certificates) > 0
+	// Policies are enabled when admission policies exist (../policies enabled)
+	hasPolicies := len(resources.AdmissionPolicies) > 0
 	// Prometheus is enabled when ServiceMonitor resources exist (../prometheus enabled)
 	hasPrometheus := len(resources.ServiceMonitors) > 0
 	// Metrics are enabled either when ServiceMonitor exists or when a metrics service is present
@@ -196,6 +198,7 @@ func (s *editKustomizeScaffolder) Scaffold() error {
 		&templates.HelmValuesBasic{
 			// values.yaml with dynamic config
 			HasWebhooks:          hasWebhooks,
+			HasPolicies:          hasPolicies,
 			HasMetrics:           hasMetrics,
 			HasClusterScopedRBAC: hasClusterScopedRBAC,
 			RoleNamespaces:       roleNamespaces,
@@ -1697,6 +1697,9 @@ func (t *HelmTemplater) addConditionalWrappers(yamlContent string, resource *uns
 		// Webhook configurations should be conditional on webhook.enable
 		yamlContent = t.makeWebhookAnnotationsConditional(yamlContent)
 		return fmt.Sprintf("{{- if .Values.webhook.enable }}\n%s{{- end }}\n", yamlContent)
+	case isAdmissionPolicyKind(kind) && strings.HasPrefix(apiVersion, "admissionregistration.k8s.io/"):
+		// Admission policies and their bindings need policies enabled
+		return fmt.Sprintf("{{- if .Values.policies.enable }}\n%s{{- end }}\n", yamlContent)
 	case kind == kindService:
 		return t.handleServiceConditionalWrappers(yamlContent, name)
 	case kind == kindDeployment:
@@ -504,6 +504,30 @@ spec:
 	})
 
 	Context("conditional wrapping", func() {
+		It("should add policies conditional and template the policy name of bindings", func() {
+			bindingResource := &unstructured.Unstructured{}
+			bindingResource.SetAPIVersion("admissionregistration.k8s.io/v1")
+			bindingResource.SetKind("ValidatingAdmissionPolicyBinding")
+			bindingResource.SetName("test-project-captain-v1-validating-policy-binding")
+
+			content := `apiVersion: admissionregistration.k8s.io/v1
+kind: ValidatingAdmissionPolicyBinding
+metadata:
+  name: test-project-captain-v1-validating-policy-binding
+spec:
+  policyName: test-project-captain-v1-validating-policy
+  validationActions:
+  - Deny
+`
+
+			result := templater.ApplyHelmSubstitutions(content, bindingResource)
+
+			Expect(result).To(HavePrefix("{{- if .Values.policies.enable }}\n"))
+			Expect(result).To(ContainSubstring("{{- end }}"))
+			Expect(result).NotTo(ContainSubstring("policyName: test-project-captain-v1-validating-policy"))
+			Expect(result).To(ContainSubstring("policyName: {{ include"))
+		})
+
 		It("should add metrics conditional for ServiceMonitor resources", func() {
 			serviceMonitorResource := &unstructured.Unstructured{}
 			serviceMonitorResource.SetAPIVersion("monitoring.coreos.com/v1")
@@ -55,6 +55,9 @@ type ParsedResources struct {
 	// Monitoring resources
 	ServiceMonitors []*unstructured.Unstructured
 
+	// Admission policies and their bindings, evaluated by the API server without webhooks
+	AdmissionPolicies []*unstructured.Unstructured
+
 	// Other resources not fitting above categories
 	Other []*unstructured.Unstructured
 }
@@ -95,6 +98,7 @@ func (p *Parser) ParseFromReader(reader io.Reader) (*ParsedResources, error) {
 		Certificates:              make([]*unstructured.Unstructured, 0),
 		WebhookConfigurations:     make([]*unstructured.Unstructured, 0),
 		ServiceMonitors:           make([]*unstructured.Unstructured, 0),
+		AdmissionPolicies:         make([]*unstructured.Unstructured, 0),
 		CustomResources:           make([]*unstructured.Unstructured, 0),
 		Other:                     make([]*unstructured.Unstructured, 0),
 	}
@@ -156,11 +160,24 @@ func (p *Parser) categorizeResource(obj *unstructured.Unstructured, resources *P
 		resources.WebhookConfigurations = append(resources.WebhookConfigurations, obj)
 	case kind == "ServiceMonitor" && apiVersion == "monitoring.coreos.com/v1":
 		resources.ServiceMonitors = append(resources.ServiceMonitors, obj)
+	case isAdmissionPolicyKind(kind) && strings.HasPrefix(apiVersion, "admissionregistration.k8s.io/"):
+		resources.AdmissionPolicies = append(resources.AdmissionPolicies, obj)
 	default:
 		resources.Other = append(resources.Other, obj)
 	}
 }
 
+// isAdmissionPolicyKind returns true for the kinds of the admission policies and their bindings
+func isAdmissionPolicyKind(kind string) bool {
+	switch kind {
+	case "ValidatingAdmissionPolicy", "ValidatingAdmissionPolicyBinding",
+		"MutatingAdmissionPolicy", "MutatingAdmissionPolicyBinding":
+		return true
+	default:
+		return false
+	}
+}
+
 // identifyCustomResources moves resources from Other to CustomResources if they are instances of project CRDs
 func (p *Parser) identifyCustomResources(resources *ParsedResources) {
 	// Build a set of API groups from the CRDs defined in this project
@@ -212,6 +212,48 @@ spec:
 		})
 	})
 
+	Context("with admission policies", func() {
+		BeforeEach(func() {
+			yamlContent := `---
+apiVersion: admissionregistration.k8s.io/v1
+kind: ValidatingAdmissionPolicy
+metadata:
+  name: test-captain-v1-validating-policy
+spec:
+  failurePolicy: Fail
+---
+apiVersion: admissionregistration.k8s.io/v1
+kind: ValidatingAdmissionPolicyBinding
+metadata:
+  name: test-captain-v1-validating-policy-binding
+spec:
+  policyName: test-captain-v1-validating-policy
+  validationActions: [Deny]
+---
+apiVersion: admissionregistration.k8s.io/v1beta1
+kind: MutatingAdmissionPolicy
+metadata:
+  name: test-captain-v1-mutating-policy
+`
+			err := os.WriteFile(tempFile, []byte(yamlContent), 0o600)
+			Expect(err).NotTo(HaveOccurred())
+
+			parser = NewParser(tempFile)
+		})
+
+		It("should parse admission policies and organize them in the policies group", func() {
+			resources, err := parser.Parse()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(resources.AdmissionPolicies).To(HaveLen(3))
+			Expect(resources.Other).To(BeEmpty())
+
+			groups := NewResourceOrganizer(resources).OrganizeByFunction()
+			Expect(groups).To(HaveKeyWithValue("policies", HaveLen(3)))
+			Expect(groups).NotTo(HaveKey("extras"))
+		})
+	})
+
 	Context("with empty or invalid YAML", func() {
 		It("should handle empty file gracefully", func() {
 			err := os.WriteFile(tempFile, []byte(""), 0o600)
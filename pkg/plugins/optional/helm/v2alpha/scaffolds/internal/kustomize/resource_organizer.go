@@ -78,6 +78,11 @@ func (o *ResourceOrganizer) OrganizeByFunction() map[string][]*unstructured.Unst
 		groups["prometheus"] = prometheusResources
 	}
 
+	// Policies - Admission policies and their bindings
+	if len(o.resources.AdmissionPolicies) > 0 {
+		groups["policies"] = o.resources.AdmissionPolicies
+	}
+
 	// Extras - Uncategorized resources (services, configmaps, secrets, etc. not fitting above categories)
 	// This includes both uncategorized services and all resources from the "Other" category
 	extrasResources := o.collectExtrasResources()
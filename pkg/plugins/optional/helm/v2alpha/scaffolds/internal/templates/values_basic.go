@@ -43,6 +43,8 @@ type HelmValuesBasic struct {
 	Force bool
 	// HasWebhooks is true when webhooks were found in the config
 	HasWebhooks bool
+	// HasPolicies is true when admission policies were found in the config
+	HasPolicies bool
 	// HasMetrics is true when metrics service/monitor were found in the config
 	HasMetrics bool
 	// HasClusterScopedRBAC is true when ClusterRole resources were found (excluding metrics-auth-role)
@@ -280,6 +282,17 @@ webhook:
 `, webhookPort))
 	}
 
+	// Admission policies configuration - only if policies are present
+	if f.HasPolicies {
+		buf.WriteString(`## Admission policies and their bindings, evaluated by the API server.
+## MutatingAdmissionPolicies require the MutatingAdmissionPolicy feature gate in the cluster.
+##
+policies:
+  enable: true
+
+`)
+	}
+
 	// Prometheus configuration
 	buf.WriteString(`## Prometheus ServiceMonitor for metrics scraping.
 ## Requires prometheus-operator to be installed in the cluster.
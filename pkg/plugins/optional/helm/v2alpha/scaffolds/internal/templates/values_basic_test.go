@@ -115,6 +115,20 @@ var _ = Describe("HelmValuesBasic", func() {
 		})
 	})
 
+	Context("when project has admission policies", func() {
+		It("should only include the policies configuration when policies are present", func() {
+			valuesTemplate = &HelmValuesBasic{HasPolicies: true}
+			valuesTemplate.InjectProjectName("test-project")
+			Expect(valuesTemplate.SetTemplateDefaults()).To(Succeed())
+			Expect(valuesTemplate.GetBody()).To(ContainSubstring("policies:\n  enable: true\n"))
+
+			valuesTemplate = &HelmValuesBasic{}
+			valuesTemplate.InjectProjectName("test-project")
+			Expect(valuesTemplate.SetTemplateDefaults()).To(Succeed())
+			Expect(valuesTemplate.GetBody()).NotTo(ContainSubstring("policies:"))
+		})
+	})
+
 	Context("template path and content", func() {
 		BeforeEach(func() {
 			valuesTemplate = &HelmValuesBasic{
@@ -30,14 +30,16 @@ func (src *Wordpress) ConvertTo(dstRaw conversion.Hub) error {
 	log.Printf("ConvertTo: Converting Wordpress from Spoke version v2 to Hub version v1;"+
 		"source: %s/%s, target: %s/%s", src.Namespace, src.Name, dst.Namespace, dst.Name)
 
-	// TODO(user): Implement conversion logic from v2 to v1
-	// Example: Copying Spec fields
-	// dst.Spec.Size = src.Spec.Replicas
-
-	// Copy ObjectMeta to preserve name, namespace, labels, etc.
-	dst.ObjectMeta = src.ObjectMeta
-
-	return nil
+	// The fields with the same name and type in both versions, including ObjectMeta, are converted by the
+	// functions of zz_generated.conversion.go. Run "kubebuilder generate conversion" to regenerate them
+	// after changing the types.
+	// TODO(user): Implement the Convert_ functions flagged there, for the fields that do not map.
+	if err := Convert_v2_Wordpress_To_v1_Wordpress(src, dst); err != nil {
+		return err
+	}
+
+	// Restore the fields that only exist in the Hub version, when this object was converted from it
+	return restoreWordpress(src, dst)
 }
 
 // ConvertFrom converts the Hub version (v1) to this Wordpress (v2).
@@ -46,12 +48,10 @@ func (dst *Wordpress) ConvertFrom(srcRaw conversion.Hub) error {
 	log.Printf("ConvertFrom: Converting Wordpress from Hub version v1 to Spoke version v2;"+
 		"source: %s/%s, target: %s/%s", src.Namespace, src.Name, dst.Namespace, dst.Name)
 
-	// TODO(user): Implement conversion logic from v1 to v2
-	// Example: Copying Spec fields
-	// dst.Spec.Replicas = src.Spec.Size
-
-	// Copy ObjectMeta to preserve name, namespace, labels, etc.
-	dst.ObjectMeta = src.ObjectMeta
+	if err := Convert_v1_Wordpress_To_v2_Wordpress(src, dst); err != nil {
+		return err
+	}
 
-	return nil
+	// Stash the fields that only exist in the Hub version, so that they are restored when converted back
+	return stashWordpress(src, dst)
 }
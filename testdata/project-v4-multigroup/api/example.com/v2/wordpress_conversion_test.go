@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The Kubernetes authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	"math/rand"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/apitesting/fuzzer"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metafuzzer "k8s.io/apimachinery/pkg/apis/meta/fuzzer"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	runtimeserializer "k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/diff"
+	"sigs.k8s.io/randfill"
+
+	examplecomv1 "sigs.k8s.io/kubebuilder/testdata/project-v4-multigroup/api/example.com/v1"
+)
+
+// The round-trip tests fill a Wordpress with random values, convert it to the other version and back,
+// and check that nothing was lost. "go test" runs them with the seeds added below; run them with
+// "go test -fuzz=FuzzWordpressSpokeHubSpoke ./<this package>" to explore more values.
+
+// FuzzWordpressSpokeHubSpoke checks that converting a Wordpress (v2) to the Hub version (v1)
+// and back returns the same object.
+func FuzzWordpressSpokeHubSpoke(f *testing.F) {
+	for seed := range int64(10) {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, seed int64) {
+		spoke := &Wordpress{}
+		newWordpressFiller(t, seed).Fill(spoke)
+
+		hub := &examplecomv1.Wordpress{}
+		if err := spoke.ConvertTo(hub); err != nil {
+			t.Fatalf("failed to convert to the Hub version: %v", err)
+		}
+		roundTrip := &Wordpress{}
+		if err := roundTrip.ConvertFrom(hub); err != nil {
+			t.Fatalf("failed to convert from the Hub version: %v", err)
+		}
+
+		removeWordpressConversionData(&spoke.ObjectMeta)
+		removeWordpressConversionData(&roundTrip.ObjectMeta)
+		if !apiequality.Semantic.DeepEqual(spoke, roundTrip) {
+			t.Errorf("the Wordpress changed after a round trip through the Hub version:\n%s",
+				diff.Diff(spoke, roundTrip))
+		}
+	})
+}
+
+// FuzzWordpressHubSpokeHub checks that converting a Wordpress Hub version (v1) to v2
+// and back returns the same object, including the fields stashed in the annotations of v2.
+func FuzzWordpressHubSpokeHub(f *testing.F) {
+	for seed := range int64(10) {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, seed int64) {
+		hub := &examplecomv1.Wordpress{}
+		newWordpressFiller(t, seed).Fill(hub)
+
+		spoke := &Wordpress{}
+		if err := spoke.ConvertFrom(hub); err != nil {
+			t.Fatalf("failed to convert from the Hub version: %v", err)
+		}
+		roundTrip := &examplecomv1.Wordpress{}
+		if err := spoke.ConvertTo(roundTrip); err != nil {
+			t.Fatalf("failed to convert to the Hub version: %v", err)
+		}
+
+		removeWordpressConversionData(&hub.ObjectMeta)
+		removeWordpressConversionData(&roundTrip.ObjectMeta)
+		if !apiequality.Semantic.DeepEqual(hub, roundTrip) {
+			t.Errorf("the Wordpress changed after a round trip through v2:\n%s",
+				diff.Diff(hub, roundTrip))
+		}
+	})
+}
+
+// newWordpressFiller returns a filler of random values for the Kubernetes types of both versions of
+// Wordpress, determined by seed.
+func newWordpressFiller(t *testing.T, seed int64) *randfill.Filler {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	if err := AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add v2 to the scheme: %v", err)
+	}
+	if err := examplecomv1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add v1 to the scheme: %v", err)
+	}
+
+	funcs := fuzzer.MergeFuzzerFuncs(metafuzzer.Funcs, wordpressFuzzerFuncs)
+	return fuzzer.FuzzerFor(funcs, rand.NewSource(seed), runtimeserializer.NewCodecFactory(scheme))
+}
+
+// removeWordpressConversionData removes the fields of the Hub version stashed in the annotations of
+// v2, which are not part of the objects compared.
+func removeWordpressConversionData(meta *metav1.ObjectMeta) {
+	delete(meta.Annotations, conversionDataAnnotation)
+	if len(meta.Annotations) == 0 {
+		meta.Annotations = nil
+	}
+}
+
+// wordpressFuzzerFuncs returns the functions filling the types that must not be random.
+// TODO(user): Add a function for each type with values that cannot survive a round trip, for example:
+//
+//	func(j *MyType, c randfill.Continue) {
+//		c.FillNoCustom(j)
+//		j.Format = "default"
+//	},
+func wordpressFuzzerFuncs(_ runtimeserializer.CodecFactory) []any {
+	return []any{}
+}
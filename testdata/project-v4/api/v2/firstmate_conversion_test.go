@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The Kubernetes authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	"math/rand"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/apitesting/fuzzer"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metafuzzer "k8s.io/apimachinery/pkg/apis/meta/fuzzer"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	runtimeserializer "k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/diff"
+	"sigs.k8s.io/randfill"
+
+	crewv1 "sigs.k8s.io/kubebuilder/testdata/project-v4/api/v1"
+)
+
+// The round-trip tests fill a FirstMate with random values, convert it to the other version and back,
+// and check that nothing was lost. "go test" runs them with the seeds added below; run them with
+// "go test -fuzz=FuzzFirstMateSpokeHubSpoke ./<this package>" to explore more values.
+
+// FuzzFirstMateSpokeHubSpoke checks that converting a FirstMate (v2) to the Hub version (v1)
+// and back returns the same object.
+func FuzzFirstMateSpokeHubSpoke(f *testing.F) {
+	for seed := range int64(10) {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, seed int64) {
+		spoke := &FirstMate{}
+		newFirstMateFiller(t, seed).Fill(spoke)
+
+		hub := &crewv1.FirstMate{}
+		if err := spoke.ConvertTo(hub); err != nil {
+			t.Fatalf("failed to convert to the Hub version: %v", err)
+		}
+		roundTrip := &FirstMate{}
+		if err := roundTrip.ConvertFrom(hub); err != nil {
+			t.Fatalf("failed to convert from the Hub version: %v", err)
+		}
+
+		removeFirstMateConversionData(&spoke.ObjectMeta)
+		removeFirstMateConversionData(&roundTrip.ObjectMeta)
+		if !apiequality.Semantic.DeepEqual(spoke, roundTrip) {
+			t.Errorf("the FirstMate changed after a round trip through the Hub version:\n%s",
+				diff.Diff(spoke, roundTrip))
+		}
+	})
+}
+
+// FuzzFirstMateHubSpokeHub checks that converting a FirstMate Hub version (v1) to v2
+// and back returns the same object, including the fields stashed in the annotations of v2.
+func FuzzFirstMateHubSpokeHub(f *testing.F) {
+	for seed := range int64(10) {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, seed int64) {
+		hub := &crewv1.FirstMate{}
+		newFirstMateFiller(t, seed).Fill(hub)
+
+		spoke := &FirstMate{}
+		if err := spoke.ConvertFrom(hub); err != nil {
+			t.Fatalf("failed to convert from the Hub version: %v", err)
+		}
+		roundTrip := &crewv1.FirstMate{}
+		if err := spoke.ConvertTo(roundTrip); err != nil {
+			t.Fatalf("failed to convert to the Hub version: %v", err)
+		}
+
+		removeFirstMateConversionData(&hub.ObjectMeta)
+		removeFirstMateConversionData(&roundTrip.ObjectMeta)
+		if !apiequality.Semantic.DeepEqual(hub, roundTrip) {
+			t.Errorf("the FirstMate changed after a round trip through v2:\n%s",
+				diff.Diff(hub, roundTrip))
+		}
+	})
+}
+
+// newFirstMateFiller returns a filler of random values for the Kubernetes types of both versions of
+// FirstMate, determined by seed.
+func newFirstMateFiller(t *testing.T, seed int64) *randfill.Filler {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	if err := AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add v2 to the scheme: %v", err)
+	}
+	if err := crewv1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add v1 to the scheme: %v", err)
+	}
+
+	funcs := fuzzer.MergeFuzzerFuncs(metafuzzer.Funcs, firstmateFuzzerFuncs)
+	return fuzzer.FuzzerFor(funcs, rand.NewSource(seed), runtimeserializer.NewCodecFactory(scheme))
+}
+
+// removeFirstMateConversionData removes the fields of the Hub version stashed in the annotations of
+// v2, which are not part of the objects compared.
+func removeFirstMateConversionData(meta *metav1.ObjectMeta) {
+	delete(meta.Annotations, conversionDataAnnotation)
+	if len(meta.Annotations) == 0 {
+		meta.Annotations = nil
+	}
+}
+
+// firstmateFuzzerFuncs returns the functions filling the types that must not be random.
+// TODO(user): Add a function for each type with values that cannot survive a round trip, for example:
+//
+//	func(j *MyType, c randfill.Continue) {
+//		c.FillNoCustom(j)
+//		j.Format = "default"
+//	},
+func firstmateFuzzerFuncs(_ runtimeserializer.CodecFactory) []any {
+	return []any{}
+}